@@ -0,0 +1,66 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// CallOpts are options that apply to an individual call.
+// They are attached to the context passed to the call with WithCallOpts().
+type CallOpts struct {
+	// Deadline is the time by which the call must complete.
+	// If set, this overrides the service-wide timeout.
+	Deadline time.Time
+	// Timeout is the maximum duration of the call.
+	// If set, this overrides the service-wide timeout.  If a deadline is
+	// also set then the call is bounded by whichever is earlier.
+	Timeout time.Duration
+	// Strategy is the name of the strategy used to select the clients that
+	// serve the call, for services that call multiple clients.
+	// If set, this overrides the service-wide strategy.
+	Strategy string
+}
+
+type callOptsKey struct{}
+
+// WithCallOpts returns a copy of the context with the given call options attached.
+func WithCallOpts(ctx context.Context, opts *CallOpts) context.Context {
+	return context.WithValue(ctx, callOptsKey{}, opts)
+}
+
+// WithCallTimeout returns a copy of the context with call options attached that
+// bound calls made with it to the given duration.
+// Any existing call options attached to the context are retained.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	opts := &CallOpts{}
+	if existing := CallOptsFromContext(ctx); existing != nil {
+		*opts = *existing
+	}
+	opts.Timeout = timeout
+
+	return WithCallOpts(ctx, opts)
+}
+
+// CallOptsFromContext returns the call options attached to the context.
+// If no call options are attached this will return nil.
+func CallOptsFromContext(ctx context.Context) *CallOpts {
+	opts, ok := ctx.Value(callOptsKey{}).(*CallOpts)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
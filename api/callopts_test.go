@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCallTimeout(t *testing.T) {
+	deadline := time.Now().Add(time.Minute)
+	ctx := api.WithCallOpts(context.Background(), &api.CallOpts{
+		Deadline: deadline,
+	})
+
+	ctx = api.WithCallTimeout(ctx, 5*time.Second)
+	opts := api.CallOptsFromContext(ctx)
+	require.NotNil(t, opts)
+	require.Equal(t, 5*time.Second, opts.Timeout)
+	require.Equal(t, deadline, opts.Deadline)
+
+	opts = api.CallOptsFromContext(api.WithCallTimeout(context.Background(), time.Second))
+	require.NotNil(t, opts)
+	require.Equal(t, time.Second, opts.Timeout)
+	require.True(t, opts.Deadline.IsZero())
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+// CapabilitySupport is the support of the connected node for a capability.
+type CapabilitySupport int
+
+const (
+	// CapabilityUnknown means that support for the capability could not be established,
+	// either because there is no way to check it without side effects or because the
+	// check failed.
+	CapabilityUnknown CapabilitySupport = iota
+	// CapabilitySupported means that the capability is supported.
+	CapabilitySupported
+	// CapabilityUnsupported means that the capability is not supported.
+	CapabilityUnsupported
+)
+
+var capabilitySupportStrings = [...]string{
+	"unknown",
+	"supported",
+	"unsupported",
+}
+
+// String returns a string representation of the capability support.
+func (c CapabilitySupport) String() string {
+	if c < 0 || int(c) >= len(capabilitySupportStrings) {
+		return capabilitySupportStrings[0]
+	}
+
+	return capabilitySupportStrings[c]
+}
+
+// Capabilities are the capabilities of a connected node.
+type Capabilities struct {
+	// Providers are the provider and submitter interfaces of the client, keyed by
+	// interface name (for example "AttesterDutiesProvider"), with a value stating
+	// if the interface is usable against the connected node.
+	Providers map[string]CapabilitySupport
+	// Endpoints are endpoints that change how providers and submitters are served,
+	// keyed by path (for example "/eth/v2/beacon/blocks"), with a value stating if
+	// the endpoint is supported by the connected node.
+	Endpoints map[string]CapabilitySupport
+}
+
+// Supports returns true if the named provider or submitter interface is known to
+// be usable against the connected node.
+func (c *Capabilities) Supports(provider string) bool {
+	if c == nil {
+		return false
+	}
+
+	return c.Providers[provider] == CapabilitySupported
+}
+
+// SupportsEndpoint returns true if the endpoint with the given path is known to be
+// supported by the connected node.
+func (c *Capabilities) SupportsEndpoint(endpoint string) bool {
+	if c == nil {
+		return false
+	}
+
+	return c.Endpoints[endpoint] == CapabilitySupported
+}
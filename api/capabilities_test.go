@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilitiesSupports(t *testing.T) {
+	capabilities := &api.Capabilities{
+		Providers: map[string]api.CapabilitySupport{
+			"NodeVersionProvider": api.CapabilitySupported,
+			"ForkChoiceProvider":  api.CapabilityUnsupported,
+			"EventsProvider":      api.CapabilityUnknown,
+		},
+	}
+
+	require.True(t, capabilities.Supports("NodeVersionProvider"))
+	require.False(t, capabilities.Supports("ForkChoiceProvider"))
+	require.False(t, capabilities.Supports("EventsProvider"))
+	require.False(t, capabilities.Supports("UnknownProvider"))
+
+	var nilCapabilities *api.Capabilities
+	require.False(t, nilCapabilities.Supports("NodeVersionProvider"))
+}
+
+func TestCapabilitiesSupportsEndpoint(t *testing.T) {
+	capabilities := &api.Capabilities{
+		Endpoints: map[string]api.CapabilitySupport{
+			"/eth/v2/beacon/blocks":         api.CapabilitySupported,
+			"/eth/v2/beacon/blinded_blocks": api.CapabilityUnsupported,
+		},
+	}
+
+	require.True(t, capabilities.SupportsEndpoint("/eth/v2/beacon/blocks"))
+	require.False(t, capabilities.SupportsEndpoint("/eth/v2/beacon/blinded_blocks"))
+	require.False(t, capabilities.SupportsEndpoint("/eth/v1/unknown"))
+
+	var nilCapabilities *api.Capabilities
+	require.False(t, nilCapabilities.SupportsEndpoint("/eth/v2/beacon/blocks"))
+}
+
+func TestCapabilitySupportString(t *testing.T) {
+	require.Equal(t, "unknown", api.CapabilityUnknown.String())
+	require.Equal(t, "supported", api.CapabilitySupported.String())
+	require.Equal(t, "unsupported", api.CapabilityUnsupported.String())
+	require.Equal(t, "unknown", api.CapabilitySupport(-1).String())
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+var (
+	// ErrNotFound is returned when the requested item does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrNotSynced is returned when the node is not synced, so cannot serve the request.
+	ErrNotSynced = errors.New("not synced")
+	// ErrUnsupportedVersion is returned when data is of a version that is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	// ErrInvalidRequest is returned when the node rejects a request as invalid.
+	ErrInvalidRequest = errors.New("invalid request")
+	// ErrRateLimited is returned when the node rejects a request due to rate limiting.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrBroadcastValidationFailed is returned when the node broadcasts a submitted block,
+	// but the block fails the requested broadcast validation.
+	ErrBroadcastValidationFailed = errors.New("broadcast but failed validation")
+)
+
+// UnknownVersionError is returned in place of decoded data when the node returns data
+// of a version that is not known to the client, and the client has been configured to
+// allow unknown versions.  It carries the data as returned by the node, allowing callers
+// to continue to operate across a fork before they are upgraded.
+// It matches ErrUnsupportedVersion.
+type UnknownVersionError struct {
+	// Version is the version of the data as supplied by the node.
+	Version string
+	// ContentType is the media type of the data, for example "application/json".
+	ContentType string
+	// Data is the data as returned by the node.
+	Data []byte
+}
+
+func (e *UnknownVersionError) Error() string {
+	return fmt.Sprintf("unknown data version %s", e.Version)
+}
+
+// Unwrap returns ErrUnsupportedVersion.
+func (*UnknownVersionError) Unwrap() error {
+	return ErrUnsupportedVersion
+}
+
+// retryable is implemented by errors that know if the request that caused them can be retried.
+type retryable interface {
+	Retryable() bool
+}
+
+// IsRetryable returns true if the request that resulted in the error could succeed if
+// retried, for example if it timed out or the node returned a server error, and false
+// if it is permanent, for example if the node rejected the request as invalid.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var retryableErr retryable
+	if errors.As(err, &retryableErr) {
+		return retryableErr.Retryable()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return false
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, ErrNotSynced),
+		errors.Is(err, ErrRateLimited):
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+
+	// Failure to connect to the node.
+	return errors.As(err, &opErr)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "Generic",
+			err:  errors.New("generic"),
+		},
+		{
+			name: "Canceled",
+			err:  errors.Wrap(context.Canceled, "failed"),
+		},
+		{
+			name:      "DeadlineExceeded",
+			err:       errors.Wrap(context.DeadlineExceeded, "failed"),
+			retryable: true,
+		},
+		{
+			name:      "NotSynced",
+			err:       errors.Wrap(api.ErrNotSynced, "failed"),
+			retryable: true,
+		},
+		{
+			name:      "RateLimited",
+			err:       api.ErrRateLimited,
+			retryable: true,
+		},
+		{
+			name: "NotFound",
+			err:  api.ErrNotFound,
+		},
+		{
+			name:      "Connection",
+			err:       errors.Wrap(&net.OpError{Op: "dial", Err: errors.New("connection refused")}, "failed"),
+			retryable: true,
+		},
+		{
+			name:      "ServerError",
+			err:       errors.Wrap(http.Error{StatusCode: nethttp.StatusInternalServerError}, "failed"),
+			retryable: true,
+		},
+		{
+			name:      "ServiceUnavailable",
+			err:       http.Error{StatusCode: nethttp.StatusServiceUnavailable},
+			retryable: true,
+		},
+		{
+			name:      "TooManyRequests",
+			err:       http.Error{StatusCode: nethttp.StatusTooManyRequests},
+			retryable: true,
+		},
+		{
+			name: "BadRequest",
+			err:  errors.Wrap(http.Error{StatusCode: nethttp.StatusBadRequest}, "failed"),
+		},
+		{
+			name: "UnprocessableEntity",
+			err:  http.Error{StatusCode: nethttp.StatusUnprocessableEntity},
+		},
+		{
+			name:      "CircuitOpen",
+			err:       http.CircuitOpenError{},
+			retryable: true,
+		},
+		{
+			name: "ResponseTooLarge",
+			err:  http.ResponseTooLargeError{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.retryable, api.IsRetryable(test.err))
+		})
+	}
+}
+
+func TestUnknownVersionError(t *testing.T) {
+	err := errors.Wrap(&api.UnknownVersionError{
+		Version:     "fulu",
+		ContentType: "application/json",
+		Data:        []byte(`{}`),
+	}, "failed to request signed beacon block")
+
+	require.EqualError(t, err, "failed to request signed beacon block: unknown data version fulu")
+	require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+	var unknownVersionErr *api.UnknownVersionError
+	require.True(t, errors.As(err, &unknownVersionErr))
+	require.Equal(t, "fulu", unknownVersionErr.Version)
+	require.Equal(t, []byte(`{}`), unknownVersionErr.Data)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// RawCallOpts are the options for a raw call.
+type RawCallOpts struct {
+	// Headers are additional headers to send with the request.
+	Headers map[string]string
+	// ContentType is the content type of the request body.
+	// Defaults to "application/json" if not supplied.
+	ContentType string
+	// Accept is the content type(s) acceptable in the response.
+	// Defaults to "application/json" if not supplied.
+	Accept string
+}
+
+// RawResponse is the response from a raw call.
+type RawResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Headers are the headers of the response.
+	Headers http.Header
+	// ContentType is the media type of the response body, without parameters.
+	// It is empty if the response did not supply a content type.
+	ContentType string
+	// ConsensusVersion is the consensus version of the response, taken from
+	// the Eth-Consensus-Version header or, for JSON responses, the body.
+	// It is unknown if the response did not supply a version.
+	ConsensusVersion spec.DataVersion
+	// Body is the body of the response.
+	Body []byte
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// ResponseMetadata is metadata about the response to a call, obtained from
+// its headers and, where a JSON body is read in full, its top-level fields.
+// It is populated for calls made with a context returned by WithResponseMetadata().
+type ResponseMetadata struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Headers are the headers of the response.
+	Headers http.Header
+	// ConsensusVersion is the consensus version of the response,
+	// from the Eth-Consensus-Version header.
+	ConsensusVersion spec.DataVersion
+	// ExecutionPayloadBlinded is set if the response contained a blinded
+	// execution payload, from the Eth-Execution-Payload-Blinded header.
+	ExecutionPayloadBlinded *bool
+	// ExecutionPayloadValue is the value of the execution payload in wei,
+	// from the Eth-Execution-Payload-Value header.
+	ExecutionPayloadValue *big.Int
+	// ConsensusBlockValue is the consensus layer reward of the block in wei,
+	// from the Eth-Consensus-Block-Value header.
+	ConsensusBlockValue *big.Int
+	// ExecutionOptimistic is set if the response is based on an optimistically
+	// imported block, from the execution_optimistic field of the body.
+	ExecutionOptimistic *bool
+	// Finalized is set if the response is based on finalized data,
+	// from the finalized field of the body.
+	Finalized *bool
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a copy of the context that requests response metadata.
+// The supplied metadata is populated by each call made with the returned context, so
+// the same context should not be used for concurrent calls.  The metadata is that of
+// the response whose data is returned by the call, so if the call is made to multiple
+// clients, as with multi, it is that of the client whose response is used, and if the
+// response is shared between identical calls, as with http.WithSingleflight(), each
+// caller receives it.
+func WithResponseMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, metadata)
+}
+
+// ResponseMetadataFromContext returns the response metadata attached to the context.
+// If no response metadata is attached this will return nil.
+func ResponseMetadataFromContext(ctx context.Context) *ResponseMetadata {
+	metadata, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata)
+	if !ok {
+		return nil
+	}
+
+	return metadata
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestAttestationRewardsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.attestationRewardsJSON",
+		},
+		{
+			name:  "IdealRewardsMissing",
+			input: []byte(`{"total_rewards":[]}`),
+			err:   "ideal rewards missing",
+		},
+		{
+			name:  "TotalRewardsMissing",
+			input: []byte(`{"ideal_rewards":[]}`),
+			err:   "total rewards missing",
+		},
+		{
+			name:  "EffectiveBalanceMissing",
+			input: []byte(`{"ideal_rewards":[{"head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[]}`),
+			err:   "invalid JSON: effective balance missing",
+		},
+		{
+			name:  "EffectiveBalanceInvalid",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"-1","head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[]}`),
+			err:   "invalid JSON: invalid value for effective balance: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"head":"2000","target":"-2000","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: validator index missing",
+		},
+		{
+			name:  "HeadMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"1","target":"-2000","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: head missing",
+		},
+		{
+			name:  "TargetInvalid",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"1","head":"2000","target":"bad","source":"4000","inactivity":"0"}]}`),
+			err:   "invalid JSON: invalid value for target: strconv.ParseInt: parsing \"bad\": invalid syntax",
+		},
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","inactivity":"0"}]}`),
+			err:   "invalid JSON: source missing",
+		},
+		{
+			name:  "InclusionDelayInvalid",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","source":"4000","inclusion_delay":"bad","inactivity":"0"}]}`),
+			err:   "invalid JSON: invalid value for inclusion delay: strconv.ParseInt: parsing \"bad\": invalid syntax",
+		},
+		{
+			name:  "InactivityMissing",
+			input: []byte(`{"ideal_rewards":[],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","source":"4000"}]}`),
+			err:   "invalid JSON: inactivity missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","source":"4000","inactivity":"0"}]}`),
+		},
+		{
+			name:  "GoodInclusionDelay",
+			input: []byte(`{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"5000","inclusion_delay":"5000","inactivity":"0"}],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","source":"4000","inclusion_delay":"3000","inactivity":"-100"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.AttestationRewards
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -27,7 +27,7 @@ import (
 // AttesterDuty is the data regarding which validators have the duty to attest in a slot.
 type AttesterDuty struct {
 	// PubKey is the public key of the validator that should attest.
-	PubKey phase0.BLSPubKey
+	PubKey phase0.BLSPubKey `ssz-size:"48"`
 	// Slot is the slot in which the validator should attest.
 	Slot phase0.Slot
 	// ValidatorIndex is the index of the validator that should attest.
@@ -4,6 +4,7 @@
 package bellatrix
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -182,7 +183,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 384 {
+	if o3 != 384 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -240,7 +241,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'AttesterSlashings'
 	{
 		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
+		num, err := codecs.DecodeDynamicLength(buf, 2)
 		if err != nil {
 			return err
 		}
@@ -262,7 +263,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Attestations'
 	{
 		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
+		num, err := codecs.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
 		}
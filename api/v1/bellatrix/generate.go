@@ -14,6 +14,7 @@
 package bellatrix
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
-//go:generate rm -f blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go signedblindedbeaconblock_ssz.go
-//go:generate sszgen --include ../../../spec/phase0,../../../spec/altair,../../../spec/bellatrix,../../../spec/capella -path . --suffix ssz -objs BlindedBeaconBlockBody,BlindedBeaconBlock,SignedBlindedBeaconBlock
-//go:generate goimports -w blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go signedblindedbeaconblock_ssz.go
+//go:generate rm -f blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go builderbid_ssz.go signedblindedbeaconblock_ssz.go signedbuilderbid_ssz.go
+//go:generate sszgen --include ../../../spec/phase0,../../../spec/altair,../../../spec/bellatrix,../../../spec/capella -path . --suffix ssz -objs BlindedBeaconBlockBody,BlindedBeaconBlock,BuilderBid,SignedBlindedBeaconBlock,SignedBuilderBid
+//go:generate go run ../../../internal/sszpatch blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go builderbid_ssz.go signedblindedbeaconblock_ssz.go signedbuilderbid_ssz.go
+//go:generate goimports -w blindedbeaconblockbody_ssz.go blindedbeaconblock_ssz.go builderbid_ssz.go signedblindedbeaconblock_ssz.go signedbuilderbid_ssz.go
@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSidecarEventJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.blobSidecarEventJSON",
+		},
+		{
+			name:  "BlockRootMissing",
+			input: []byte(`{"index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "block root missing",
+		},
+		{
+			name:  "BlockRootWrongType",
+			input: []byte(`{"block_root":true,"index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blobSidecarEventJSON.block_root of type string",
+		},
+		{
+			name:  "BlockRootInvalid",
+			input: []byte(`{"block_root":"invalid","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid value for block root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlockRootShort",
+			input: []byte(`{"block_root":"0xe3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "incorrect length 31 for block root",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "index missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":true,"slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blobSidecarEventJSON.index of type string",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"-1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid value for index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":true,"kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blobSidecarEventJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"-1","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "KzgCommitmentMissing",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "kzg commitment missing",
+		},
+		{
+			name:  "KzgCommitmentWrongType",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":true,"versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blobSidecarEventJSON.kzg_commitment of type string",
+		},
+		{
+			name:  "KzgCommitmentInvalid",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"invalid","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "invalid value for kzg commitment: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "KzgCommitmentShort",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0x9a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "incorrect length 47 for kzg commitment",
+		},
+		{
+			name:  "VersionedHashMissing",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"}`),
+			err:   "versioned hash missing",
+		},
+		{
+			name:  "VersionedHashWrongType",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field blobSidecarEventJSON.versioned_hash of type string",
+		},
+		{
+			name:  "VersionedHashInvalid",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"invalid"}`),
+			err:   "invalid value for versioned hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "VersionedHashShort",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+			err:   "incorrect length 31 for versioned hash",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"block_root":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","index":"1","slot":"525277","kzg_commitment":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","versioned_hash":"0x014d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.BlobSidecarEvent
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+
+				// Confirm that the SSZ encoding round trips.
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes api.BlobSidecarEvent
+				require.NoError(t, sszRes.UnmarshalSSZ(ssz))
+				require.Equal(t, res, sszRes)
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -27,7 +27,7 @@ import (
 // BlockEvent is the data for the block event.
 type BlockEvent struct {
 	Slot                phase0.Slot
-	Block               phase0.Root
+	Block               phase0.Root `ssz-size:"32"`
 	ExecutionOptimistic bool
 }
 
@@ -103,6 +103,13 @@ func TestBlockEventJSON(t *testing.T) {
 				rt, err := json.Marshal(&res)
 				require.NoError(t, err)
 				assert.Equal(t, string(test.input), string(rt))
+
+				// Confirm that the SSZ encoding round trips.
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes api.BlockEvent
+				require.NoError(t, sszRes.UnmarshalSSZ(ssz))
+				require.Equal(t, res, sszRes)
 				assert.Equal(t, string(rt), res.String())
 			}
 		})
@@ -4,6 +4,7 @@
 package capella
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -197,7 +198,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 388 {
+	if o3 != 388 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -260,7 +261,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'AttesterSlashings'
 	{
 		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
+		num, err := codecs.DecodeDynamicLength(buf, 2)
 		if err != nil {
 			return err
 		}
@@ -282,7 +283,7 @@ func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Attestations'
 	{
 		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
+		num, err := codecs.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
 		}
@@ -28,10 +28,10 @@ import (
 type ChainReorgEvent struct {
 	Slot         phase0.Slot
 	Depth        uint64
-	OldHeadBlock phase0.Root
-	NewHeadBlock phase0.Root
-	OldHeadState phase0.Root
-	NewHeadState phase0.Root
+	OldHeadBlock phase0.Root `ssz-size:"32"`
+	NewHeadBlock phase0.Root `ssz-size:"32"`
+	OldHeadState phase0.Root `ssz-size:"32"`
+	NewHeadState phase0.Root `ssz-size:"32"`
 	Epoch        phase0.Epoch
 }
 
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// DeletedKeystores is the response of a validator client to the deletion of keystores.
+type DeletedKeystores struct {
+	// Results are the results of the deletion, in the same order as the keys requested.
+	Results []*KeyOperationResult
+	// SlashingProtection is the EIP-3076 slashing protection interchange data for the keys.
+	SlashingProtection string
+}
+
+// deletedKeystoresJSON is the spec representation of the struct.
+type deletedKeystoresJSON struct {
+	Data               []*KeyOperationResult `json:"data"`
+	SlashingProtection string                `json:"slashing_protection"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DeletedKeystores) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&deletedKeystoresJSON{
+		Data:               d.Results,
+		SlashingProtection: d.SlashingProtection,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DeletedKeystores) UnmarshalJSON(input []byte) error {
+	var deletedKeystoresJSON deletedKeystoresJSON
+	if err := json.Unmarshal(input, &deletedKeystoresJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if deletedKeystoresJSON.Data == nil {
+		return errors.New("data missing")
+	}
+	d.Results = deletedKeystoresJSON.Data
+	d.SlashingProtection = deletedKeystoresJSON.SlashingProtection
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DeletedKeystores) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
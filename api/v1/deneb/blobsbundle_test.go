@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBlobsBundleJSON(t *testing.T) {
+	commitment := "0x95cc5099bbd8420d8ebade383c00a2346dace60a7604f768cd71501757b4d72eeb7d5474a6b615af10379d69aa9f478f"
+	proof := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+	blob := "0x" + strings.Repeat("0123456789abcdef", 131072*2/16)
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type deneb.blobsBundleJSON",
+		},
+		{
+			name:  "CommitmentsMissing",
+			input: []byte(fmt.Sprintf(`{"proofs":["%s"],"blobs":["%s"]}`, proof, blob)),
+			err:   "commitments missing",
+		},
+		{
+			name:  "CommitmentInvalid",
+			input: []byte(fmt.Sprintf(`{"commitments":["invalid"],"proofs":["%s"],"blobs":["%s"]}`, proof, blob)),
+			err:   "invalid JSON: invalid prefix",
+		},
+		{
+			name:  "ProofsMissing",
+			input: []byte(fmt.Sprintf(`{"commitments":["%s"],"blobs":["%s"]}`, commitment, blob)),
+			err:   "proofs missing",
+		},
+		{
+			name:  "ProofInvalid",
+			input: []byte(fmt.Sprintf(`{"commitments":["%s"],"proofs":["0x01"],"blobs":["%s"]}`, commitment, blob)),
+			err:   "invalid JSON: incorrect length",
+		},
+		{
+			name:  "BlobsMissing",
+			input: []byte(fmt.Sprintf(`{"commitments":["%s"],"proofs":["%s"]}`, commitment, proof)),
+			err:   "blobs missing",
+		},
+		{
+			name:  "BlobInvalid",
+			input: []byte(fmt.Sprintf(`{"commitments":["%s"],"proofs":["%s"],"blobs":["0x01"]}`, commitment, proof)),
+			err:   "invalid JSON: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"commitments":["%s"],"proofs":["%s"],"blobs":["%s"]}`, commitment, proof, blob)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res deneb.BlobsBundle
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+
+				// Ensure that the bundle survives an SSZ round trip.
+				sszData, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes deneb.BlobsBundle
+				require.NoError(t, sszRes.UnmarshalSSZ(sszData))
+				require.Equal(t, res, sszRes)
+				expectedRoot, err := res.HashTreeRoot()
+				require.NoError(t, err)
+				root, err := sszRes.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, expectedRoot, root)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+)
+
+func FuzzBlindedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlindedBeaconBlock](f)
+}
+
+func FuzzBlindedBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlindedBeaconBlockBody](f)
+}
+
+func FuzzBlindedBlobSidecarUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlindedBlobSidecar](f)
+}
+
+func FuzzBlindedBlockContentsUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlindedBlockContents](f)
+}
+
+func FuzzBlobsBundleUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlobsBundle](f)
+}
+
+func FuzzBlockContentsUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlockContents](f)
+}
+
+func FuzzBuilderBidUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BuilderBid](f)
+}
+
+func FuzzExecutionPayloadAndBlobsBundleUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.ExecutionPayloadAndBlobsBundle](f)
+}
+
+func FuzzSignedBlindedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBlindedBeaconBlock](f)
+}
+
+func FuzzSignedBlindedBlobSidecarUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBlindedBlobSidecar](f)
+}
+
+func FuzzSignedBlindedBlockContentsUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBlindedBlockContents](f)
+}
+
+func FuzzSignedBlockContentsUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBlockContents](f)
+}
+
+func FuzzSignedBuilderBidUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBuilderBid](f)
+}
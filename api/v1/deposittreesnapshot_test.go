@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositTreeSnapshotJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "JSONEmpty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.depositTreeSnapshotJSON",
+		},
+		{
+			name:  "FinalizedMissing",
+			input: []byte(`{"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "finalized missing",
+		},
+		{
+			name:  "FinalizedWrongType",
+			input: []byte(`{"finalized":true,"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositTreeSnapshotJSON.finalized of type []string",
+		},
+		{
+			name:  "FinalizedInvalid",
+			input: []byte(`{"finalized":["invalid"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid value for finalized 0: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "FinalizedShort",
+			input: []byte(`{"finalized":["0x0102"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "incorrect length for finalized 0",
+		},
+		{
+			name:  "FinalizedTooMany",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101","0x0101010101010101010101010101010101010101010101010101010101010101"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "too many finalized roots",
+		},
+		{
+			name:  "DepositRootMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "deposit root missing",
+		},
+		{
+			name:  "DepositRootWrongType",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":true,"deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositTreeSnapshotJSON.deposit_root of type string",
+		},
+		{
+			name:  "DepositRootInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"invalid","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid value for deposit root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "DepositRootShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0102","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "incorrect length for deposit root",
+		},
+		{
+			name:  "DepositCountMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "deposit count missing",
+		},
+		{
+			name:  "DepositCountWrongType",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":true,"execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositTreeSnapshotJSON.deposit_count of type string",
+		},
+		{
+			name:  "DepositCountInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"-1","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+			err:   "invalid value for deposit count: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExecutionBlockHashMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_height":"100"}`),
+			err:   "execution block hash missing",
+		},
+		{
+			name:  "ExecutionBlockHashWrongType",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":true,"execution_block_height":"100"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositTreeSnapshotJSON.execution_block_hash of type string",
+		},
+		{
+			name:  "ExecutionBlockHashInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"invalid","execution_block_height":"100"}`),
+			err:   "invalid value for execution block hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ExecutionBlockHashShort",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0102","execution_block_height":"100"}`),
+			err:   "incorrect length for execution block hash",
+		},
+		{
+			name:  "ExecutionBlockHeightMissing",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303"}`),
+			err:   "execution block height missing",
+		},
+		{
+			name:  "ExecutionBlockHeightWrongType",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field depositTreeSnapshotJSON.execution_block_height of type string",
+		},
+		{
+			name:  "ExecutionBlockHeightInvalid",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"-1"}`),
+			err:   "invalid value for execution block height: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Empty",
+			input: []byte(`{"finalized":[],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"finalized":["0x0101010101010101010101010101010101010101010101010101010101010101","0x0202020202020202020202020202020202020202020202020202020202020202"],"deposit_root":"0x0404040404040404040404040404040404040404040404040404040404040404","deposit_count":"2","execution_block_hash":"0x0303030303030303030303030303030303030303030303030303030303030303","execution_block_height":"100"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.DepositTreeSnapshot
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+
+				// Confirm that the SSZ encoding round trips.
+				ssz, err := res.MarshalSSZ()
+				require.NoError(t, err)
+				var sszRes api.DepositTreeSnapshot
+				require.NoError(t, sszRes.UnmarshalSSZ(ssz))
+				require.Equal(t, res, sszRes)
+			}
+		})
+	}
+}
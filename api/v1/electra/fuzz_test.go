@@ -0,0 +1,33 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+)
+
+func FuzzBlindedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.BlindedBeaconBlock](f)
+}
+
+func FuzzBlindedBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.BlindedBeaconBlockBody](f)
+}
+
+func FuzzSignedBlindedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.SignedBlindedBeaconBlock](f)
+}
@@ -30,16 +30,34 @@ type Event struct {
 	Data interface{}
 }
 
+// Event topics.
+const (
+	EventTopicAttestation          = "attestation"
+	EventTopicBlock                = "block"
+	EventTopicChainReorg           = "chain_reorg"
+	EventTopicFinalizedCheckpoint  = "finalized_checkpoint"
+	EventTopicHead                 = "head"
+	EventTopicVoluntaryExit        = "voluntary_exit"
+	EventTopicContributionAndProof = "contribution_and_proof"
+	EventTopicPayloadAttributes    = "payload_attributes"
+	EventTopicBlobSidecar          = "blob_sidecar"
+	EventTopicProposerSlashing     = "proposer_slashing"
+	EventTopicAttesterSlashing     = "attester_slashing"
+)
+
 // SupportedEventTopics is a map of supported event topics.
 var SupportedEventTopics = map[string]bool{
-	"attestation":            true,
-	"block":                  true,
-	"chain_reorg":            true,
-	"finalized_checkpoint":   true,
-	"head":                   true,
-	"voluntary_exit":         true,
-	"contribution_and_proof": true,
-	"payload_attributes":     true,
+	EventTopicAttestation:          true,
+	EventTopicBlock:                true,
+	EventTopicChainReorg:           true,
+	EventTopicFinalizedCheckpoint:  true,
+	EventTopicHead:                 true,
+	EventTopicVoluntaryExit:        true,
+	EventTopicContributionAndProof: true,
+	EventTopicPayloadAttributes:    true,
+	EventTopicBlobSidecar:          true,
+	EventTopicProposerSlashing:     true,
+	EventTopicAttesterSlashing:     true,
 }
 
 // eventJSON is the spec representation of the struct.
@@ -83,22 +101,28 @@ func (e *Event) UnmarshalJSON(input []byte) error {
 		return errors.New("data missing")
 	}
 	switch eventJSON.Topic {
-	case "attestation":
+	case EventTopicAttestation:
 		e.Data = &phase0.Attestation{}
-	case "block":
+	case EventTopicBlock:
 		e.Data = &BlockEvent{}
-	case "chain_reorg":
+	case EventTopicChainReorg:
 		e.Data = &ChainReorgEvent{}
-	case "finalized_checkpoint":
+	case EventTopicFinalizedCheckpoint:
 		e.Data = &FinalizedCheckpointEvent{}
-	case "head":
+	case EventTopicHead:
 		e.Data = &HeadEvent{}
-	case "voluntary_exit":
+	case EventTopicVoluntaryExit:
 		e.Data = &phase0.SignedVoluntaryExit{}
-	case "contribution_and_proof":
+	case EventTopicContributionAndProof:
 		e.Data = &altair.SignedContributionAndProof{}
-	case "payload_attributes":
+	case EventTopicPayloadAttributes:
 		e.Data = &PayloadAttributesEvent{}
+	case EventTopicBlobSidecar:
+		e.Data = &BlobSidecarEvent{}
+	case EventTopicProposerSlashing:
+		e.Data = &phase0.ProposerSlashing{}
+	case EventTopicAttesterSlashing:
+		e.Data = &phase0.AttesterSlashing{}
 	default:
 		return fmt.Errorf("unsupported event topic %s", eventJSON.Topic)
 	}
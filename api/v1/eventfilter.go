@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EventFilter is a predicate evaluated against each event of a subscription before it is
+// passed to the handler.  It returns true if the event should be passed on.
+type EventFilter func(event *Event) bool
+
+// MatchesEventFilters returns true if the event passes all of the supplied filters.
+func MatchesEventFilters(event *Event, filters []EventFilter) bool {
+	for _, filter := range filters {
+		if !filter(event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TopicsEventFilter passes only events with the given topics.
+func TopicsEventFilter(topics ...string) EventFilter {
+	wanted := make(map[string]struct{}, len(topics))
+	for _, topic := range topics {
+		wanted[topic] = struct{}{}
+	}
+
+	return func(event *Event) bool {
+		_, exists := wanted[event.Topic]
+
+		return exists
+	}
+}
+
+// CommitteesEventFilter passes only attestations for the given committee indices.
+// Events for other topics are passed on.
+func CommitteesEventFilter(indices ...phase0.CommitteeIndex) EventFilter {
+	wanted := make(map[phase0.CommitteeIndex]struct{}, len(indices))
+	for _, index := range indices {
+		wanted[index] = struct{}{}
+	}
+
+	return func(event *Event) bool {
+		attestation, isAttestation := event.Data.(*phase0.Attestation)
+		if !isAttestation {
+			return true
+		}
+		if attestation.Data == nil {
+			return false
+		}
+		_, exists := wanted[attestation.Data.Index]
+
+		return exists
+	}
+}
+
+// ProposersEventFilter passes only events for the given proposer indices.  This applies to
+// payload attributes events and proposer slashings; events for other topics are passed on.
+// Note that head and block events do not carry the index of their proposer, so cannot be
+// filtered in this way.
+func ProposersEventFilter(indices ...phase0.ValidatorIndex) EventFilter {
+	wanted := make(map[phase0.ValidatorIndex]struct{}, len(indices))
+	for _, index := range indices {
+		wanted[index] = struct{}{}
+	}
+
+	return func(event *Event) bool {
+		var proposerIndex phase0.ValidatorIndex
+		switch data := event.Data.(type) {
+		case *PayloadAttributesEvent:
+			if data.Data == nil {
+				return false
+			}
+			proposerIndex = data.Data.ProposerIndex
+		case *phase0.ProposerSlashing:
+			if data.SignedHeader1 == nil || data.SignedHeader1.Message == nil {
+				return false
+			}
+			proposerIndex = data.SignedHeader1.Message.ProposerIndex
+		default:
+			return true
+		}
+		_, exists := wanted[proposerIndex]
+
+		return exists
+	}
+}
+
+// ValidatorsEventFilter passes only voluntary exits for the given validator indices.
+// Events for other topics are passed on.
+func ValidatorsEventFilter(indices ...phase0.ValidatorIndex) EventFilter {
+	wanted := make(map[phase0.ValidatorIndex]struct{}, len(indices))
+	for _, index := range indices {
+		wanted[index] = struct{}{}
+	}
+
+	return func(event *Event) bool {
+		voluntaryExit, isVoluntaryExit := event.Data.(*phase0.SignedVoluntaryExit)
+		if !isVoluntaryExit {
+			return true
+		}
+		if voluntaryExit.Message == nil {
+			return false
+		}
+		_, exists := wanted[voluntaryExit.Message.ValidatorIndex]
+
+		return exists
+	}
+}
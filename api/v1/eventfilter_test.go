@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFilters(t *testing.T) {
+	head := &api.Event{
+		Topic: api.EventTopicHead,
+		Data:  &api.HeadEvent{Slot: 1},
+	}
+	attestation := &api.Event{
+		Topic: api.EventTopicAttestation,
+		Data: &phase0.Attestation{
+			Data: &phase0.AttestationData{Index: 3},
+		},
+	}
+	payloadAttributes := &api.Event{
+		Topic: api.EventTopicPayloadAttributes,
+		Data: &api.PayloadAttributesEvent{
+			Data: &api.PayloadAttributesData{ProposerIndex: 5},
+		},
+	}
+	proposerSlashing := &api.Event{
+		Topic: api.EventTopicProposerSlashing,
+		Data: &phase0.ProposerSlashing{
+			SignedHeader1: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{ProposerIndex: 6},
+			},
+		},
+	}
+	voluntaryExit := &api.Event{
+		Topic: api.EventTopicVoluntaryExit,
+		Data: &phase0.SignedVoluntaryExit{
+			Message: &phase0.VoluntaryExit{ValidatorIndex: 7},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		event   *api.Event
+		filters []api.EventFilter
+		matches bool
+	}{
+		{
+			name:    "NoFilters",
+			event:   head,
+			matches: true,
+		},
+		{
+			name:    "TopicsMatch",
+			event:   head,
+			filters: []api.EventFilter{api.TopicsEventFilter(api.EventTopicBlock, api.EventTopicHead)},
+			matches: true,
+		},
+		{
+			name:    "TopicsNoMatch",
+			event:   head,
+			filters: []api.EventFilter{api.TopicsEventFilter(api.EventTopicBlock)},
+			matches: false,
+		},
+		{
+			name:    "CommitteesMatch",
+			event:   attestation,
+			filters: []api.EventFilter{api.CommitteesEventFilter(1, 3)},
+			matches: true,
+		},
+		{
+			name:    "CommitteesNoMatch",
+			event:   attestation,
+			filters: []api.EventFilter{api.CommitteesEventFilter(1, 2)},
+			matches: false,
+		},
+		{
+			name:    "CommitteesOtherTopic",
+			event:   head,
+			filters: []api.EventFilter{api.CommitteesEventFilter(1, 2)},
+			matches: true,
+		},
+		{
+			name:    "ProposersPayloadAttributesMatch",
+			event:   payloadAttributes,
+			filters: []api.EventFilter{api.ProposersEventFilter(5)},
+			matches: true,
+		},
+		{
+			name:    "ProposersPayloadAttributesNoMatch",
+			event:   payloadAttributes,
+			filters: []api.EventFilter{api.ProposersEventFilter(6)},
+			matches: false,
+		},
+		{
+			name:    "ProposersProposerSlashingMatch",
+			event:   proposerSlashing,
+			filters: []api.EventFilter{api.ProposersEventFilter(6)},
+			matches: true,
+		},
+		{
+			name:    "ProposersOtherTopic",
+			event:   head,
+			filters: []api.EventFilter{api.ProposersEventFilter(6)},
+			matches: true,
+		},
+		{
+			name:    "ValidatorsMatch",
+			event:   voluntaryExit,
+			filters: []api.EventFilter{api.ValidatorsEventFilter(7)},
+			matches: true,
+		},
+		{
+			name:    "ValidatorsNoMatch",
+			event:   voluntaryExit,
+			filters: []api.EventFilter{api.ValidatorsEventFilter(8)},
+			matches: false,
+		},
+		{
+			name:  "MultipleFilters",
+			event: attestation,
+			filters: []api.EventFilter{
+				api.TopicsEventFilter(api.EventTopicAttestation),
+				api.CommitteesEventFilter(4),
+			},
+			matches: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.matches, api.MatchesEventFilters(test.event, test.filters))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ExpectedWithdrawal is a withdrawal expected to be included in the next block built on a state.
+type ExpectedWithdrawal struct {
+	// Index is the index of the withdrawal.
+	Index capella.WithdrawalIndex
+	// ValidatorIndex is the index of the validator from which the withdrawal is made.
+	ValidatorIndex phase0.ValidatorIndex
+	// Address is the execution address to which the withdrawal is paid.
+	Address bellatrix.ExecutionAddress
+	// Amount is the amount of the withdrawal.
+	Amount phase0.Gwei
+}
+
+// expectedWithdrawalJSON is the spec representation of the struct.
+type expectedWithdrawalJSON struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExpectedWithdrawal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&expectedWithdrawalJSON{
+		Index:          fmt.Sprintf("%d", e.Index),
+		ValidatorIndex: fmt.Sprintf("%d", e.ValidatorIndex),
+		Address:        fmt.Sprintf("%#x", e.Address),
+		Amount:         fmt.Sprintf("%d", e.Amount),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExpectedWithdrawal) UnmarshalJSON(input []byte) error {
+	var expectedWithdrawalJSON expectedWithdrawalJSON
+	if err := json.Unmarshal(input, &expectedWithdrawalJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if expectedWithdrawalJSON.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(expectedWithdrawalJSON.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	e.Index = capella.WithdrawalIndex(index)
+
+	if expectedWithdrawalJSON.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(expectedWithdrawalJSON.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	e.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+
+	if expectedWithdrawalJSON.Address == "" {
+		return errors.New("address missing")
+	}
+	address, err := hex.DecodeString(strings.TrimPrefix(expectedWithdrawalJSON.Address, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for address")
+	}
+	if len(address) != bellatrix.ExecutionAddressLength {
+		return errors.New("incorrect length for address")
+	}
+	copy(e.Address[:], address)
+
+	if expectedWithdrawalJSON.Amount == "" {
+		return errors.New("amount missing")
+	}
+	amount, err := strconv.ParseUint(expectedWithdrawalJSON.Amount, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for amount")
+	}
+	e.Amount = phase0.Gwei(amount)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (e *ExpectedWithdrawal) String() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
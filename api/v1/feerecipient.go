@@ -0,0 +1,88 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// FeeRecipient is the fee recipient configured for a validator in a validator client.
+type FeeRecipient struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// EthAddress is the execution address to which fees are sent.
+	EthAddress bellatrix.ExecutionAddress
+}
+
+// feeRecipientJSON is the spec representation of the struct.
+type feeRecipientJSON struct {
+	Pubkey     string `json:"pubkey"`
+	EthAddress string `json:"ethaddress"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FeeRecipient) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&feeRecipientJSON{
+		Pubkey:     fmt.Sprintf("%#x", f.Pubkey),
+		EthAddress: f.EthAddress.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FeeRecipient) UnmarshalJSON(input []byte) error {
+	var feeRecipientJSON feeRecipientJSON
+	if err := json.Unmarshal(input, &feeRecipientJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if feeRecipientJSON.Pubkey == "" {
+		return errors.New("pubkey missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(feeRecipientJSON.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for pubkey")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return errors.New("incorrect length for pubkey")
+	}
+	copy(f.Pubkey[:], pubKey)
+	if feeRecipientJSON.EthAddress == "" {
+		return errors.New("eth address missing")
+	}
+	ethAddress, err := hex.DecodeString(strings.TrimPrefix(feeRecipientJSON.EthAddress, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for eth address")
+	}
+	if len(ethAddress) != bellatrix.ExecutionAddressLength {
+		return errors.New("incorrect length for eth address")
+	}
+	copy(f.EthAddress[:], ethAddress)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *FeeRecipient) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeRecipientJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.feeRecipientJSON",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"ethaddress":"0xabCDeF0123456789AbcdEf0123456789aBCDEF01"}`),
+			err:   "pubkey missing",
+		},
+		{
+			name:  "PubkeyWrongType",
+			input: []byte(`{"pubkey":true,"ethaddress":"0xabCDeF0123456789AbcdEf0123456789aBCDEF01"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field feeRecipientJSON.pubkey of type string",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(`{"pubkey":"invalid","ethaddress":"0xabCDeF0123456789AbcdEf0123456789aBCDEF01"}`),
+			err:   "invalid value for pubkey: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e4","ethaddress":"0xabCDeF0123456789AbcdEf0123456789aBCDEF01"}`),
+			err:   "incorrect length for pubkey",
+		},
+		{
+			name:  "EthAddressMissing",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"}`),
+			err:   "eth address missing",
+		},
+		{
+			name:  "EthAddressWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","ethaddress":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field feeRecipientJSON.ethaddress of type string",
+		},
+		{
+			name:  "EthAddressInvalid",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","ethaddress":"invalid"}`),
+			err:   "invalid value for eth address: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "EthAddressShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","ethaddress":"0xabcdef0123456789abcdef0123456789abcdef"}`),
+			err:   "incorrect length for eth address",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","ethaddress":"0xabCDeF0123456789AbcdEf0123456789aBCDEF01"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.FeeRecipient
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -26,8 +26,8 @@ import (
 
 // FinalizedCheckpointEvent is the data for the finalized checkpoint event.
 type FinalizedCheckpointEvent struct {
-	Block phase0.Root
-	State phase0.Root
+	Block phase0.Root `ssz-size:"32"`
+	State phase0.Root `ssz-size:"32"`
 	Epoch phase0.Epoch
 }
 
@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+)
+
+func FuzzAttesterDutyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.AttesterDuty](f)
+}
+
+func FuzzBlobSidecarEventUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.BlobSidecarEvent](f)
+}
+
+func FuzzBlockEventUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.BlockEvent](f)
+}
+
+func FuzzChainReorgEventUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.ChainReorgEvent](f)
+}
+
+func FuzzDepositTreeSnapshotUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.DepositTreeSnapshot](f)
+}
+
+func FuzzFinalityUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.Finality](f)
+}
+
+func FuzzFinalizedCheckpointEventUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.FinalizedCheckpointEvent](f)
+}
+
+func FuzzHeadEventUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.HeadEvent](f)
+}
+
+func FuzzProposerDutyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.ProposerDuty](f)
+}
+
+func FuzzSignedValidatorRegistrationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.SignedValidatorRegistration](f)
+}
+
+func FuzzSyncCommitteeDutyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.SyncCommitteeDuty](f)
+}
+
+func FuzzValidatorUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.Validator](f)
+}
+
+func FuzzValidatorRegistrationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[api.ValidatorRegistration](f)
+}
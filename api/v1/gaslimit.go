@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// GasLimit is the gas limit configured for a validator in a validator client.
+type GasLimit struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// GasLimit is the gas limit requested for blocks proposed by the validator.
+	GasLimit uint64
+}
+
+// gasLimitJSON is the spec representation of the struct.
+type gasLimitJSON struct {
+	Pubkey   string `json:"pubkey"`
+	GasLimit string `json:"gas_limit"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *GasLimit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&gasLimitJSON{
+		Pubkey:   fmt.Sprintf("%#x", g.Pubkey),
+		GasLimit: fmt.Sprintf("%d", g.GasLimit),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *GasLimit) UnmarshalJSON(input []byte) error {
+	var gasLimitJSON gasLimitJSON
+	if err := json.Unmarshal(input, &gasLimitJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if gasLimitJSON.Pubkey == "" {
+		return errors.New("pubkey missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(gasLimitJSON.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for pubkey")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return errors.New("incorrect length for pubkey")
+	}
+	copy(g.Pubkey[:], pubKey)
+	if gasLimitJSON.GasLimit == "" {
+		return errors.New("gas limit missing")
+	}
+	g.GasLimit, err = strconv.ParseUint(gasLimitJSON.GasLimit, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (g *GasLimit) String() string {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
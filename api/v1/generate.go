@@ -14,6 +14,8 @@
 package v1
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
-//go:generate rm -f signedvalidatorregistration_ssz.go validatorregistration_ssz.go
-//go:generate sszgen -suffix ssz -include ../../spec/phase0,../../spec/altair,../../spec/bellatrix -path . -objs SignedValidatorRegistration,ValidatorRegistration
-//go:generate goimports -w signedvalidatorregistration_ssz.go validatorregistration_ssz.go
+// validator_ssz.go is written by hand, as sszgen cannot encode ValidatorState.
+//go:generate rm -f attesterduty_ssz.go blobsidecarevent_ssz.go blockevent_ssz.go chainreorgevent_ssz.go deposittreesnapshot_ssz.go finality_ssz.go finalizedcheckpointevent_ssz.go headevent_ssz.go proposerduty_ssz.go signedvalidatorregistration_ssz.go synccommitteeduty_ssz.go validatorregistration_ssz.go
+//go:generate sszgen -suffix ssz -include ../../spec/phase0,../../spec/altair,../../spec/bellatrix,../../spec/deneb -path . -objs AttesterDuty,BlobSidecarEvent,BlockEvent,ChainReorgEvent,DepositTreeSnapshot,Finality,FinalizedCheckpointEvent,HeadEvent,ProposerDuty,SignedValidatorRegistration,SyncCommitteeDuty,ValidatorRegistration
+//go:generate go run ../../internal/sszpatch attesterduty_ssz.go blobsidecarevent_ssz.go blockevent_ssz.go chainreorgevent_ssz.go deposittreesnapshot_ssz.go finality_ssz.go finalizedcheckpointevent_ssz.go headevent_ssz.go proposerduty_ssz.go signedvalidatorregistration_ssz.go synccommitteeduty_ssz.go validatorregistration_ssz.go
+//go:generate goimports -w attesterduty_ssz.go blobsidecarevent_ssz.go blockevent_ssz.go chainreorgevent_ssz.go deposittreesnapshot_ssz.go finality_ssz.go finalizedcheckpointevent_ssz.go headevent_ssz.go proposerduty_ssz.go signedvalidatorregistration_ssz.go synccommitteeduty_ssz.go validatorregistration_ssz.go
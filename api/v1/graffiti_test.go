@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraffitiJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.graffitiJSON",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"graffiti":"hello"}`),
+			err:   "pubkey missing",
+		},
+		{
+			name:  "PubkeyWrongType",
+			input: []byte(`{"pubkey":true,"graffiti":"hello"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field graffitiJSON.pubkey of type string",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(`{"pubkey":"invalid","graffiti":"hello"}`),
+			err:   "invalid value for pubkey: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e4","graffiti":"hello"}`),
+			err:   "incorrect length for pubkey",
+		},
+		{
+			name:  "GraffitiWrongType",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","graffiti":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field graffitiJSON.graffiti of type string",
+		},
+		{
+			name:  "GraffitiTooLong",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","graffiti":"this graffiti is far too long to fit"}`),
+			err:   "graffiti too long",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","graffiti":"hello"}`),
+		},
+		{
+			name:  "GoodEmptyGraffiti",
+			input: []byte(`{"pubkey":"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c","graffiti":""}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.Graffiti
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
@@ -28,11 +28,11 @@ import (
 // HeadEvent is the data for the head event.
 type HeadEvent struct {
 	Slot                      phase0.Slot
-	Block                     phase0.Root
-	State                     phase0.Root
+	Block                     phase0.Root `ssz-size:"32"`
+	State                     phase0.Root `ssz-size:"32"`
 	EpochTransition           bool
-	CurrentDutyDependentRoot  phase0.Root
-	PreviousDutyDependentRoot phase0.Root
+	CurrentDutyDependentRoot  phase0.Root `ssz-size:"32"`
+	PreviousDutyDependentRoot phase0.Root `ssz-size:"32"`
 }
 
 // headEventJSON is the spec representation of the struct.
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// KeyOperationStatus is the status of an operation on a key carried out by the keymanager API.
+type KeyOperationStatus string
+
+const (
+	// KeyOperationStatusImported means that the key was imported.
+	KeyOperationStatusImported KeyOperationStatus = "imported"
+	// KeyOperationStatusDuplicate means that the key was already present.
+	KeyOperationStatusDuplicate KeyOperationStatus = "duplicate"
+	// KeyOperationStatusDeleted means that the key was deleted.
+	KeyOperationStatusDeleted KeyOperationStatus = "deleted"
+	// KeyOperationStatusNotActive means that the key was not active, but slashing
+	// protection data for it was present.
+	KeyOperationStatusNotActive KeyOperationStatus = "not_active"
+	// KeyOperationStatusNotFound means that the key was not found.
+	KeyOperationStatusNotFound KeyOperationStatus = "not_found"
+	// KeyOperationStatusError means that the operation on the key failed.
+	KeyOperationStatusError KeyOperationStatus = "error"
+)
+
+// KeyOperationResult is the result of an operation on a single key carried out by the keymanager API.
+type KeyOperationResult struct {
+	// Status is the status of the operation.
+	Status KeyOperationStatus
+	// Message provides further information about the operation, if available.
+	Message string
+}
+
+// keyOperationResultJSON is the spec representation of the struct.
+type keyOperationResultJSON struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k *KeyOperationResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&keyOperationResultJSON{
+		Status:  string(k.Status),
+		Message: k.Message,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *KeyOperationResult) UnmarshalJSON(input []byte) error {
+	var keyOperationResultJSON keyOperationResultJSON
+	if err := json.Unmarshal(input, &keyOperationResultJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if keyOperationResultJSON.Status == "" {
+		return errors.New("status missing")
+	}
+	status := KeyOperationStatus(keyOperationResultJSON.Status)
+	switch status {
+	case KeyOperationStatusImported,
+		KeyOperationStatusDuplicate,
+		KeyOperationStatusDeleted,
+		KeyOperationStatusNotActive,
+		KeyOperationStatusNotFound,
+		KeyOperationStatusError:
+		k.Status = status
+	default:
+		return fmt.Errorf("unrecognised status %s", keyOperationResultJSON.Status)
+	}
+	k.Message = keyOperationResultJSON.Message
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (k *KeyOperationResult) String() string {
+	data, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Keystore is a keystore held locally by a validator client.
+type Keystore struct {
+	// ValidatingPubkey is the public key of the validator.
+	ValidatingPubkey phase0.BLSPubKey
+	// DerivationPath is the derivation path of the key, if known.
+	DerivationPath string
+	// Readonly is true if the keystore cannot be deleted through the keymanager API.
+	Readonly bool
+}
+
+// keystoreJSON is the spec representation of the struct.
+type keystoreJSON struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path,omitempty"`
+	Readonly         bool   `json:"readonly"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (k *Keystore) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&keystoreJSON{
+		ValidatingPubkey: fmt.Sprintf("%#x", k.ValidatingPubkey),
+		DerivationPath:   k.DerivationPath,
+		Readonly:         k.Readonly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (k *Keystore) UnmarshalJSON(input []byte) error {
+	var keystoreJSON keystoreJSON
+	if err := json.Unmarshal(input, &keystoreJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if keystoreJSON.ValidatingPubkey == "" {
+		return errors.New("validating pubkey missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(keystoreJSON.ValidatingPubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validating pubkey")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return errors.New("incorrect length for validating pubkey")
+	}
+	copy(k.ValidatingPubkey[:], pubKey)
+	k.DerivationPath = keystoreJSON.DerivationPath
+	k.Readonly = keystoreJSON.Readonly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (k *Keystore) String() string {
+	data, err := json.Marshal(k)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
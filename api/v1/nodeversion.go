@@ -0,0 +1,196 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NodeClient is a beacon node client implementation.
+type NodeClient int
+
+const (
+	// NodeClientUnknown is an unknown client.
+	NodeClientUnknown NodeClient = iota
+	// NodeClientGrandine is the Grandine client.
+	NodeClientGrandine
+	// NodeClientLighthouse is the Lighthouse client.
+	NodeClientLighthouse
+	// NodeClientLodestar is the Lodestar client.
+	NodeClientLodestar
+	// NodeClientNimbus is the Nimbus client.
+	NodeClientNimbus
+	// NodeClientPrysm is the Prysm client.
+	NodeClientPrysm
+	// NodeClientTeku is the Teku client.
+	NodeClientTeku
+)
+
+var nodeClientStrings = [...]string{
+	"unknown",
+	"grandine",
+	"lighthouse",
+	"lodestar",
+	"nimbus",
+	"prysm",
+	"teku",
+}
+
+// String returns a string representation of the client.
+func (c NodeClient) String() string {
+	if c < 0 || int(c) >= len(nodeClientStrings) {
+		return "unknown"
+	}
+	return nodeClientStrings[c]
+}
+
+// NodeVersion is a node version string parsed in to its component parts.
+// Components that are not present in the version string are left empty.
+type NodeVersion struct {
+	// Raw is the version string as supplied by the node.
+	Raw string
+	// Client is the client implementation.
+	Client NodeClient
+	// Version is the semantic version of the client, without a leading "v".
+	Version string
+	// Major is the major component of the semantic version.
+	Major uint64
+	// Minor is the minor component of the semantic version.
+	Minor uint64
+	// Patch is the patch component of the semantic version.
+	Patch uint64
+	// PreRelease is the pre-release component of the semantic version, if any.
+	PreRelease string
+	// Commit is the source control commit from which the client was built, if supplied.
+	Commit string
+	// Platform is the platform on which the client is running, if supplied.
+	Platform string
+}
+
+var (
+	semverRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)(.*)$`)
+	commitRegex = regexp.MustCompile(`^[0-9a-f]{6,40}\+?$`)
+)
+
+// ParseNodeVersion parses a node version string.
+// Version strings are generally of the form "client/version/platform", for example
+// "Lighthouse/v4.5.0-441fc16/x86_64-linux", however there is variation between clients.
+// Parsing is best effort; any parts that cannot be identified are left empty.
+func ParseNodeVersion(input string) *NodeVersion {
+	res := &NodeVersion{
+		Raw: input,
+	}
+
+	// Prysm supplies its platform in parentheses.
+	if start := strings.Index(input, "("); start != -1 {
+		if end := strings.LastIndex(input, ")"); end > start {
+			res.Platform = strings.TrimSpace(input[start+1 : end])
+			input = strings.TrimSpace(input[:start])
+		}
+	}
+
+	parts := strings.Split(input, "/")
+	res.Client = parseNodeClient(parts[0])
+	if len(parts) < 2 {
+		return res
+	}
+
+	res.parseVersion(parts[1])
+
+	for _, part := range parts[2:] {
+		switch {
+		case part == "":
+			// Nothing to do.
+		case res.Commit == "" && commitRegex.MatchString(part):
+			res.Commit = strings.TrimSuffix(part, "+")
+		case res.Platform == "":
+			res.Platform = part
+		}
+	}
+
+	return res
+}
+
+// parseNodeClient parses the client name.
+func parseNodeClient(input string) NodeClient {
+	input = strings.ToLower(input)
+	for i := range nodeClientStrings {
+		if NodeClient(i) != NodeClientUnknown && strings.HasPrefix(input, nodeClientStrings[i]) {
+			return NodeClient(i)
+		}
+	}
+
+	return NodeClientUnknown
+}
+
+// parseVersion parses the version component of a version string.
+func (v *NodeVersion) parseVersion(input string) {
+	match := semverRegex.FindStringSubmatch(input)
+	if match == nil {
+		return
+	}
+	// Errors are not possible here, as the regular expression ensures the values are numeric.
+	v.Major, _ = strconv.ParseUint(match[1], 10, 64)
+	v.Minor, _ = strconv.ParseUint(match[2], 10, 64)
+	v.Patch, _ = strconv.ParseUint(match[3], 10, 64)
+	v.Version = fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	// Anything following the version is a combination of pre-release and commit.
+	remainder := strings.TrimPrefix(match[4], "-")
+	preRelease := make([]string, 0)
+	for _, component := range strings.Split(remainder, "-") {
+		switch {
+		case component == "":
+			// Nothing to do.
+		case v.Commit == "" && commitRegex.MatchString(component):
+			v.Commit = strings.TrimSuffix(component, "+")
+		case v.Commit == "":
+			// Components after the commit are build information rather than pre-release.
+			preRelease = append(preRelease, component)
+		}
+	}
+	if len(preRelease) > 0 {
+		v.PreRelease = strings.Join(preRelease, "-")
+		v.Version = fmt.Sprintf("%s-%s", v.Version, v.PreRelease)
+	}
+}
+
+// IsClient returns true if the node is running the given client.
+func (v *NodeVersion) IsClient(client NodeClient) bool {
+	return v != nil && v.Client == client
+}
+
+// AtLeast returns true if the node's semantic version is at least the given version.
+// Pre-release information is ignored.
+func (v *NodeVersion) AtLeast(major uint64, minor uint64, patch uint64) bool {
+	if v == nil {
+		return false
+	}
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+
+	return v.Patch >= patch
+}
+
+// String returns a string representation of the struct.
+func (v *NodeVersion) String() string {
+	return v.Raw
+}
@@ -0,0 +1,176 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *api.NodeVersion
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			expected: &api.NodeVersion{
+				Client: api.NodeClientUnknown,
+			},
+		},
+		{
+			name:  "Unknown",
+			input: "mock",
+			expected: &api.NodeVersion{
+				Raw:    "mock",
+				Client: api.NodeClientUnknown,
+			},
+		},
+		{
+			name:  "Lighthouse",
+			input: "Lighthouse/v4.5.0-441fc16/x86_64-linux",
+			expected: &api.NodeVersion{
+				Raw:      "Lighthouse/v4.5.0-441fc16/x86_64-linux",
+				Client:   api.NodeClientLighthouse,
+				Version:  "4.5.0",
+				Major:    4,
+				Minor:    5,
+				Patch:    0,
+				Commit:   "441fc16",
+				Platform: "x86_64-linux",
+			},
+		},
+		{
+			name:  "LighthouseDirty",
+			input: "Lighthouse/v4.5.0-441fc16+/x86_64-linux",
+			expected: &api.NodeVersion{
+				Raw:      "Lighthouse/v4.5.0-441fc16+/x86_64-linux",
+				Client:   api.NodeClientLighthouse,
+				Version:  "4.5.0",
+				Major:    4,
+				Minor:    5,
+				Patch:    0,
+				Commit:   "441fc16",
+				Platform: "x86_64-linux",
+			},
+		},
+		{
+			name:  "LighthousePreRelease",
+			input: "Lighthouse/v4.6.0-rc.0-51a1d2e/aarch64-linux",
+			expected: &api.NodeVersion{
+				Raw:        "Lighthouse/v4.6.0-rc.0-51a1d2e/aarch64-linux",
+				Client:     api.NodeClientLighthouse,
+				Version:    "4.6.0-rc.0",
+				Major:      4,
+				Minor:      6,
+				Patch:      0,
+				PreRelease: "rc.0",
+				Commit:     "51a1d2e",
+				Platform:   "aarch64-linux",
+			},
+		},
+		{
+			name:  "Teku",
+			input: "teku/v23.10.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17",
+			expected: &api.NodeVersion{
+				Raw:      "teku/v23.10.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17",
+				Client:   api.NodeClientTeku,
+				Version:  "23.10.0",
+				Major:    23,
+				Minor:    10,
+				Patch:    0,
+				Platform: "linux-x86_64",
+			},
+		},
+		{
+			name:  "Prysm",
+			input: "Prysm/v4.1.1 (linux amd64)",
+			expected: &api.NodeVersion{
+				Raw:      "Prysm/v4.1.1 (linux amd64)",
+				Client:   api.NodeClientPrysm,
+				Version:  "4.1.1",
+				Major:    4,
+				Minor:    1,
+				Patch:    1,
+				Platform: "linux amd64",
+			},
+		},
+		{
+			name:  "Nimbus",
+			input: "Nimbus/v23.10.0-8b07f4-stateofus",
+			expected: &api.NodeVersion{
+				Raw:     "Nimbus/v23.10.0-8b07f4-stateofus",
+				Client:  api.NodeClientNimbus,
+				Version: "23.10.0",
+				Major:   23,
+				Minor:   10,
+				Patch:   0,
+				Commit:  "8b07f4",
+			},
+		},
+		{
+			name:  "Lodestar",
+			input: "Lodestar/v1.12.0/80c248b",
+			expected: &api.NodeVersion{
+				Raw:     "Lodestar/v1.12.0/80c248b",
+				Client:  api.NodeClientLodestar,
+				Version: "1.12.0",
+				Major:   1,
+				Minor:   12,
+				Patch:   0,
+				Commit:  "80c248b",
+			},
+		},
+		{
+			name:  "Grandine",
+			input: "Grandine/0.3.0-6dfb4d8/x86_64-linux",
+			expected: &api.NodeVersion{
+				Raw:      "Grandine/0.3.0-6dfb4d8/x86_64-linux",
+				Client:   api.NodeClientGrandine,
+				Version:  "0.3.0",
+				Major:    0,
+				Minor:    3,
+				Patch:    0,
+				Commit:   "6dfb4d8",
+				Platform: "x86_64-linux",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, api.ParseNodeVersion(test.input))
+		})
+	}
+}
+
+func TestNodeVersionHelpers(t *testing.T) {
+	version := api.ParseNodeVersion("Lighthouse/v4.5.0-441fc16/x86_64-linux")
+
+	require.True(t, version.IsClient(api.NodeClientLighthouse))
+	require.False(t, version.IsClient(api.NodeClientTeku))
+	require.True(t, version.AtLeast(4, 5, 0))
+	require.True(t, version.AtLeast(4, 4, 9))
+	require.True(t, version.AtLeast(3, 9, 9))
+	require.False(t, version.AtLeast(4, 5, 1))
+	require.False(t, version.AtLeast(5, 0, 0))
+
+	var nilVersion *api.NodeVersion
+	require.False(t, nilVersion.IsClient(api.NodeClientUnknown))
+	require.False(t, nilVersion.AtLeast(0, 0, 0))
+}
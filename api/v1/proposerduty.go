@@ -26,7 +26,7 @@ import (
 
 // ProposerDuty represents a duty of a validator to propose a slot.
 type ProposerDuty struct {
-	PubKey         phase0.BLSPubKey
+	PubKey         phase0.BLSPubKey `ssz-size:"48"`
 	Slot           phase0.Slot
 	ValidatorIndex phase0.ValidatorIndex
 }
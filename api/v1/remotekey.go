@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RemoteKey is a key held by a remote signer on behalf of a validator client.
+type RemoteKey struct {
+	// Pubkey is the public key of the validator.
+	Pubkey phase0.BLSPubKey
+	// URL is the URL of the remote signer holding the key.
+	URL string
+	// Readonly is true if the key cannot be deleted through the keymanager API.
+	Readonly bool
+}
+
+// remoteKeyJSON is the spec representation of the struct.
+type remoteKeyJSON struct {
+	Pubkey   string `json:"pubkey"`
+	URL      string `json:"url"`
+	Readonly bool   `json:"readonly"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *RemoteKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&remoteKeyJSON{
+		Pubkey:   fmt.Sprintf("%#x", r.Pubkey),
+		URL:      r.URL,
+		Readonly: r.Readonly,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RemoteKey) UnmarshalJSON(input []byte) error {
+	var remoteKeyJSON remoteKeyJSON
+	if err := json.Unmarshal(input, &remoteKeyJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if remoteKeyJSON.Pubkey == "" {
+		return errors.New("pubkey missing")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(remoteKeyJSON.Pubkey, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for pubkey")
+	}
+	if len(pubKey) != phase0.PublicKeyLength {
+		return errors.New("incorrect length for pubkey")
+	}
+	copy(r.Pubkey[:], pubKey)
+	if remoteKeyJSON.URL == "" {
+		return errors.New("url missing")
+	}
+	r.URL = remoteKeyJSON.URL
+	r.Readonly = remoteKeyJSON.Readonly
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (r *RemoteKey) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
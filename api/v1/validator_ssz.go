@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// The SSZ functions for Validator are written by hand in the style of those
+// generated by fastssz, as the generator cannot encode the int-based validator
+// state.  The state is encoded as a uint64.
+
+// MarshalSSZ ssz marshals the Validator object
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the Validator object to a target array
+func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(v.Index))
+
+	// Field (1) 'Balance'
+	dst = ssz.MarshalUint64(dst, uint64(v.Balance))
+
+	// Field (2) 'Status'
+	dst = ssz.MarshalUint64(dst, uint64(v.Status))
+
+	// Field (3) 'Validator'
+	if v.Validator == nil {
+		v.Validator = new(phase0.Validator)
+	}
+	if dst, err = v.Validator.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Validator object
+func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 145 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	v.Index = phase0.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Balance'
+	v.Balance = phase0.Gwei(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Status'
+	v.Status = ValidatorState(ssz.UnmarshallUint64(buf[16:24]))
+
+	// Field (3) 'Validator'
+	if v.Validator == nil {
+		v.Validator = new(phase0.Validator)
+	}
+	if err = v.Validator.UnmarshalSSZ(buf[24:145]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Validator object
+func (v *Validator) SizeSSZ() (size int) {
+	size = 145
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Validator object
+func (v *Validator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the Validator object with a hasher
+func (v *Validator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(v.Index))
+
+	// Field (1) 'Balance'
+	hh.PutUint64(uint64(v.Balance))
+
+	// Field (2) 'Status'
+	hh.PutUint64(uint64(v.Status))
+
+	// Field (3) 'Validator'
+	if v.Validator == nil {
+		v.Validator = new(phase0.Validator)
+	}
+	if err = v.Validator.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+
+	return
+}
+
+// GetTree ssz hashes the Validator object
+func (v *Validator) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
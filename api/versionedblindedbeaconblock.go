@@ -19,6 +19,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -30,11 +31,12 @@ type VersionedBlindedBeaconBlock struct {
 	Bellatrix *apiv1bellatrix.BlindedBeaconBlock
 	Capella   *apiv1capella.BlindedBeaconBlock
 	Deneb     *apiv1deneb.BlindedBeaconBlock
+	Electra   *apiv1electra.BlindedBeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBlindedBeaconBlock) IsEmpty() bool {
-	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the blinded beacon block.
@@ -55,6 +57,11 @@ func (v *VersionedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unsupported version")
 	}
@@ -78,6 +85,11 @@ func (v *VersionedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, er
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -101,6 +113,11 @@ func (v *VersionedBlindedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error
 			return phase0.BLSSignature{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.RANDAOReveal, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+		return v.Electra.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unsupported version")
 	}
@@ -124,29 +141,67 @@ func (v *VersionedBlindedBeaconBlock) Graffiti() ([32]byte, error) {
 			return [32]byte{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.Graffiti, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return [32]byte{}, errors.New("no electra block")
+		}
+		return v.Electra.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unsupported version")
 	}
 }
 
 // Attestations returns the attestations of the blinded beacon block.
-func (v *VersionedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+func (v *VersionedBlindedBeaconBlock) Attestations() ([]*spec.VersionedAttestation, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Bellatrix.Body.Attestations))
+		for i, attestation := range v.Bellatrix.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version:   spec.DataVersionBellatrix,
+				Bellatrix: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Capella.Body.Attestations))
+		for i, attestation := range v.Capella.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionCapella,
+				Capella: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case spec.DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Body == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Deneb.Body.Attestations))
+		for i, attestation := range v.Deneb.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionDeneb,
+				Deneb:   attestation,
+			}
+		}
+		return versionedAttestations, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Electra.Body.Attestations))
+		for i, attestation := range v.Electra.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionElectra,
+				Electra: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unsupported version")
 	}
@@ -170,6 +225,11 @@ func (v *VersionedBlindedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -193,6 +253,11 @@ func (v *VersionedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -216,6 +281,11 @@ func (v *VersionedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -239,6 +309,11 @@ func (v *VersionedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -280,6 +355,17 @@ func (v *VersionedBlindedBeaconBlock) TransactionsRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block body execution payload header")
 		}
 		return v.Deneb.Body.ExecutionPayloadHeader.TransactionsRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return phase0.Root{}, errors.New("no electra block body")
+		}
+		if v.Electra.Body.ExecutionPayloadHeader == nil {
+			return phase0.Root{}, errors.New("no electra block body execution payload header")
+		}
+		return v.Electra.Body.ExecutionPayloadHeader.TransactionsRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -321,6 +407,17 @@ func (v *VersionedBlindedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress
 			return bellatrix.ExecutionAddress{}, errors.New("no deneb block body execution payload header")
 		}
 		return v.Deneb.Body.ExecutionPayloadHeader.FeeRecipient, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra block body")
+		}
+		if v.Electra.Body.ExecutionPayloadHeader == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra block body execution payload header")
+		}
+		return v.Electra.Body.ExecutionPayloadHeader.FeeRecipient, nil
 	default:
 		return bellatrix.ExecutionAddress{}, errors.New("unsupported version")
 	}
@@ -362,6 +459,17 @@ func (v *VersionedBlindedBeaconBlock) Timestamp() (uint64, error) {
 			return 0, errors.New("no deneb block body execution payload header")
 		}
 		return v.Deneb.Body.ExecutionPayloadHeader.Timestamp, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return 0, errors.New("no electra block body")
+		}
+		if v.Electra.Body.ExecutionPayloadHeader == nil {
+			return 0, errors.New("no electra block body execution payload header")
+		}
+		return v.Electra.Body.ExecutionPayloadHeader.Timestamp, nil
 	default:
 		return 0, errors.New("unsupported version")
 	}
@@ -385,6 +493,11 @@ func (v *VersionedBlindedBeaconBlock) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
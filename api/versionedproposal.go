@@ -0,0 +1,266 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedProposal contains a versioned beacon block proposal, which may be blinded or unblinded.
+type VersionedProposal struct {
+	Version spec.DataVersion
+	// Blinded is true if the proposal contains a blinded execution payload.
+	Blinded bool
+	// ExecutionValue is the value of the execution payload in wei.
+	ExecutionValue *big.Int
+	// ConsensusValue is the consensus layer reward of the block in wei.
+	ConsensusValue   *big.Int
+	Phase0           *phase0.BeaconBlock
+	Altair           *altair.BeaconBlock
+	Bellatrix        *bellatrix.BeaconBlock
+	BellatrixBlinded *apiv1bellatrix.BlindedBeaconBlock
+	Capella          *capella.BeaconBlock
+	CapellaBlinded   *apiv1capella.BlindedBeaconBlock
+	Deneb            *deneb.BeaconBlock
+	DenebBlinded     *apiv1deneb.BlindedBeaconBlock
+	Electra          *electra.BeaconBlock
+	ElectraBlinded   *apiv1electra.BlindedBeaconBlock
+}
+
+// IsEmpty returns true if there is no proposal.
+func (v *VersionedProposal) IsEmpty() bool {
+	return v.Phase0 == nil &&
+		v.Altair == nil &&
+		v.Bellatrix == nil &&
+		v.BellatrixBlinded == nil &&
+		v.Capella == nil &&
+		v.CapellaBlinded == nil &&
+		v.Deneb == nil &&
+		v.DenebBlinded == nil &&
+		v.Electra == nil &&
+		v.ElectraBlinded == nil
+}
+
+// BeaconBlock returns the proposal as an unblinded beacon block.
+// It returns an error if the proposal is blinded.
+func (v *VersionedProposal) BeaconBlock() (*spec.VersionedBeaconBlock, error) {
+	if v.Blinded {
+		return nil, errors.New("proposal is blinded")
+	}
+
+	block := &spec.VersionedBeaconBlock{
+		Version: v.Version,
+	}
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		block.Phase0 = v.Phase0
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		block.Altair = v.Altair
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		block.Bellatrix = v.Bellatrix
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		block.Capella = v.Capella
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		block.Deneb = v.Deneb
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		block.Electra = v.Electra
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return block, nil
+}
+
+// BlindedBeaconBlock returns the proposal as a blinded beacon block.
+// It returns an error if the proposal is not blinded.
+func (v *VersionedProposal) BlindedBeaconBlock() (*VersionedBlindedBeaconBlock, error) {
+	if !v.Blinded {
+		return nil, errors.New("proposal is not blinded")
+	}
+
+	block := &VersionedBlindedBeaconBlock{
+		Version: v.Version,
+	}
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.BellatrixBlinded == nil {
+			return nil, errors.New("no bellatrix blinded block")
+		}
+		block.Bellatrix = v.BellatrixBlinded
+	case spec.DataVersionCapella:
+		if v.CapellaBlinded == nil {
+			return nil, errors.New("no capella blinded block")
+		}
+		block.Capella = v.CapellaBlinded
+	case spec.DataVersionDeneb:
+		if v.DenebBlinded == nil {
+			return nil, errors.New("no deneb blinded block")
+		}
+		block.Deneb = v.DenebBlinded
+	case spec.DataVersionElectra:
+		if v.ElectraBlinded == nil {
+			return nil, errors.New("no electra blinded block")
+		}
+		block.Electra = v.ElectraBlinded
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return block, nil
+}
+
+// Slot returns the slot of the proposal.
+func (v *VersionedProposal) Slot() (phase0.Slot, error) {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return 0, err
+		}
+
+		return block.Slot()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return block.Slot()
+}
+
+// ProposerIndex returns the proposer index of the proposal.
+func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return 0, err
+		}
+
+		return block.ProposerIndex()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return block.ProposerIndex()
+}
+
+// RandaoReveal returns the RANDAO reveal of the proposal.
+func (v *VersionedProposal) RandaoReveal() (phase0.BLSSignature, error) {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return phase0.BLSSignature{}, err
+		}
+
+		return block.RandaoReveal()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return phase0.BLSSignature{}, err
+	}
+
+	return block.RandaoReveal()
+}
+
+// Graffiti returns the graffiti of the proposal.
+func (v *VersionedProposal) Graffiti() ([32]byte, error) {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return [32]byte{}, err
+		}
+
+		return block.Graffiti()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return block.Graffiti()
+}
+
+// Root returns the root of the proposal.
+func (v *VersionedProposal) Root() (phase0.Root, error) {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return phase0.Root{}, err
+		}
+
+		return block.Root()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return phase0.Root{}, err
+	}
+
+	return block.Root()
+}
+
+// String returns a string version of the structure.
+func (v *VersionedProposal) String() string {
+	if v.Blinded {
+		block, err := v.BlindedBeaconBlock()
+		if err != nil {
+			return ""
+		}
+
+		return block.String()
+	}
+
+	block, err := v.BeaconBlock()
+	if err != nil {
+		return ""
+	}
+
+	return block.String()
+}
@@ -19,6 +19,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -29,6 +30,7 @@ type VersionedSignedBlindedBeaconBlock struct {
 	Bellatrix *apiv1bellatrix.SignedBlindedBeaconBlock
 	Capella   *apiv1capella.SignedBlindedBeaconBlock
 	Deneb     *apiv1deneb.SignedBlindedBeaconBlock
+	Electra   *apiv1electra.SignedBlindedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -49,29 +51,67 @@ func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, errors.New("unsupported version")
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
-func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*spec.VersionedAttestation, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Message.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Bellatrix.Message.Body.Attestations))
+		for i, attestation := range v.Bellatrix.Message.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version:   spec.DataVersionBellatrix,
+				Bellatrix: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Message.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Capella.Message.Body.Attestations))
+		for i, attestation := range v.Capella.Message.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionCapella,
+				Capella: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case spec.DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Message.Body.Attestations, nil
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Deneb.Message.Body.Attestations))
+		for i, attestation := range v.Deneb.Message.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionDeneb,
+				Deneb:   attestation,
+			}
+		}
+		return versionedAttestations, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttestations := make([]*spec.VersionedAttestation, len(v.Electra.Message.Body.Attestations))
+		for i, attestation := range v.Electra.Message.Body.Attestations {
+			versionedAttestations[i] = &spec.VersionedAttestation{
+				Version: spec.DataVersionElectra,
+				Electra: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unsupported version")
 	}
@@ -95,6 +135,11 @@ func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -118,6 +163,11 @@ func (v *VersionedSignedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -141,6 +191,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -164,29 +219,67 @@ func (v *VersionedSignedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
-func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*spec.VersionedAttesterSlashing, error) {
 	switch v.Version {
 	case spec.DataVersionBellatrix:
 		if v.Bellatrix == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*spec.VersionedAttesterSlashing, len(v.Bellatrix.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Bellatrix.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &spec.VersionedAttesterSlashing{
+				Version:   spec.DataVersionBellatrix,
+				Bellatrix: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case spec.DataVersionCapella:
 		if v.Capella == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*spec.VersionedAttesterSlashing, len(v.Capella.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Capella.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionCapella,
+				Capella: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case spec.DataVersionDeneb:
 		if v.Deneb == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*spec.VersionedAttesterSlashing, len(v.Deneb.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Deneb.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionDeneb,
+				Deneb:   attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttesterSlashings := make([]*spec.VersionedAttesterSlashing, len(v.Electra.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Electra.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &spec.VersionedAttesterSlashing{
+				Version: spec.DataVersionElectra,
+				Electra: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -210,6 +303,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.Propo
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -233,6 +331,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorInd
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -256,6 +359,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockHash() (phase0.Hash32,
 			return phase0.Hash32{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -279,6 +387,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockNumber() (uint64, erro
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -302,6 +415,11 @@ func (v *VersionedSignedBlindedBeaconBlock) Signature() (phase0.BLSSignature, er
 			return phase0.BLSSignature{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Signature, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+		return v.Electra.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -0,0 +1,195 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// VersionedSignedBuilderBid contains a versioned signed builder bid.
+type VersionedSignedBuilderBid struct {
+	Version   spec.DataVersion
+	Bellatrix *apiv1bellatrix.SignedBuilderBid
+	Capella   *apiv1capella.SignedBuilderBid
+	Deneb     *apiv1deneb.SignedBuilderBid
+}
+
+// IsEmpty returns true if there is no bid.
+func (v *VersionedSignedBuilderBid) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// Value returns the value of the bid.
+func (v *VersionedSignedBuilderBid) Value() (*uint256.Int, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return nil, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Message.Value, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return nil, errors.New("no capella bid")
+		}
+
+		return v.Capella.Message.Value, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil {
+			return nil, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Message.Value, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// Builder returns the public key of the builder that made the bid.
+func (v *VersionedSignedBuilderBid) Builder() (phase0.BLSPubKey, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return phase0.BLSPubKey{}, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Message.Pubkey, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return phase0.BLSPubKey{}, errors.New("no capella bid")
+		}
+
+		return v.Capella.Message.Pubkey, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil {
+			return phase0.BLSPubKey{}, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Message.Pubkey, nil
+	default:
+		return phase0.BLSPubKey{}, errors.New("unsupported version")
+	}
+}
+
+// ParentHash returns the parent hash of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Message.Header.ParentHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no capella bid")
+		}
+
+		return v.Capella.Message.Header.ParentHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Message.Header.ParentHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// BlockHash returns the block hash of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Message.Header.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no capella bid")
+		}
+
+		return v.Capella.Message.Header.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Header == nil {
+			return phase0.Hash32{}, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Message.Header.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload header of the bid.
+func (v *VersionedSignedBuilderBid) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Message.Header.FeeRecipient, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no capella bid")
+		}
+
+		return v.Capella.Message.Header.FeeRecipient, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Header == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Message.Header.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unsupported version")
+	}
+}
+
+// Signature returns the signature of the bid.
+func (v *VersionedSignedBuilderBid) Signature() (phase0.BLSSignature, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.BLSSignature{}, errors.New("no bellatrix bid")
+		}
+
+		return v.Bellatrix.Signature, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.BLSSignature{}, errors.New("no capella bid")
+		}
+
+		return v.Capella.Signature, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.BLSSignature{}, errors.New("no deneb bid")
+		}
+
+		return v.Deneb.Signature, nil
+	default:
+		return phase0.BLSSignature{}, errors.New("unsupported version")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedSubmitBlindedBlockResponse contains a versioned response from a builder
+// to the submission of a signed blinded beacon block.
+type VersionedSubmitBlindedBlockResponse struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayload
+	Capella   *capella.ExecutionPayload
+	Deneb     *apiv1deneb.ExecutionPayloadAndBlobsBundle
+}
+
+// IsEmpty returns true if there is no response.
+func (v *VersionedSubmitBlindedBlockResponse) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// BlockHash returns the block hash of the execution payload.
+func (v *VersionedSubmitBlindedBlockResponse) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix response")
+		}
+
+		return v.Bellatrix.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella response")
+		}
+
+		return v.Capella.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no deneb response")
+		}
+
+		return v.Deneb.ExecutionPayload.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// Transactions returns the transactions of the execution payload.
+func (v *VersionedSubmitBlindedBlockResponse) Transactions() ([]bellatrix.Transaction, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix response")
+		}
+
+		return v.Bellatrix.Transactions, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella response")
+		}
+
+		return v.Capella.Transactions, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.ExecutionPayload == nil {
+			return nil, errors.New("no deneb response")
+		}
+
+		return v.Deneb.ExecutionPayload.Transactions, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// BlobsBundle returns the blobs bundle that accompanies the execution payload.
+func (v *VersionedSubmitBlindedBlockResponse) BlobsBundle() (*apiv1deneb.BlobsBundle, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix, spec.DataVersionCapella:
+		return nil, errors.New("blobs bundle not available prior to deneb")
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.BlobsBundle == nil {
+			return nil, errors.New("no deneb response")
+		}
+
+		return v.Deneb.BlobsBundle, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
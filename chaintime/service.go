@@ -0,0 +1,277 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// forkEpochKeys are the spec keys for the epochs at which each fork activates.
+var forkEpochKeys = []struct {
+	version spec.DataVersion
+	key     string
+}{
+	{spec.DataVersionAltair, "ALTAIR_FORK_EPOCH"},
+	{spec.DataVersionBellatrix, "BELLATRIX_FORK_EPOCH"},
+	{spec.DataVersionCapella, "CAPELLA_FORK_EPOCH"},
+	{spec.DataVersionDeneb, "DENEB_FORK_EPOCH"},
+	{spec.DataVersionElectra, "ELECTRA_FORK_EPOCH"},
+}
+
+// Service provides chain time information.
+// All information is obtained when the service is created, so its functions
+// do not make calls to the underlying providers.
+type Service struct {
+	log                          zerolog.Logger
+	genesisTime                  time.Time
+	slotDuration                 time.Duration
+	slotsPerEpoch                uint64
+	epochsPerSyncCommitteePeriod uint64
+	forkEpochs                   map[spec.DataVersion]phase0.Epoch
+}
+
+// New creates a new chain time service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "chaintime").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	genesis, err := parameters.genesisProvider.Genesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis")
+	}
+	if genesis == nil {
+		return nil, errors.New("genesis not available")
+	}
+
+	specData, err := parameters.specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	tmp, exists := specData["SECONDS_PER_SLOT"]
+	if !exists {
+		return nil, errors.New("SECONDS_PER_SLOT not found in spec")
+	}
+	slotDuration, isDuration := tmp.(time.Duration)
+	if !isDuration {
+		return nil, errors.New("SECONDS_PER_SLOT of unexpected type")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("SECONDS_PER_SLOT cannot be 0")
+	}
+
+	slotsPerEpoch, err := specUint64(specData, "SLOTS_PER_EPOCH")
+	if err != nil {
+		return nil, err
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH cannot be 0")
+	}
+
+	// Sync committees were introduced in Altair, so this is not present for phase 0 only chains.
+	epochsPerSyncCommitteePeriod := uint64(0)
+	if _, exists := specData["EPOCHS_PER_SYNC_COMMITTEE_PERIOD"]; exists {
+		epochsPerSyncCommitteePeriod, err = specUint64(specData, "EPOCHS_PER_SYNC_COMMITTEE_PERIOD")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	forkEpochs := make(map[spec.DataVersion]phase0.Epoch, len(forkEpochKeys)+1)
+	forkEpochs[spec.DataVersionPhase0] = 0
+	for _, forkEpochKey := range forkEpochKeys {
+		if _, exists := specData[forkEpochKey.key]; !exists {
+			log.Trace().Str("key", forkEpochKey.key).Msg("Fork epoch not present in spec; assuming not scheduled")
+			continue
+		}
+		forkEpoch, err := specUint64(specData, forkEpochKey.key)
+		if err != nil {
+			return nil, err
+		}
+		forkEpochs[forkEpochKey.version] = phase0.Epoch(forkEpoch)
+	}
+
+	return &Service{
+		log:                          log,
+		genesisTime:                  genesis.GenesisTime,
+		slotDuration:                 slotDuration,
+		slotsPerEpoch:                slotsPerEpoch,
+		epochsPerSyncCommitteePeriod: epochsPerSyncCommitteePeriod,
+		forkEpochs:                   forkEpochs,
+	}, nil
+}
+
+func specUint64(specData map[string]interface{}, key string) (uint64, error) {
+	tmp, exists := specData[key]
+	if !exists {
+		return 0, fmt.Errorf("%s not found in spec", key)
+	}
+	val, isUint64 := tmp.(uint64)
+	if !isUint64 {
+		return 0, fmt.Errorf("%s of unexpected type", key)
+	}
+
+	return val, nil
+}
+
+// GenesisTime provides the time of the chain's genesis.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// SlotDuration provides the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// SlotToTime provides the start time of a given slot.
+func (s *Service) SlotToTime(slot phase0.Slot) time.Time {
+	return s.genesisTime.Add(time.Duration(slot) * s.slotDuration)
+}
+
+// SlotStart provides the start time of a given slot.
+// This is an alias for SlotToTime.
+func (s *Service) SlotStart(slot phase0.Slot) time.Time {
+	return s.SlotToTime(slot)
+}
+
+// SlotEnd provides the end time of a given slot, which is the start time of the next slot.
+func (s *Service) SlotEnd(slot phase0.Slot) time.Time {
+	return s.SlotToTime(slot + 1)
+}
+
+// TimeToSlot provides the slot at a given time.
+// Times prior to genesis return slot 0.
+func (s *Service) TimeToSlot(timestamp time.Time) phase0.Slot {
+	if timestamp.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(uint64(timestamp.Sub(s.genesisTime) / s.slotDuration))
+}
+
+// TimeToEpoch provides the epoch at a given time.
+// Times prior to genesis return epoch 0.
+func (s *Service) TimeToEpoch(timestamp time.Time) phase0.Epoch {
+	return s.EpochOf(s.TimeToSlot(timestamp))
+}
+
+// CurrentSlot provides the current slot.
+func (s *Service) CurrentSlot() phase0.Slot {
+	return s.TimeToSlot(time.Now())
+}
+
+// CurrentEpoch provides the current epoch.
+func (s *Service) CurrentEpoch() phase0.Epoch {
+	return s.TimeToEpoch(time.Now())
+}
+
+// EpochOf provides the epoch of a given slot.
+func (s *Service) EpochOf(slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch provides the first slot of the given epoch.
+func (s *Service) FirstSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// LastSlotOfEpoch provides the last slot of the given epoch.
+func (s *Service) LastSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch)*s.slotsPerEpoch + s.slotsPerEpoch - 1)
+}
+
+// EpochStart provides the start time of the given epoch.
+func (s *Service) EpochStart(epoch phase0.Epoch) time.Time {
+	return s.SlotToTime(s.FirstSlotOfEpoch(epoch))
+}
+
+// EpochEnd provides the end time of the given epoch, which is the start time of the next epoch.
+func (s *Service) EpochEnd(epoch phase0.Epoch) time.Time {
+	return s.EpochStart(epoch + 1)
+}
+
+// SyncCommitteePeriod provides the sync committee period of the given epoch.
+// Chains without sync committees always return period 0.
+func (s *Service) SyncCommitteePeriod(epoch phase0.Epoch) uint64 {
+	if s.epochsPerSyncCommitteePeriod == 0 {
+		return 0
+	}
+
+	return uint64(epoch) / s.epochsPerSyncCommitteePeriod
+}
+
+// FirstEpochOfSyncCommitteePeriod provides the first epoch of the given sync committee period.
+func (s *Service) FirstEpochOfSyncCommitteePeriod(period uint64) phase0.Epoch {
+	return phase0.Epoch(period * s.epochsPerSyncCommitteePeriod)
+}
+
+// LastEpochOfSyncCommitteePeriod provides the last epoch of the given sync committee period.
+func (s *Service) LastEpochOfSyncCommitteePeriod(period uint64) phase0.Epoch {
+	if s.epochsPerSyncCommitteePeriod == 0 {
+		return phase0.Epoch(math.MaxUint64)
+	}
+
+	return phase0.Epoch((period+1)*s.epochsPerSyncCommitteePeriod - 1)
+}
+
+// ForkEpoch provides the epoch at which the given fork activates.
+// If the fork is not scheduled this returns false.
+func (s *Service) ForkEpoch(version spec.DataVersion) (phase0.Epoch, bool) {
+	epoch, exists := s.forkEpochs[version]
+	if !exists || epoch == phase0.Epoch(math.MaxUint64) {
+		return 0, false
+	}
+
+	return epoch, true
+}
+
+// ForkAtEpoch provides the fork that is active at the given epoch.
+func (s *Service) ForkAtEpoch(epoch phase0.Epoch) spec.DataVersion {
+	res := spec.DataVersionPhase0
+	for version, forkEpoch := range s.forkEpochs {
+		if forkEpoch <= epoch && version > res {
+			res = version
+		}
+	}
+
+	return res
+}
+
+// ForkAtSlot provides the fork that is active at the given slot.
+func (s *Service) ForkAtSlot(slot phase0.Slot) spec.DataVersion {
+	return s.ForkAtEpoch(s.EpochOf(slot))
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithSpecProvider(mockClient),
+			},
+			err: "problem with parameters: no genesis provider specified",
+		},
+		{
+			name: "SpecProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(mockClient),
+			},
+			err: "problem with parameters: no spec provider specified",
+		},
+		{
+			name: "Good",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisProvider(mockClient),
+				chaintime.WithSpecProvider(mockClient),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSlots(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1606824023, 0)
+	mockClient, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, genesisTime, s.GenesisTime())
+	require.Equal(t, genesisTime, s.SlotToTime(0))
+	require.Equal(t, genesisTime.Add(12*time.Second), s.SlotEnd(0))
+	require.Equal(t, genesisTime.Add(1200*time.Second), s.SlotStart(100))
+	require.Equal(t, phase0.Slot(0), s.TimeToSlot(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Slot(100), s.TimeToSlot(genesisTime.Add(1211*time.Second)))
+	require.Equal(t, phase0.Epoch(3), s.EpochOf(100))
+	require.Equal(t, phase0.Epoch(3), s.TimeToEpoch(genesisTime.Add(1211*time.Second)))
+	require.Equal(t, phase0.Slot(96), s.FirstSlotOfEpoch(3))
+	require.Equal(t, phase0.Slot(127), s.LastSlotOfEpoch(3))
+	require.Equal(t, genesisTime.Add(96*12*time.Second), s.EpochStart(3))
+	require.Equal(t, genesisTime.Add(128*12*time.Second), s.EpochEnd(3))
+}
+
+func TestSyncCommitteePeriods(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0), s.SyncCommitteePeriod(255))
+	require.Equal(t, uint64(1), s.SyncCommitteePeriod(256))
+	require.Equal(t, phase0.Epoch(512), s.FirstEpochOfSyncCommitteePeriod(2))
+	require.Equal(t, phase0.Epoch(767), s.LastEpochOfSyncCommitteePeriod(2))
+}
+
+func TestForks(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	s, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, spec.DataVersionPhase0, s.ForkAtEpoch(0))
+	require.Equal(t, spec.DataVersionPhase0, s.ForkAtEpoch(74239))
+	require.Equal(t, spec.DataVersionAltair, s.ForkAtEpoch(74240))
+	require.Equal(t, spec.DataVersionBellatrix, s.ForkAtSlot(144896*32))
+	require.Equal(t, spec.DataVersionCapella, s.ForkAtEpoch(999999999))
+
+	epoch, scheduled := s.ForkEpoch(spec.DataVersionCapella)
+	require.True(t, scheduled)
+	require.Equal(t, phase0.Epoch(194048), epoch)
+	_, scheduled = s.ForkEpoch(spec.DataVersionDeneb)
+	require.False(t, scheduled)
+}
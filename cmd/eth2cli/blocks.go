@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+)
+
+func signedBeaconBlock(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("block ID required")
+	}
+	provider, isProvider := client.(eth2client.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide blocks")
+	}
+	block, err := provider.SignedBeaconBlock(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	return block, nil
+}
+
+func beaconBlockHeader(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("block ID required")
+	}
+	provider, isProvider := client.(eth2client.BeaconBlockHeadersProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide block headers")
+	}
+	header, err := provider.BeaconBlockHeader(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, errors.New("header not found")
+	}
+
+	return header, nil
+}
+
+func beaconState(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("state ID required")
+	}
+	provider, isProvider := client.(eth2client.BeaconStateProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide states")
+	}
+	state, err := provider.BeaconState(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("state not found")
+	}
+
+	return state, nil
+}
+
+func finality(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("state ID required")
+	}
+	provider, isProvider := client.(eth2client.FinalityProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide finality")
+	}
+
+	return provider.Finality(ctx, args[0])
+}
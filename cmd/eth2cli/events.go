@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// tailEvents outputs events for the given topics until the context is cancelled.
+func tailEvents(ctx context.Context, client eth2client.Service, topics []string, o *outputter) error {
+	if len(topics) == 0 {
+		return errors.New("at least one topic required")
+	}
+	provider, isProvider := client.(eth2client.EventsProvider)
+	if !isProvider {
+		return errors.New("client does not provide events")
+	}
+
+	if err := provider.Events(ctx, topics, func(event *apiv1.Event) {
+		if err := o.output(event); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to output %s event: %v\n", event.Topic, err)
+		}
+	}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// eth2cli is a command-line tool that exposes the providers of go-eth2-client.
+// As well as being a debugging tool, its source serves as example code for the
+// library's API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+)
+
+// command is a subcommand of the tool.
+type command struct {
+	usage       string
+	description string
+	run         func(ctx context.Context, client eth2client.Service, args []string) (interface{}, error)
+}
+
+var commands = map[string]*command{
+	"version":       {"version", "show the beacon node version", nodeVersion},
+	"syncing":       {"syncing", "show the beacon node sync state", nodeSyncing},
+	"spec":          {"spec", "show the chain spec", chainSpec},
+	"genesis":       {"genesis", "show the chain genesis", chainGenesis},
+	"fork-schedule": {"fork-schedule", "show the chain fork schedule", forkSchedule},
+	"block":         {"block <block ID>", "show a signed beacon block", signedBeaconBlock},
+	"header":        {"header <block ID>", "show a beacon block header", beaconBlockHeader},
+	"state":         {"state <state ID>", "show a beacon state", beaconState},
+	"finality":      {"finality <state ID>", "show finality checkpoints", finality},
+	"validators":    {"validators <state ID> [index|pubkey...]", "show validators", validators},
+	"duties":        {"duties <attester|proposer|sync> <epoch> [index...]", "show validator duties", duties},
+	"events":        {"events <topic...>", "tail events until interrupted", nil},
+}
+
+func main() {
+	address := flag.String("address", "http://localhost:5052", "address of the beacon node")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout for requests to the beacon node")
+	format := flag.String("format", "json", "output format: json, yaml or ssz")
+	logLevel := flag.String("log-level", "warn", "log level")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+	cmd, exists := commands[flag.Arg(0)]
+	if !exists {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(1)
+	}
+	outputter, err := newOutputter(*format)
+	if err != nil {
+		fail(err)
+	}
+	level, err := zerolog.ParseLevel(*logLevel)
+	if err != nil {
+		fail(err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	client, err := http.New(ctx,
+		http.WithAddress(*address),
+		http.WithTimeout(*timeout),
+		http.WithLogLevel(level),
+	)
+	if err != nil {
+		fail(err)
+	}
+
+	if flag.Arg(0) == "events" {
+		if err := tailEvents(ctx, client, flag.Args()[1:], outputter); err != nil {
+			fail(err)
+		}
+		return
+	}
+
+	res, err := cmd.run(ctx, client, flag.Args()[1:])
+	if err != nil {
+		fail(err)
+	}
+	if err := outputter.output(res); err != nil {
+		fail(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [flags] <command> [arguments]\n\nCommands:\n", os.Args[0])
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-52s %s\n", commands[name].usage, commands[name].description)
+	}
+	fmt.Fprintf(os.Stderr, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "%s\n", strings.TrimSpace(err.Error()))
+	os.Exit(1)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+)
+
+func nodeVersion(ctx context.Context, client eth2client.Service, _ []string) (interface{}, error) {
+	provider, isProvider := client.(eth2client.NodeVersionProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide node version")
+	}
+	version, err := provider.NodeVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"version": version}, nil
+}
+
+func nodeSyncing(ctx context.Context, client eth2client.Service, _ []string) (interface{}, error) {
+	provider, isProvider := client.(eth2client.NodeSyncingProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide sync state")
+	}
+
+	return provider.NodeSyncing(ctx)
+}
+
+func chainSpec(ctx context.Context, client eth2client.Service, _ []string) (interface{}, error) {
+	provider, isProvider := client.(eth2client.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide spec")
+	}
+
+	return provider.Spec(ctx)
+}
+
+func chainGenesis(ctx context.Context, client eth2client.Service, _ []string) (interface{}, error) {
+	provider, isProvider := client.(eth2client.GenesisProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide genesis")
+	}
+
+	return provider.Genesis(ctx)
+}
+
+func forkSchedule(ctx context.Context, client eth2client.Service, _ []string) (interface{}, error) {
+	provider, isProvider := client.(eth2client.ForkScheduleProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide fork schedule")
+	}
+
+	return provider.ForkSchedule(ctx)
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// sszMarshaler is the interface for types that can be marshalled to SSZ.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+type outputter struct {
+	format string
+}
+
+func newOutputter(format string) (*outputter, error) {
+	switch format {
+	case "json", "yaml", "ssz":
+		return &outputter{format: format}, nil
+	default:
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// output writes the data to standard output in the requested format.
+func (o *outputter) output(data interface{}) error {
+	data = unwrapVersioned(data)
+
+	switch o.format {
+	case "ssz":
+		marshaler, isMarshaler := data.(sszMarshaler)
+		if !isMarshaler {
+			return errors.New("data cannot be output as SSZ")
+		}
+		res, err := marshaler.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal SSZ")
+		}
+		_, err = os.Stdout.Write(res)
+		return err
+	case "yaml":
+		res, err := json.Marshal(data)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal JSON")
+		}
+		res, err = yaml.JSONToYAML(res)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert to YAML")
+		}
+		_, err = fmt.Fprintf(os.Stdout, "---\n%s", res)
+		return err
+	default:
+		res, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal JSON")
+		}
+		_, err = fmt.Fprintf(os.Stdout, "%s\n", res)
+		return err
+	}
+}
+
+// unwrapVersioned returns the populated data of a versioned container, for
+// example the Capella block of a VersionedSignedBeaconBlock.  Data that is
+// not a versioned container is returned as-is.
+func unwrapVersioned(data interface{}) interface{} {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return data
+	}
+	val = val.Elem()
+	if !val.FieldByName("Version").IsValid() {
+		return data
+	}
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if val.Type().Field(i).Name == "Version" || field.Kind() != reflect.Ptr || field.IsNil() {
+			continue
+		}
+		return field.Interface()
+	}
+
+	return data
+}
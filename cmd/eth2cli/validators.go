@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+func validators(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, errors.New("state ID required")
+	}
+	provider, isProvider := client.(eth2client.ValidatorsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+
+	if len(args) > 1 && strings.HasPrefix(args[1], "0x") {
+		pubKeys, err := parsePubKeys(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		return provider.ValidatorsByPubKey(ctx, args[0], pubKeys)
+	}
+
+	indices, err := parseIndices(args[1:])
+	if err != nil {
+		return nil, err
+	}
+	return provider.Validators(ctx, args[0], indices)
+}
+
+func duties(ctx context.Context, client eth2client.Service, args []string) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, errors.New("duty type and epoch required")
+	}
+	epoch, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid epoch")
+	}
+	indices, err := parseIndices(args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch args[0] {
+	case "attester":
+		provider, isProvider := client.(eth2client.AttesterDutiesProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide attester duties")
+		}
+		return provider.AttesterDuties(ctx, phase0.Epoch(epoch), indices)
+	case "proposer":
+		provider, isProvider := client.(eth2client.ProposerDutiesProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide proposer duties")
+		}
+		return provider.ProposerDuties(ctx, phase0.Epoch(epoch), indices)
+	case "sync":
+		provider, isProvider := client.(eth2client.SyncCommitteeDutiesProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide sync committee duties")
+		}
+		return provider.SyncCommitteeDuties(ctx, phase0.Epoch(epoch), indices)
+	default:
+		return nil, fmt.Errorf("unknown duty type %q", args[0])
+	}
+}
+
+func parseIndices(args []string) ([]phase0.ValidatorIndex, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	indices := make([]phase0.ValidatorIndex, len(args))
+	for i := range args {
+		index, err := strconv.ParseUint(args[i], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid validator index %s", args[i]))
+		}
+		indices[i] = phase0.ValidatorIndex(index)
+	}
+
+	return indices, nil
+}
+
+func parsePubKeys(args []string) ([]phase0.BLSPubKey, error) {
+	pubKeys := make([]phase0.BLSPubKey, len(args))
+	for i := range args {
+		data, err := hex.DecodeString(strings.TrimPrefix(args[i], "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid public key %s", args[i]))
+		}
+		if len(data) != phase0.PublicKeyLength {
+			return nil, fmt.Errorf("incorrect length for public key %s", args[i])
+		}
+		copy(pubKeys[i][:], data)
+	}
+
+	return pubKeys, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+)
+
+// jsonBufferPool holds buffers for JSON encodings.
+var jsonBufferPool = sync.Pool{
+	New: func() any {
+		return bytes.NewBuffer(make([]byte, 0, 4096))
+	},
+}
+
+// MarshalJSONPooled encodes the value as JSON into a buffer obtained from a
+// shared pool, rather than allocating a new buffer for each encoding.  The
+// encoding is the same as that provided by json.Marshal.
+//
+// The returned release function returns the buffer to the pool, and must be
+// called once the encoding is no longer in use.  The encoding must not be
+// accessed after the release function has been called.
+func MarshalJSONPooled(v any) ([]byte, func(), error) {
+	buf, isBuffer := jsonBufferPool.Get().(*bytes.Buffer)
+	if !isBuffer {
+		buf = new(bytes.Buffer)
+	}
+	buf.Reset()
+
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		putJSONBuffer(buf)
+
+		return nil, nil, err
+	}
+	// The encoder terminates the encoding with a newline, which json.Marshal does not.
+	data := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+
+	var once sync.Once
+
+	return data, func() { once.Do(func() { putJSONBuffer(buf) }) }, nil
+}
+
+func putJSONBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	jsonBufferPool.Put(buf)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalJSONPooled(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{
+			name: "Checkpoints",
+			value: []*phase0.Checkpoint{
+				{Epoch: 1, Root: phase0.Root{0x01, 0x02}},
+				{Epoch: 2, Root: phase0.Root{0x03, 0x04}},
+			},
+		},
+		{
+			name:  "EscapedHTML",
+			value: map[string]string{"graffiti": "<&>"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected, err := json.Marshal(test.value)
+			require.NoError(t, err)
+
+			for i := 0; i < 3; i++ {
+				data, release, err := codecs.MarshalJSONPooled(test.value)
+				require.NoError(t, err)
+				require.Equal(t, expected, data)
+				release()
+				// Releasing more than once must be safe.
+				release()
+			}
+		})
+	}
+}
+
+func TestMarshalJSONPooledError(t *testing.T) {
+	_, _, err := codecs.MarshalJSONPooled(make(chan int))
+	require.Error(t, err)
+}
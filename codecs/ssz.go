@@ -0,0 +1,191 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"encoding/binary"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidBool is returned when an SSZ-encoded boolean is neither 0 nor 1.
+var ErrInvalidBool = errors.New("invalid boolean")
+
+// SSZStreamedList describes a variable-length list of fixed-size elements
+// within an SSZ container that should be decoded directly from a stream,
+// rather than buffered alongside the rest of the container.
+type SSZStreamedList struct {
+	// Offset is the position of the list's offset in the container's fixed part.
+	Offset int
+	// ElementSize is the size of each element of the list, in bytes.
+	ElementSize int
+	// MaxElements is the maximum number of elements in the list.
+	MaxElements int
+	// Decode is called with the encoding of each element of the list in turn.
+	Decode func(buf []byte) error
+}
+
+// UnmarshalSSZStream decodes an SSZ container from a reader.
+//
+// fixedSize is the size of the container's fixed part, and offsets are the
+// positions of all variable-length field offsets within the fixed part, in
+// field order.  Variable-length fields listed in streamed are decoded element
+// by element as they are read; all other fields are buffered and passed, along
+// with the fixed part, to unmarshal.  The buffer passed to unmarshal contains
+// the streamed lists as empty, so the caller must populate them afterwards.
+//
+// This allows very large containers to be decoded without holding the entire
+// encoding in memory alongside the decoded value.
+func UnmarshalSSZStream(r io.Reader,
+	fixedSize int,
+	offsets []int,
+	streamed []*SSZStreamedList,
+	unmarshal func(buf []byte) error,
+) error {
+	buf := make([]byte, fixedSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ssz.ErrSize
+		}
+
+		return errors.Wrap(err, "failed to read fixed part")
+	}
+
+	// Obtain the field boundaries from the fixed part.
+	starts := make([]uint64, len(offsets))
+	for i, offset := range offsets {
+		starts[i] = ssz.ReadOffset(buf[offset : offset+4])
+		if i == 0 && starts[i] != uint64(fixedSize) {
+			return ssz.ErrInvalidVariableOffset
+		}
+		if i > 0 && starts[i] < starts[i-1] {
+			return ssz.ErrOffset
+		}
+	}
+
+	streamedLists := make(map[int]*SSZStreamedList, len(streamed))
+	for _, list := range streamed {
+		streamedLists[list.Offset] = list
+	}
+
+	for i, offset := range offsets {
+		// Offsets are rewritten to account for the streamed lists that are
+		// not present in the buffer.
+		binary.LittleEndian.PutUint32(buf[offset:offset+4], uint32(len(buf)))
+
+		// The length of the final field is unknown until the end of the stream.
+		length := -1
+		if i < len(offsets)-1 {
+			length = int(starts[i+1] - starts[i])
+		}
+
+		if list, exists := streamedLists[offset]; exists {
+			if err := decodeStreamedList(r, list, length); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if length == -1 {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return errors.Wrap(err, "failed to read final field")
+			}
+			buf = append(buf, data...)
+
+			continue
+		}
+
+		start := len(buf)
+		buf = append(buf, make([]byte, length)...)
+		if _, err := io.ReadFull(r, buf[start:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ssz.ErrOffset
+			}
+
+			return errors.Wrap(err, "failed to read field")
+		}
+	}
+
+	return unmarshal(buf)
+}
+
+// decodeStreamedList decodes a list from the reader.
+// A length of -1 means that the list runs to the end of the stream.
+func decodeStreamedList(r io.Reader, list *SSZStreamedList, length int) error {
+	if length != -1 {
+		if _, err := ssz.DivideInt2(length, list.ElementSize, list.MaxElements); err != nil {
+			return err
+		}
+	}
+
+	element := make([]byte, list.ElementSize)
+	for num := 0; length == -1 || num*list.ElementSize < length; num++ {
+		if _, err := io.ReadFull(r, element); err != nil {
+			if length == -1 && errors.Is(err, io.EOF) {
+				return nil
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ssz.ErrSize
+			}
+
+			return errors.Wrap(err, "failed to read list element")
+		}
+		if num >= list.MaxElements {
+			return ssz.ErrListTooBig
+		}
+		if err := list.Decode(element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeDynamicLength returns the number of elements in an SSZ-encoded list of
+// variable-size elements.
+//
+// It is a stricter form of ssz.DecodeDynamicLength that also confirms the buffer
+// is large enough to hold the offsets of the elements, so that a malformed
+// encoding cannot trigger a large allocation for the list, and that a non-empty
+// buffer contains at least one element.
+func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
+	num, err := ssz.DecodeDynamicLength(buf, maxSize)
+	if err != nil {
+		return 0, err
+	}
+	if num*4 > len(buf) || (num == 0 && len(buf) > 0) {
+		return 0, ssz.ErrOffset
+	}
+
+	return num, nil
+}
+
+// UnmarshalBool decodes an SSZ-encoded boolean.
+//
+// Unlike ssz.UnmarshalBool it rejects any value other than 0 or 1, as these do
+// not have a canonical encoding.
+func UnmarshalBool(buf []byte) (bool, error) {
+	switch buf[0] {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, ErrInvalidBool
+	}
+}
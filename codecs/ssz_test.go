@@ -0,0 +1,206 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// testContainer is a container of two variable-length lists, in which the
+// final list is streamed.
+type testContainer struct {
+	Buffered []byte
+	Streamed []uint64
+}
+
+func (c *testContainer) unmarshalSSZ(buf []byte) error {
+	o0 := ssz.ReadOffset(buf[0:4])
+	o1 := ssz.ReadOffset(buf[4:8])
+	c.Buffered = append([]byte{}, buf[o0:o1]...)
+	if len(buf[o1:]) != 0 {
+		return ssz.ErrSize
+	}
+
+	return nil
+}
+
+func (c *testContainer) unmarshalSSZFrom(t *testing.T, input []byte) error {
+	t.Helper()
+
+	c.Streamed = make([]uint64, 0)
+	streamed := []*codecs.SSZStreamedList{
+		{
+			Offset:      4,
+			ElementSize: 8,
+			MaxElements: 3,
+			Decode: func(buf []byte) error {
+				c.Streamed = append(c.Streamed, ssz.UnmarshallUint64(buf))
+
+				return nil
+			},
+		},
+	}
+
+	return codecs.UnmarshalSSZStream(bytes.NewReader(input), 8, []int{0, 4}, streamed, c.unmarshalSSZ)
+}
+
+func TestUnmarshalSSZStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected *testContainer
+		err      error
+	}{
+		{
+			name: "Empty",
+			err:  ssz.ErrSize,
+		},
+		{
+			name:  "FirstOffsetInvalid",
+			input: []byte{0x07, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00},
+			err:   ssz.ErrInvalidVariableOffset,
+		},
+		{
+			name:  "OffsetsDescending",
+			input: []byte{0x08, 0x00, 0x00, 0x00, 0x07, 0x00, 0x00, 0x00},
+			err:   ssz.ErrOffset,
+		},
+		{
+			name:  "BufferedShort",
+			input: []byte{0x08, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00, 0x01},
+			err:   ssz.ErrOffset,
+		},
+		{
+			name: "StreamedPartial",
+			input: []byte{
+				0x08, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00,
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x02, 0x00,
+			},
+			err: ssz.ErrSize,
+		},
+		{
+			name: "StreamedTooLong",
+			input: []byte{
+				0x08, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00,
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			err: ssz.ErrListTooBig,
+		},
+		{
+			name: "Good",
+			input: []byte{
+				0x08, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x00,
+				0xaa, 0xbb,
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+			expected: &testContainer{
+				Buffered: []byte{0xaa, 0xbb},
+				Streamed: []uint64{1, 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &testContainer{}
+			err := c.unmarshalSSZFrom(t, test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, c)
+			}
+		})
+	}
+}
+
+func TestDecodeDynamicLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		max      int
+		expected int
+		err      bool
+	}{
+		{
+			name: "Empty",
+			max:  4,
+		},
+		{
+			name:  "Short",
+			input: []byte{0x04, 0x00},
+			max:   4,
+			err:   true,
+		},
+		{
+			name:  "ZeroOffset",
+			input: []byte{0x00, 0x00, 0x00, 0x00},
+			max:   4,
+			err:   true,
+		},
+		{
+			name:  "TooBig",
+			input: []byte{0x14, 0x00, 0x00, 0x00},
+			max:   4,
+			err:   true,
+		},
+		{
+			name:  "OffsetsBeyondBuffer",
+			input: []byte{0x10, 0x00, 0x00, 0x00},
+			max:   4,
+			err:   true,
+		},
+		{
+			name:     "Good",
+			input:    []byte{0x08, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00},
+			max:      4,
+			expected: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			num, err := codecs.DecodeDynamicLength(test.input, test.max)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, num)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBool(t *testing.T) {
+	res, err := codecs.UnmarshalBool([]byte{0x00})
+	require.NoError(t, err)
+	require.False(t, res)
+
+	res, err = codecs.UnmarshalBool([]byte{0x01})
+	require.NoError(t, err)
+	require.True(t, res)
+
+	_, err = codecs.UnmarshalBool([]byte{0x02})
+	require.ErrorIs(t, err, codecs.ErrInvalidBool)
+}
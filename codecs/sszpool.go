@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity above which buffers are not returned
+// to their pool, to avoid the pool holding on to memory after an occasional very
+// large encoding.
+const maxPooledBufferSize = 16 * 1024 * 1024
+
+// sszBufferPool holds buffers for SSZ encodings.
+var sszBufferPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 4096)
+
+		return &buf
+	},
+}
+
+// SSZMarshaler is a type that can be encoded as SSZ into a supplied buffer.
+type SSZMarshaler interface {
+	MarshalSSZTo(buf []byte) ([]byte, error)
+	SizeSSZ() int
+}
+
+// MarshalSSZPooled encodes the value as SSZ into a buffer obtained from a
+// shared pool, rather than allocating a new buffer for each encoding.  This
+// suits short-lived encodings, such as the bodies of submissions to a node.
+//
+// The returned release function returns the buffer to the pool, and must be
+// called once the encoding is no longer in use.  The encoding must not be
+// accessed after the release function has been called.
+func MarshalSSZPooled(m SSZMarshaler) ([]byte, func(), error) {
+	bufp, isBuffer := sszBufferPool.Get().(*[]byte)
+	if !isBuffer {
+		buf := make([]byte, 0)
+		bufp = &buf
+	}
+
+	if size := m.SizeSSZ(); cap(*bufp) < size {
+		*bufp = make([]byte, 0, size)
+	}
+
+	data, err := m.MarshalSSZTo((*bufp)[:0])
+	if err != nil {
+		putSSZBuffer(bufp)
+
+		return nil, nil, err
+	}
+	// Retain the buffer in case it was grown during encoding.
+	*bufp = data
+
+	var once sync.Once
+
+	return data, func() { once.Do(func() { putSSZBuffer(bufp) }) }, nil
+}
+
+func putSSZBuffer(bufp *[]byte) {
+	if cap(*bufp) > maxPooledBufferSize {
+		return
+	}
+	*bufp = (*bufp)[:0]
+	sszBufferPool.Put(bufp)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalSSZPooled(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{
+		Epoch: 1,
+		Root:  phase0.Root{0x01, 0x02},
+	}
+	expected, err := checkpoint.MarshalSSZ()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		data, release, err := codecs.MarshalSSZPooled(checkpoint)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+		release()
+		// Releasing more than once must be safe.
+		release()
+	}
+}
+
+func TestMarshalSSZPooledError(t *testing.T) {
+	// Overlong aggregation bits fail to encode.
+	_, _, err := codecs.MarshalSSZPooled(&phase0.Attestation{
+		AggregationBits: make([]byte, 2049),
+	})
+	require.Error(t, err)
+}
+
+func BenchmarkMarshalSSZPooled(b *testing.B) {
+	attestation := &phase0.Attestation{
+		AggregationBits: []byte{0x01},
+		Data: &phase0.AttestationData{
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, release, err := codecs.MarshalSSZPooled(attestation)
+		if err != nil {
+			b.Fatal(err)
+		}
+		release()
+	}
+}
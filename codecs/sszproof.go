@@ -0,0 +1,274 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// maxZeroHashDepth is the deepest empty subtree that can be present in an SSZ
+// Merkle tree.
+const maxZeroHashDepth = 64
+
+// zeroHashes are the roots of empty subtrees, indexed by subtree depth.
+var zeroHashes = func() [][32]byte {
+	hashes := make([][32]byte, maxZeroHashDepth+1)
+	for i := 1; i <= maxZeroHashDepth; i++ {
+		hashes[i] = sha256.Sum256(append(hashes[i-1][:], hashes[i-1][:]...))
+	}
+
+	return hashes
+}()
+
+// SSZProvable is an SSZ object for which a Merkle tree can be obtained.
+type SSZProvable interface {
+	ssz.HashRoot
+	GetTree() (*ssz.Node, error)
+}
+
+// SSZMultiproof is a Merkle multiproof of a number of nodes of an SSZ object,
+// in the format of the consensus specifications.
+type SSZMultiproof struct {
+	// Indices are the generalized indices of the proven nodes.
+	Indices []uint64
+	// Leaves are the values of the proven nodes, in the order of Indices.
+	Leaves [][32]byte
+	// Proof are the helper nodes required to calculate the root from the
+	// leaves, in decreasing order of generalized index.
+	Proof [][32]byte
+}
+
+// GeneralizedIndex returns the generalized index of the node at the given
+// position from the left of the given depth of a Merkle tree.
+func GeneralizedIndex(depth int, position uint64) uint64 {
+	return 1<<depth | position
+}
+
+// ConcatGeneralizedIndices returns the generalized index of a node within a
+// nested object, given the generalized index of each object within its parent
+// followed by that of the node within the innermost object.
+func ConcatGeneralizedIndices(indices ...uint64) uint64 {
+	res := uint64(1)
+	for _, index := range indices {
+		depth := bits.Len64(index) - 1
+		res = res<<depth | (index ^ 1<<depth)
+	}
+
+	return res
+}
+
+// SSZHelperIndices returns the generalized indices of the helper nodes
+// required to prove the nodes at the given generalized indices, in decreasing
+// order.
+func SSZHelperIndices(indices []uint64) []uint64 {
+	paths := make(map[uint64]struct{})
+	branches := make(map[uint64]struct{})
+	for _, index := range indices {
+		for ; index > 1; index >>= 1 {
+			paths[index] = struct{}{}
+			branches[index^1] = struct{}{}
+		}
+	}
+
+	res := make([]uint64, 0, len(branches))
+	for index := range branches {
+		if _, exists := paths[index]; !exists {
+			res = append(res, index)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] > res[j] })
+
+	return res
+}
+
+// SSZMultiproofFor returns a Merkle multiproof of the nodes of the object at
+// the given generalized indices.
+//
+// The proof is checked against the hash tree root of the object before it is
+// returned, so that an incomplete Merkle tree cannot produce an invalid proof.
+func SSZMultiproofFor(obj SSZProvable, indices []uint64) (proof *SSZMultiproof, err error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no indices supplied")
+	}
+	if err := checkProofIndices(indices); err != nil {
+		return nil, err
+	}
+
+	// fastssz panics rather than erroring on trees that it cannot build.
+	defer func() {
+		if r := recover(); r != nil {
+			proof = nil
+			err = fmt.Errorf("failed to build tree: %v", r)
+		}
+	}()
+
+	tree, err := obj.GetTree()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain tree")
+	}
+
+	proof = &SSZMultiproof{
+		Indices: append([]uint64{}, indices...),
+		Leaves:  make([][32]byte, len(indices)),
+	}
+	for i, index := range indices {
+		if proof.Leaves[i], err = sszNodeHash(tree, index); err != nil {
+			return nil, err
+		}
+	}
+	helpers := SSZHelperIndices(indices)
+	proof.Proof = make([][32]byte, len(helpers))
+	for i, index := range helpers {
+		if proof.Proof[i], err = sszNodeHash(tree, index); err != nil {
+			return nil, err
+		}
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain hash tree root")
+	}
+	if err := proof.Verify(root); err != nil {
+		return nil, errors.Wrap(err, "generated proof is invalid")
+	}
+
+	return proof, nil
+}
+
+// Verify confirms that the multiproof is valid for the given root.
+func (p *SSZMultiproof) Verify(root [32]byte) error {
+	if len(p.Indices) == 0 {
+		return errors.New("no indices")
+	}
+	if len(p.Leaves) != len(p.Indices) {
+		return fmt.Errorf("%d leaves for %d indices", len(p.Leaves), len(p.Indices))
+	}
+	if err := checkProofIndices(p.Indices); err != nil {
+		return err
+	}
+	helpers := SSZHelperIndices(p.Indices)
+	if len(p.Proof) != len(helpers) {
+		return fmt.Errorf("%d proof nodes for %d helper indices", len(p.Proof), len(helpers))
+	}
+
+	nodes := make(map[uint64][32]byte, len(p.Indices)+len(helpers))
+	keys := make([]uint64, 0, len(p.Indices)+len(helpers))
+	for i, index := range p.Indices {
+		nodes[index] = p.Leaves[i]
+		keys = append(keys, index)
+	}
+	for i, index := range helpers {
+		nodes[index] = p.Proof[i]
+		keys = append(keys, index)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	// Each node is calculated once both of its children are known, following
+	// calculate_multi_merkle_root in the consensus specifications.
+	for pos := 0; pos < len(keys); pos++ {
+		index := keys[pos]
+		if index == 1 {
+			continue
+		}
+		parent := index >> 1
+		if _, exists := nodes[parent]; exists {
+			continue
+		}
+		sibling, exists := nodes[index^1]
+		if !exists {
+			continue
+		}
+		node := nodes[index]
+		if index&1 == 0 {
+			nodes[parent] = sha256.Sum256(append(node[:], sibling[:]...))
+		} else {
+			nodes[parent] = sha256.Sum256(append(sibling[:], node[:]...))
+		}
+		keys = append(keys, parent)
+	}
+
+	calculatedRoot, exists := nodes[1]
+	if !exists {
+		return errors.New("proof does not reach root")
+	}
+	if !bytes.Equal(calculatedRoot[:], root[:]) {
+		return errors.New("proof does not match root")
+	}
+
+	return nil
+}
+
+// checkProofIndices ensures that the generalized indices can be proven
+// together.
+func checkProofIndices(indices []uint64) error {
+	seen := make(map[uint64]struct{}, len(indices))
+	for _, index := range indices {
+		if index == 0 || index > math.MaxInt64 {
+			return fmt.Errorf("generalized index %d is invalid", index)
+		}
+		if _, exists := seen[index]; exists {
+			return fmt.Errorf("generalized index %d is duplicated", index)
+		}
+		seen[index] = struct{}{}
+	}
+	for _, index := range indices {
+		for ancestor := index >> 1; ancestor > 0; ancestor >>= 1 {
+			if _, exists := seen[ancestor]; exists {
+				return fmt.Errorf("generalized index %d is an ancestor of %d", ancestor, index)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sszNodeHash returns the hash of the node at the given generalized index.
+//
+// fastssz does not expand empty subtrees, so if the node lies within one its
+// hash is obtained from the depth of the empty subtree that contains it.
+func sszNodeHash(tree *ssz.Node, index uint64) ([32]byte, error) {
+	var res [32]byte
+
+	node, err := tree.Get(int(index))
+	if err == nil {
+		copy(res[:], node.Hash())
+
+		return res, nil
+	}
+
+	for depth := 1; index>>depth > 0; depth++ {
+		ancestor, err := tree.Get(int(index >> depth))
+		if err != nil {
+			continue
+		}
+		copy(res[:], ancestor.Hash())
+		for subtreeDepth := depth; subtreeDepth <= maxZeroHashDepth; subtreeDepth++ {
+			if res == zeroHashes[subtreeDepth] {
+				return zeroHashes[subtreeDepth-depth], nil
+			}
+		}
+
+		break
+	}
+
+	return res, fmt.Errorf("generalized index %d is not in the tree", index)
+}
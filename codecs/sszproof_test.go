@@ -0,0 +1,165 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testProofBlock() *phase0.BeaconBlock {
+	return &phase0.BeaconBlock{
+		Slot:          12345,
+		ProposerIndex: 67,
+		ParentRoot:    phase0.Root{0x01},
+		StateRoot:     phase0.Root{0x02},
+		Body: &phase0.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				DepositRoot: phase0.Root{0x03},
+				BlockHash:   make([]byte, 32),
+			},
+			Graffiti: [32]byte{0x04},
+			Attestations: []*phase0.Attestation{
+				{
+					AggregationBits: bitfield.Bitlist{0x05},
+					Data: &phase0.AttestationData{
+						Slot:            12344,
+						BeaconBlockRoot: phase0.Root{0x06},
+						Source:          &phase0.Checkpoint{},
+						Target:          &phase0.Checkpoint{Epoch: 385},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConcatGeneralizedIndices(t *testing.T) {
+	require.Equal(t, uint64(1), codecs.ConcatGeneralizedIndices())
+	require.Equal(t, uint64(12), codecs.ConcatGeneralizedIndices(12))
+	require.Equal(t, uint64(98), codecs.ConcatGeneralizedIndices(12, 10))
+	require.Equal(t, uint64(98), codecs.ConcatGeneralizedIndices(1, 12, 1, 10))
+	require.Equal(t, uint64(10), codecs.GeneralizedIndex(3, 2))
+}
+
+func TestSSZHelperIndices(t *testing.T) {
+	require.Equal(t, []uint64{10, 4, 3}, codecs.SSZHelperIndices([]uint64{11}))
+	require.Equal(t, []uint64{10, 6, 4}, codecs.SSZHelperIndices([]uint64{11, 7, 2}))
+}
+
+func TestSSZMultiproofFor(t *testing.T) {
+	block := testProofBlock()
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	bodyRoot, err := block.Body.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		indices  []uint64
+		expected [][32]byte
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "no indices supplied",
+		},
+		{
+			name:    "Zero",
+			indices: []uint64{0},
+			err:     "generalized index 0 is invalid",
+		},
+		{
+			name:    "Duplicate",
+			indices: []uint64{11, 11},
+			err:     "generalized index 11 is duplicated",
+		},
+		{
+			name:    "Ancestor",
+			indices: []uint64{98, 12},
+			err:     "generalized index 12 is an ancestor of 98",
+		},
+		{
+			name:    "BelowLeaf",
+			indices: []uint64{codecs.ConcatGeneralizedIndices(11, 2)},
+			err:     "generalized index 22 is not in the tree",
+		},
+		{
+			name:     "Root",
+			indices:  []uint64{1},
+			expected: [][32]byte{blockRoot},
+		},
+		{
+			name:     "Single",
+			indices:  []uint64{11},
+			expected: [][32]byte{block.StateRoot},
+		},
+		{
+			name:     "Multiple",
+			indices:  []uint64{8, 12, 11},
+			expected: [][32]byte{{0x39, 0x30}, bodyRoot, block.StateRoot},
+		},
+		{
+			name:     "Nested",
+			indices:  []uint64{codecs.ConcatGeneralizedIndices(12, 10), 8},
+			expected: [][32]byte{block.Body.Graffiti, {0x39, 0x30}},
+		},
+		{
+			name: "EmptyListElement",
+			// Body, attestations, list data, fifth element.
+			indices:  []uint64{codecs.ConcatGeneralizedIndices(12, 13, 2, codecs.GeneralizedIndex(7, 5))},
+			expected: [][32]byte{{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proof, err := codecs.SSZMultiproofFor(block, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.indices, proof.Indices)
+				require.Equal(t, test.expected, proof.Leaves)
+				require.NoError(t, proof.Verify(blockRoot))
+			}
+		})
+	}
+}
+
+func TestSSZMultiproofVerify(t *testing.T) {
+	block := testProofBlock()
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := codecs.SSZMultiproofFor(block, []uint64{8, 98})
+	require.NoError(t, err)
+	require.NoError(t, proof.Verify(blockRoot))
+
+	require.EqualError(t, proof.Verify(phase0.Root{}), "proof does not match root")
+
+	proof.Leaves[0][0]++
+	require.EqualError(t, proof.Verify(blockRoot), "proof does not match root")
+	proof.Leaves[0][0]--
+
+	proof.Proof = proof.Proof[1:]
+	require.EqualError(t, proof.Verify(blockRoot), "6 proof nodes for 7 helper indices")
+
+	proof.Leaves = proof.Leaves[1:]
+	require.EqualError(t, proof.Verify(blockRoot), "1 leaves for 2 indices")
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package connect provides a simple way to connect to one or more beacon nodes.
+package connect
+
+import (
+	"context"
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/pkg/errors"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// New creates a client for the given address.
+// The address can be a single address, or a comma-separated list of addresses.
+// A single address results in a standard HTTP client; multiple addresses result
+// in a multi client, which fails over between standard HTTP clients for each address.
+// The supplied parameters are applied to each HTTP client; the address parameter
+// is not required as it is supplied separately.
+func New(ctx context.Context, address string, params ...http.Parameter) (consensusclient.Service, error) {
+	addresses := make([]string, 0)
+	for _, address := range strings.Split(address, ",") {
+		address = strings.TrimSpace(address)
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return NewWithAddresses(ctx, addresses, params...)
+}
+
+// NewWithAddresses creates a client for the given addresses.
+// A single address results in a standard HTTP client; multiple addresses result
+// in a multi client, which fails over between standard HTTP clients for each address.
+// The supplied parameters are applied to each HTTP client; the address parameter
+// is not required as it is supplied separately.
+func NewWithAddresses(ctx context.Context, addresses []string, params ...http.Parameter) (consensusclient.Service, error) {
+	switch len(addresses) {
+	case 0:
+		return nil, errors.New("no addresses specified")
+	case 1:
+		return http.New(ctx, append(params, http.WithAddress(addresses[0]))...)
+	}
+
+	log := zerologger.With().Str("service", "client").Str("impl", "connect").Logger()
+
+	clients := make([]consensusclient.Service, 0, len(addresses))
+	for _, address := range addresses {
+		client, err := http.New(ctx, append(params, http.WithAddress(address))...)
+		if err != nil {
+			log.Warn().Str("address", address).Err(err).Msg("Failed to connect to beacon node; ignoring")
+			continue
+		}
+		clients = append(clients, client)
+	}
+	if len(clients) == 0 {
+		return nil, errors.New("failed to connect to any beacon node")
+	}
+
+	return multi.New(ctx, multi.WithClients(clients))
+}
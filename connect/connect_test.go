@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/connect"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// responses are the responses from the test beacon node.
+var responses = map[string]string{
+	"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+	"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`,
+	"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+	"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+	"/eth/v1/node/version":            `{"data":{"version":"test/v1.0.0"}}`,
+	"/eth/v1/node/syncing":            `{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`,
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv1 := newTestServer(t)
+	srv2 := newTestServer(t)
+	unreachable := "http://localhost:1"
+
+	tests := []struct {
+		name    string
+		address string
+		impl    string
+		err     string
+	}{
+		{
+			name: "Empty",
+			err:  "no addresses specified",
+		},
+		{
+			name:    "Single",
+			address: srv1.URL,
+			impl:    "Standard (HTTP)",
+		},
+		{
+			name:    "Multiple",
+			address: strings.Join([]string{srv1.URL, srv2.URL}, ","),
+			impl:    "multi",
+		},
+		{
+			name:    "MultipleWithSpaces",
+			address: strings.Join([]string{srv1.URL, srv2.URL}, " , "),
+			impl:    "multi",
+		},
+		{
+			name:    "MultipleOneUnreachable",
+			address: strings.Join([]string{srv1.URL, unreachable}, ","),
+			impl:    "multi",
+		},
+		{
+			name:    "MultipleAllUnreachable",
+			address: strings.Join([]string{unreachable, unreachable}, ","),
+			err:     "failed to connect to any beacon node",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := connect.New(ctx, test.address, http.WithLogLevel(zerolog.Disabled))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.impl, client.Name())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Duty is a duty for which a deadline can be calculated.
+type Duty int
+
+const (
+	// DutyUnknown is an unknown duty.
+	DutyUnknown Duty = iota
+	// DutyProposal is a block proposal.
+	DutyProposal
+	// DutyAttestation is an attestation.
+	DutyAttestation
+	// DutyAggregation is an attestation aggregation.
+	DutyAggregation
+	// DutySyncCommitteeMessage is a sync committee message.
+	DutySyncCommitteeMessage
+	// DutySyncCommitteeContribution is a sync committee contribution.
+	DutySyncCommitteeContribution
+)
+
+var dutyStrings = [...]string{
+	"unknown",
+	"proposal",
+	"attestation",
+	"aggregation",
+	"sync committee message",
+	"sync committee contribution",
+}
+
+// String returns a string representation of the duty.
+func (d Duty) String() string {
+	if int(d) >= len(dutyStrings) {
+		return dutyStrings[0]
+	}
+	return dutyStrings[d]
+}
+
+// fraction is the fraction of the way through a slot by which a duty must complete.
+type fraction struct {
+	numerator   int64
+	denominator int64
+}
+
+// dutyFractions are the points in the slot by which each duty must complete.
+// Proposals and messages must be complete by the time attestations are due
+// to be broadcast, aggregates and contributions by the time they are due.
+var dutyFractions = map[Duty]fraction{
+	DutyProposal:                  {1, 3},
+	DutyAttestation:               {1, 3},
+	DutyAggregation:               {2, 3},
+	DutySyncCommitteeMessage:      {1, 3},
+	DutySyncCommitteeContribution: {2, 3},
+}
+
+// Service calculates deadlines for calls relative to the chain clock.
+type Service struct {
+	log       zerolog.Logger
+	chainTime *chaintime.Service
+}
+
+// New creates a new deadline service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "deadline").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:       log,
+		chainTime: parameters.chainTime,
+	}, nil
+}
+
+// SlotFraction returns the time the given fraction of the way through the given slot.
+// For example, a numerator of 1 and denominator of 3 returns the time one third of
+// the way through the slot.
+func (s *Service) SlotFraction(slot phase0.Slot, numerator int64, denominator int64) time.Time {
+	if denominator == 0 {
+		return s.chainTime.SlotStart(slot)
+	}
+
+	return s.chainTime.SlotStart(slot).Add(s.chainTime.SlotDuration() * time.Duration(numerator) / time.Duration(denominator))
+}
+
+// Deadline returns the time by which the given duty for the given slot must complete.
+func (s *Service) Deadline(slot phase0.Slot, duty Duty) (time.Time, error) {
+	fraction, exists := dutyFractions[duty]
+	if !exists {
+		return time.Time{}, fmt.Errorf("no deadline for duty %v", duty)
+	}
+
+	return s.SlotFraction(slot, fraction.numerator, fraction.denominator), nil
+}
+
+// WithDeadline returns a copy of the context with call options attached that
+// bound calls made with it to the deadline for the given duty for the given slot.
+// Any existing call options attached to the context are retained, including
+// an existing deadline if it is earlier than that of the duty.
+func (s *Service) WithDeadline(ctx context.Context, slot phase0.Slot, duty Duty) (context.Context, error) {
+	deadline, err := s.Deadline(slot, duty)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Trace().Uint64("slot", uint64(slot)).Stringer("duty", duty).Time("deadline", deadline).Msg("Attaching deadline to context")
+
+	opts := &api.CallOpts{}
+	if existing := api.CallOptsFromContext(ctx); existing != nil {
+		*opts = *existing
+	}
+	if opts.Deadline.IsZero() || deadline.Before(opts.Deadline) {
+		opts.Deadline = deadline
+	}
+
+	return api.WithCallOpts(ctx, opts), nil
+}
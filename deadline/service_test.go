@@ -0,0 +1,174 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/deadline"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1606824023, 0)
+	mockClient, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []deadline.Parameter
+		err    string
+	}{
+		{
+			name: "ChainTimeMissing",
+			params: []deadline.Parameter{
+				deadline.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no chain time service specified",
+		},
+		{
+			name: "Good",
+			params: []deadline.Parameter{
+				deadline.WithLogLevel(zerolog.Disabled),
+				deadline.WithChainTime(chainTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := deadline.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1606824023, 0)
+	mockClient, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	s, err := deadline.New(ctx,
+		deadline.WithLogLevel(zerolog.Disabled),
+		deadline.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		duty     deadline.Duty
+		expected time.Time
+		err      string
+	}{
+		{
+			name: "Unknown",
+			duty: deadline.DutyUnknown,
+			err:  "no deadline for duty unknown",
+		},
+		{
+			name:     "Attestation",
+			duty:     deadline.DutyAttestation,
+			expected: genesisTime.Add(10*12*time.Second + 4*time.Second),
+		},
+		{
+			name:     "Aggregation",
+			duty:     deadline.DutyAggregation,
+			expected: genesisTime.Add(10*12*time.Second + 8*time.Second),
+		},
+		{
+			name:     "SyncCommitteeContribution",
+			duty:     deadline.DutySyncCommitteeContribution,
+			expected: genesisTime.Add(10*12*time.Second + 8*time.Second),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.Deadline(10, test.duty)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestWithDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1606824023, 0)
+	mockClient, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	require.NoError(t, err)
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	s, err := deadline.New(ctx,
+		deadline.WithLogLevel(zerolog.Disabled),
+		deadline.WithChainTime(chainTime),
+	)
+	require.NoError(t, err)
+
+	require.Nil(t, api.CallOptsFromContext(ctx))
+	opCtx, err := s.WithDeadline(ctx, 10, deadline.DutyAttestation)
+	require.NoError(t, err)
+	opts := api.CallOptsFromContext(opCtx)
+	require.NotNil(t, opts)
+	require.Equal(t, genesisTime.Add(10*12*time.Second+4*time.Second), opts.Deadline)
+
+	// An earlier existing deadline is retained.
+	earlier := genesisTime.Add(10*12*time.Second + 2*time.Second)
+	opCtx, err = s.WithDeadline(api.WithCallOpts(ctx, &api.CallOpts{Deadline: earlier}), 10, deadline.DutyAggregation)
+	require.NoError(t, err)
+	require.Equal(t, earlier, api.CallOptsFromContext(opCtx).Deadline)
+
+	// A later existing deadline is replaced.
+	later := genesisTime.Add(11 * 12 * time.Second)
+	opCtx, err = s.WithDeadline(api.WithCallOpts(ctx, &api.CallOpts{Deadline: later, Timeout: time.Second}), 10, deadline.DutyAggregation)
+	require.NoError(t, err)
+	opts = api.CallOptsFromContext(opCtx)
+	require.Equal(t, genesisTime.Add(10*12*time.Second+8*time.Second), opts.Deadline)
+	require.Equal(t, time.Second, opts.Timeout)
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -28,7 +29,7 @@ type aggregateAttestationDataJSON struct {
 }
 
 // AggregateAttestation fetches the aggregate attestation given an attestation.
-// N.B if an aggregate attestation for the attestation is not available this will return nil without an error.
+// N.B if an aggregate attestation for the attestation is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root) (*phase0.Attestation, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
 	if err != nil {
@@ -44,7 +45,10 @@ func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, at
 	}
 	if aggregateAttestationDataJSON.Data == nil {
 		// Empty response is returned by some nodes if there is no matching aggregate.
-		return nil, nil
+		if s.nilOnNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(api.ErrNotFound, "no aggregate attestation returned")
 	}
 
 	// Ensure the data returned to us is as expected given our input.
@@ -28,7 +28,50 @@ type attestationPoolJSON struct {
 
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
+	attestations, err := s.attestationPool(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for i := range attestations {
+		if attestations[i].Data.Slot != slot {
+			return nil, errors.New("attestation pool entry not for requested slot")
+		}
+	}
+
+	return attestations, nil
+}
+
+// AttestationPoolForCommittee obtains the attestation pool for a given slot and committee.
+func (s *Service) AttestationPoolForCommittee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]*phase0.Attestation,
+	error,
+) {
+	attestations, err := s.attestationPool(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d&committee_index=%d", slot, committeeIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for i := range attestations {
+		if attestations[i].Data.Slot != slot {
+			return nil, errors.New("attestation pool entry not for requested slot")
+		}
+		if attestations[i].Data.Index != committeeIndex {
+			return nil, errors.New("attestation pool entry not for requested committee")
+		}
+	}
+
+	return attestations, nil
+}
+
+// attestationPool obtains the attestation pool from the given URL.
+func (s *Service) attestationPool(ctx context.Context, url string) ([]*phase0.Attestation, error) {
+	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation pool")
 	}
@@ -40,14 +83,12 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 	if err := json.NewDecoder(respBodyReader).Decode(&attestationPoolJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse attestation pool")
 	}
-
-	// Ensure the data returned to us is as expected given our input.
 	if attestationPoolJSON.Data == nil {
 		return nil, errors.New("attestation pool not returned")
 	}
 	for i := range attestationPoolJSON.Data {
-		if attestationPoolJSON.Data[i].Data.Slot != slot {
-			return nil, errors.New("attestation pool entry not for requested slot")
+		if attestationPoolJSON.Data[i].Data == nil {
+			return nil, errors.New("attestation pool entry missing data")
 		}
 	}
 
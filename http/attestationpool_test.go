@@ -15,6 +15,8 @@ package http_test
 
 import (
 	"context"
+	"encoding/json"
+	nethttp "net/http"
 	"os"
 	"testing"
 	"time"
@@ -22,6 +24,7 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +68,61 @@ func TestAttestationPool(t *testing.T) {
 		})
 	}
 }
+
+func TestAttestationPoolForCommittee(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attestations := []*phase0.Attestation{
+		{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data: &phase0.AttestationData{
+				Slot:   5,
+				Index:  2,
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+		{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data: &phase0.AttestationData{
+				Slot:   5,
+				Index:  3,
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+	}
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/beacon/pool/attestations" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		// The server returns the attestations for committee 2 regardless of the
+		// requested committee, to check that mismatched results are rejected.
+		if r.URL.Query().Get("slot") != "5" {
+			w.WriteHeader(nethttp.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&struct {
+			Data []*phase0.Attestation `json:"data"`
+		}{
+			Data: attestations[:1],
+		})
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	res, err := service.(client.AttestationPoolProvider).AttestationPoolForCommittee(ctx, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, attestations[:1], res)
+
+	_, err = service.(client.AttestationPoolProvider).AttestationPoolForCommittee(ctx, 5, 3)
+	require.EqualError(t, err, "attestation pool entry not for requested committee")
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type attestationRewardsJSON struct {
+	Data *api.AttestationRewards `json:"data"`
+}
+
+// AttestationRewards provides the attestation rewards for a given epoch.
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+// will be applied.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+
+	return s.attestationRewards(ctx, epoch, ids)
+}
+
+// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+// supplied no filter will be applied.
+func (s *Service) AttestationRewardsByPubKey(ctx context.Context, epoch phase0.Epoch, validatorPubKeys []phase0.BLSPubKey) (*api.AttestationRewards, error) {
+	ids := make([]string, len(validatorPubKeys))
+	for i := range validatorPubKeys {
+		ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
+	}
+
+	return s.attestationRewards(ctx, epoch, ids)
+}
+
+// attestationRewards obtains the attestation rewards for the given validator IDs.
+func (s *Service) attestationRewards(ctx context.Context, epoch phase0.Epoch, ids []string) (*api.AttestationRewards, error) {
+	reqBody, err := json.Marshal(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator IDs")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attestation rewards")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain attestation rewards")
+	}
+
+	var attestationRewardsJSON attestationRewardsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&attestationRewardsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attestation rewards")
+	}
+	if attestationRewardsJSON.Data == nil {
+		return nil, errors.New("no attestation rewards returned")
+	}
+
+	return attestationRewardsJSON.Data, nil
+}
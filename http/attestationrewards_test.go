@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationRewards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requestBody string
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodPost || r.URL.Path != "/eth/v1/beacon/rewards/attestations/10" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		requestBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":true,"data":{"ideal_rewards":[{"effective_balance":"32000000000","head":"2500","target":"5000","source":"5000","inactivity":"0"}],"total_rewards":[{"validator_index":"1","head":"2000","target":"-2000","source":"4000","inactivity":"0"}]}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	rewards, err := service.(client.AttestationRewardsProvider).AttestationRewards(ctx, 10, []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, `["1","2"]`, requestBody)
+	require.Len(t, rewards.IdealRewards, 1)
+	require.Len(t, rewards.TotalRewards, 1)
+	require.Equal(t, phase0.ValidatorIndex(1), rewards.TotalRewards[0].ValidatorIndex)
+	require.Equal(t, int64(-2000), rewards.TotalRewards[0].Target)
+
+	_, err = service.(client.AttestationRewardsProvider).AttestationRewardsByPubKey(ctx, 10, []phase0.BLSPubKey{{0x01}})
+	require.NoError(t, err)
+	require.Equal(t, `["0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"]`, requestBody)
+
+	_, err = service.(client.AttestationRewardsProvider).AttestationRewards(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, requestBody)
+}
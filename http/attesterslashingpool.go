@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type attesterSlashingPoolJSON struct {
+	Data []*phase0.AttesterSlashing `json:"data"`
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Service) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/pool/attester_slashings")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attester slashing pool")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain attester slashing pool")
+	}
+
+	var attesterSlashingPoolJSON attesterSlashingPoolJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&attesterSlashingPoolJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attester slashing pool")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if attesterSlashingPoolJSON.Data == nil {
+		return nil, errors.New("attester slashing pool not returned")
+	}
+
+	return attesterSlashingPoolJSON.Data, nil
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// authorization returns the value of the authorization header for requests,
+// or an empty string if no authorization is configured.
+func (s *Service) authorization(ctx context.Context) (string, error) {
+	token := s.bearerToken
+	if s.tokenProvider != nil {
+		var err error
+		token, err = s.tokenProvider(ctx)
+		if err != nil {
+			return "", err
+		}
+		if token == "" {
+			return "", errors.New("token provider returned empty token")
+		}
+		// Tokens from the provider can change, so the redactor is kept up to date.
+		s.redactor.setToken(token)
+	}
+	if token == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("Bearer %s", token), nil
+}
+
+// setAuthorization sets the authorization header for the request, if configured.
+func (s *Service) setAuthorization(req *http.Request) error {
+	authorization, err := s.authorization(req.Context())
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain authorization token")
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorization(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var authorization string
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		mu.Lock()
+		authorization = r.Header.Get("Authorization")
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`))
+	})
+
+	// Static token.
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithBearerToken("static"),
+	)
+	require.NoError(t, err)
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	mu.Lock()
+	require.Equal(t, "Bearer static", authorization)
+	mu.Unlock()
+
+	// Rotating token.
+	calls := 0
+	service, err = http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTokenProvider(func(_ context.Context) (string, error) {
+			calls++
+			return fmt.Sprintf("token%d", calls), nil
+		}),
+	)
+	require.NoError(t, err)
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	mu.Lock()
+	first := authorization
+	mu.Unlock()
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	mu.Lock()
+	require.NotEqual(t, first, authorization)
+	mu.Unlock()
+
+	// Failing token provider.
+	_, err = http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTokenProvider(func(_ context.Context) (string, error) {
+			return "", errors.New("token unavailable")
+		}),
+	)
+	require.ErrorContains(t, err, "failed to obtain authorization token: token unavailable")
+
+	// Both supplied.
+	_, err = http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithBearerToken("static"),
+		http.WithTokenProvider(func(_ context.Context) (string, error) {
+			return "dynamic", nil
+		}),
+	)
+	require.EqualError(t, err, "problem with parameters: cannot specify both bearer token and token provider")
+}
@@ -14,11 +14,14 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
@@ -26,6 +29,10 @@ type beaconBlockHeaderJSON struct {
 	Data *api.BeaconBlockHeader `json:"data"`
 }
 
+type beaconBlockHeadersJSON struct {
+	Data []*api.BeaconBlockHeader `json:"data"`
+}
+
 // BeaconBlockHeader provides the block header of a given block ID.
 func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/headers/%s", blockID))
@@ -43,3 +50,53 @@ func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.B
 
 	return resp.Data, nil
 }
+
+// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+// Either filter may be nil; if both are nil the header of the canonical head is returned.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	slot *phase0.Slot,
+	parentRoot *phase0.Root,
+) (
+	[]*api.BeaconBlockHeader,
+	error,
+) {
+	filters := make([]string, 0, 2)
+	if slot != nil {
+		filters = append(filters, fmt.Sprintf("slot=%d", *slot))
+	}
+	if parentRoot != nil {
+		filters = append(filters, fmt.Sprintf("parent_root=%#x", *parentRoot))
+	}
+	url := "/eth/v1/beacon/headers"
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
+	}
+
+	respBodyReader, err := s.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request beacon block headers")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var resp beaconBlockHeadersJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse beacon block headers")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for _, header := range resp.Data {
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			return nil, errors.New("beacon block header missing")
+		}
+		if slot != nil && header.Header.Message.Slot != *slot {
+			return nil, errors.New("beacon block header not for requested slot")
+		}
+		if parentRoot != nil && !bytes.Equal(header.Header.Message.ParentRoot[:], parentRoot[:]) {
+			return nil, errors.New("beacon block header not for requested parent root")
+		}
+	}
+
+	return resp.Data, nil
+}
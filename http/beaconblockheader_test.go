@@ -15,11 +15,14 @@ package http_test
 
 import (
 	"context"
+	"fmt"
+	nethttp "net/http"
 	"os"
 	"testing"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,3 +54,73 @@ func TestBeaconBlockHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconBlockHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	header := `{"root":"0xbc354f1a5f27f8d096eee9e6b6139e1b730385f9752513832a57c9849a149df7","canonical":true,"header":{"message":{"slot":"585321","proposer_index":"29787","parent_root":"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df","state_root":"0x4e405274abd4f59c6a2268b4e6ca93dba01e15ae6b56401fb20a1ad9701b036d","body_root":"0x57bb79520694c132a35dc887cac2e4dad9acc5ded58b5ae66b491644ab8835c8"},"signature":"0xa8d684242ee025ee96e877b28433d93176072b8c8e8295609501863147bb1d174b8a16aed661d001f30859c9e42c0f9d18ea35786a9bdf115dff1877980046e19e0e4c9310e281f8129f2692ddc4680673ab78b7f8db72f91be7863dd9fe1e55"}}`
+	slot := phase0.Slot(585321)
+	otherSlot := phase0.Slot(585322)
+	parentRoot := phase0.Root{0xba, 0x4d, 0x78, 0x42, 0x93, 0xdf, 0x28, 0xba, 0xb7, 0x71, 0xa1, 0x4d, 0xf5, 0x8c, 0xdb, 0xed, 0x9d, 0x8d, 0x64, 0xaf, 0xd0, 0xdd, 0xf1, 0xc5, 0x2d, 0xff, 0x3e, 0x25, 0xfc, 0xdd, 0x51, 0xdf}
+
+	tests := []struct {
+		name       string
+		slot       *phase0.Slot
+		parentRoot *phase0.Root
+		query      string
+		expected   int
+		err        string
+	}{
+		{
+			name:     "NoFilters",
+			expected: 2,
+		},
+		{
+			name:     "Slot",
+			slot:     &slot,
+			query:    "slot=585321",
+			expected: 2,
+		},
+		{
+			name:       "SlotAndParentRoot",
+			slot:       &slot,
+			parentRoot: &parentRoot,
+			query:      "slot=585321&parent_root=0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df",
+			expected:   2,
+		},
+		{
+			name:  "SlotMismatch",
+			slot:  &otherSlot,
+			query: "slot=585322",
+			err:   "beacon block header not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/beacon/headers" || r.URL.RawQuery != test.query {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s,%s]}`, header, header)))
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			headers, err := service.(client.BeaconBlockHeadersProvider).BeaconBlockHeaders(ctx, test.slot, test.parentRoot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, headers, test.expected)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -51,6 +52,10 @@ type denebBeaconBlockProposalJSON struct {
 	Data *deneb.BeaconBlock `json:"data"`
 }
 
+type electraBeaconBlockProposalJSON struct {
+	Data *electra.BeaconBlock `json:"data"`
+}
+
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*spec.VersionedBeaconBlock, error) {
 	// Graffiti should be 32 bytes.
@@ -160,8 +165,13 @@ func (s *Service) beaconBlockProposalFromSSZ(res *httpResponse) (*spec.Versioned
 		if err := block.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb beacon block proposal")
 		}
+	case spec.DataVersionElectra:
+		block.Electra = &electra.BeaconBlock{}
+		if err := block.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra beacon block proposal")
+		}
 	default:
-		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unhandled block proposal version %s", res.consensusVersion)
 	}
 
 	return block, nil
@@ -204,8 +214,14 @@ func (s *Service) beaconBlockProposalFromJSON(res *httpResponse) (*spec.Versione
 			return nil, errors.Wrap(err, "failed to parse deneb beacon block proposal")
 		}
 		block.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraBeaconBlockProposalJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra beacon block proposal")
+		}
+		block.Electra = resp.Data
 	default:
-		return nil, fmt.Errorf("unsupported block version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unsupported block version %s", res.consensusVersion)
 	}
 
 	return block, nil
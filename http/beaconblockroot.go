@@ -33,7 +33,7 @@ type beaconBlockRootDataJSON struct {
 }
 
 // BeaconBlockRoot fetches a block's root given a block ID.
-// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+// N.B if a signed beacon block for the block ID is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.Root, error) {
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s/root", blockID))
 	if err != nil {
@@ -14,7 +14,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -25,43 +24,32 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
 
-type phase0BeaconStateJSON struct {
-	Data *phase0.BeaconState `json:"data"`
-}
-
-type altairBeaconStateJSON struct {
-	Data *altair.BeaconState `json:"data"`
-}
-
-type bellatrixBeaconStateJSON struct {
-	Data *bellatrix.BeaconState `json:"data"`
-}
-
-type capellaBeaconStateJSON struct {
-	Data *capella.BeaconState `json:"data"`
-}
-
-type denebBeaconStateJSON struct {
-	Data *deneb.BeaconState `json:"data"`
-}
-
 // BeaconState fetches a beacon state.
-// N.B if the requested beacon state is not available this will return nil without an error.
+// N.B if the requested beacon state is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
-	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID))
+	// Beacon states are large, so are decoded as they are received rather than buffered.
+	res, err := s.getStream(ctx, fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID), "")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon state")
 	}
+	defer res.Close()
 	if res.statusCode == http.StatusNotFound {
 		return nil, nil
 	}
+	if res.body == nil {
+		return nil, errors.New("no beacon state returned")
+	}
 
 	switch res.contentType {
 	case ContentTypeSSZ:
+		if res.consensusVersion == spec.DataVersionUnknown {
+			return nil, errors.New("failed to parse consensus version: no consensus version header")
+		}
 		return s.beaconStateFromSSZ(res)
 	case ContentTypeJSON:
 		return s.beaconStateFromJSON(res)
@@ -70,7 +58,9 @@ func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.Versio
 	}
 }
 
-func (s *Service) beaconStateFromSSZ(res *httpResponse) (*spec.VersionedBeaconState, error) {
+// beaconStateFromSSZ decodes a beacon state as it is read from the response body,
+// so that the encoding is not held in memory alongside the decoded state.
+func (s *Service) beaconStateFromSSZ(res *httpStreamResponse) (*spec.VersionedBeaconState, error) {
 	state := &spec.VersionedBeaconState{
 		Version: res.consensusVersion,
 	}
@@ -78,74 +68,85 @@ func (s *Service) beaconStateFromSSZ(res *httpResponse) (*spec.VersionedBeaconSt
 	switch res.consensusVersion {
 	case spec.DataVersionPhase0:
 		state.Phase0 = &phase0.BeaconState{}
-		if err := state.Phase0.UnmarshalSSZ(res.body); err != nil {
+		if err := state.Phase0.UnmarshalSSZFrom(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode phase0 beacon state")
 		}
 	case spec.DataVersionAltair:
 		state.Altair = &altair.BeaconState{}
-		if err := state.Altair.UnmarshalSSZ(res.body); err != nil {
+		if err := state.Altair.UnmarshalSSZFrom(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode altair beacon state")
 		}
 	case spec.DataVersionBellatrix:
 		state.Bellatrix = &bellatrix.BeaconState{}
-		if err := state.Bellatrix.UnmarshalSSZ(res.body); err != nil {
+		if err := state.Bellatrix.UnmarshalSSZFrom(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode bellatrix beacon state")
 		}
 	case spec.DataVersionCapella:
 		state.Capella = &capella.BeaconState{}
-		if err := state.Capella.UnmarshalSSZ(res.body); err != nil {
+		if err := state.Capella.UnmarshalSSZFrom(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode capella beacon state")
 		}
 	case spec.DataVersionDeneb:
 		state.Deneb = &deneb.BeaconState{}
-		if err := state.Deneb.UnmarshalSSZ(res.body); err != nil {
+		if err := state.Deneb.UnmarshalSSZFrom(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb beacon state")
 		}
+	case spec.DataVersionElectra:
+		state.Electra = &electra.BeaconState{}
+		if err := state.Electra.UnmarshalSSZFrom(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra beacon state")
+		}
 	default:
-		return nil, fmt.Errorf("unhandled state version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unhandled state version %s", res.consensusVersion)
 	}
 
 	return state, nil
 }
 
-func (s *Service) beaconStateFromJSON(res *httpResponse) (*spec.VersionedBeaconState, error) {
-	state := &spec.VersionedBeaconState{
-		Version: res.consensusVersion,
-	}
-
-	reader := bytes.NewBuffer(res.body)
-
-	switch state.Version {
-	case spec.DataVersionPhase0:
-		var resp phase0BeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse phase 0 beacon state")
-		}
-		state.Phase0 = resp.Data
-	case spec.DataVersionAltair:
-		var resp altairBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse altair beacon state")
-		}
-		state.Altair = resp.Data
-	case spec.DataVersionBellatrix:
-		var resp bellatrixBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse bellatrix beacon state")
-		}
-		state.Bellatrix = resp.Data
-	case spec.DataVersionCapella:
-		var resp capellaBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse capella beacon state")
+func (s *Service) beaconStateFromJSON(res *httpStreamResponse) (*spec.VersionedBeaconState, error) {
+	state := &spec.VersionedBeaconState{}
+
+	var err error
+	state.Version, err = decodeVersionedJSON(res.body, res.consensusVersion, func(version spec.DataVersion, decoder *json.Decoder) error {
+		switch version {
+		case spec.DataVersionPhase0:
+			state.Phase0 = &phase0.BeaconState{}
+			if err := decoder.Decode(state.Phase0); err != nil {
+				return errors.Wrap(err, "failed to parse phase 0 beacon state")
+			}
+		case spec.DataVersionAltair:
+			state.Altair = &altair.BeaconState{}
+			if err := decoder.Decode(state.Altair); err != nil {
+				return errors.Wrap(err, "failed to parse altair beacon state")
+			}
+		case spec.DataVersionBellatrix:
+			state.Bellatrix = &bellatrix.BeaconState{}
+			if err := decoder.Decode(state.Bellatrix); err != nil {
+				return errors.Wrap(err, "failed to parse bellatrix beacon state")
+			}
+		case spec.DataVersionCapella:
+			state.Capella = &capella.BeaconState{}
+			if err := decoder.Decode(state.Capella); err != nil {
+				return errors.Wrap(err, "failed to parse capella beacon state")
+			}
+		case spec.DataVersionDeneb:
+			state.Deneb = &deneb.BeaconState{}
+			if err := decoder.Decode(state.Deneb); err != nil {
+				return errors.Wrap(err, "failed to parse deneb beacon state")
+			}
+		case spec.DataVersionElectra:
+			state.Electra = &electra.BeaconState{}
+			if err := decoder.Decode(state.Electra); err != nil {
+				return errors.Wrap(err, "failed to parse electra beacon state")
+			}
+		default:
+			return newUnsupportedVersionError("unhandled state version %s", version)
 		}
-		state.Capella = resp.Data
-	case spec.DataVersionDeneb:
-		var resp denebBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "failed to parse deneb beacon state")
-		}
-		state.Deneb = resp.Data
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return state, nil
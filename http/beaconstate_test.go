@@ -15,12 +15,21 @@ package http_test
 
 import (
 	"context"
+	"errors"
+	nethttp "net/http"
 	"os"
 	"testing"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +69,165 @@ func TestBeaconState(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconStateSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state := &phase0.BeaconState{
+		GenesisTime:       1606824023,
+		Slot:              12345,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Validators: []*phase0.Validator{
+			{
+				PublicKey:             phase0.BLSPubKey{0x01},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+			},
+		},
+		Balances:                    []phase0.Gwei{32000000001},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           bitfield.Bitvector4{0x0f},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		maxResponseSize int64
+		err             bool
+	}{
+		{
+			name: "Good",
+		},
+		{
+			name:            "ExceedsLimit",
+			maxResponseSize: int64(len(data) - 1),
+			err:             true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v2/debug/beacon/states/head" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Header().Set("Eth-Consensus-Version", "phase0")
+				_, _ = w.Write(data)
+			})
+
+			params := []http.Parameter{
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			}
+			if test.maxResponseSize != 0 {
+				params = append(params, http.WithMaxResponseSize(test.maxResponseSize))
+			}
+			service, err := http.New(ctx, params...)
+			require.NoError(t, err)
+
+			res, err := service.(client.BeaconStateProvider).BeaconState(ctx, "head")
+			if test.err {
+				var tooLarge http.ResponseTooLargeError
+				require.True(t, errors.As(err, &tooLarge))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, spec.DataVersionPhase0, res.Version)
+				require.Equal(t, state.Validators, res.Phase0.Validators)
+				require.Equal(t, state.Balances, res.Phase0.Balances)
+				require.Equal(t, state.Slot, res.Phase0.Slot)
+			}
+		})
+	}
+}
+
+func TestBeaconStateSSZElectra(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncCommittee := &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+	state := &electra.BeaconState{
+		GenesisTime:       1606824023,
+		Slot:              12345,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Validators: []*phase0.Validator{
+			{
+				PublicKey:             phase0.BLSPubKey{0x01},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+			},
+			{
+				PublicKey:             phase0.BLSPubKey{0x02},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      31000000000,
+			},
+		},
+		Balances:                    []phase0.Gwei{32000000001, 31000000002},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:  []altair.ParticipationFlags{0x07, 0x03},
+		CurrentEpochParticipation:   []altair.ParticipationFlags{0x01, 0x00},
+		JustificationBits:           bitfield.Bitvector4{0x0f},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+		InactivityScores:            []uint64{0, 4},
+		CurrentSyncCommittee:        syncCommittee,
+		NextSyncCommittee:           syncCommittee,
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+		HistoricalSummaries: []*capella.HistoricalSummary{{}},
+		PendingConsolidations: []*electra.PendingConsolidation{
+			{SourceIndex: 0, TargetIndex: 1},
+		},
+	}
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	stateRoot, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v2/debug/beacon/states/head" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Eth-Consensus-Version", "electra")
+		_, _ = w.Write(data)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// The per-validator lists are streamed from the response.
+	res, err := service.(client.BeaconStateProvider).BeaconState(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, res.Version)
+	require.Equal(t, state.Validators, res.Electra.Validators)
+	require.Equal(t, state.Balances, res.Electra.Balances)
+	require.Equal(t, state.InactivityScores, res.Electra.InactivityScores)
+	root, err := res.Electra.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, stateRoot, root)
+}
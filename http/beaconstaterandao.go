@@ -38,7 +38,21 @@ func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase
 		return nil, errors.New("no state ID specified")
 	}
 
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/randao", stateID))
+	return s.beaconStateRandao(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/randao", stateID))
+}
+
+// BeaconStateRandaoAtEpoch fetches the RANDAO mix for the given epoch given a state ID.
+func (s *Service) BeaconStateRandaoAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*phase0.Root, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	return s.beaconStateRandao(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/randao?epoch=%d", stateID, epoch))
+}
+
+// beaconStateRandao fetches a beacon state RANDAO from the given URL.
+func (s *Service) beaconStateRandao(ctx context.Context, url string) (*phase0.Root, error) {
+	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request state RANDAO")
 	}
@@ -50,11 +64,17 @@ func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase
 	if err := json.NewDecoder(respBodyReader).Decode(&data); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state RANDAO")
 	}
+	if data.Data == nil {
+		return nil, errors.New("no state RANDAO returned")
+	}
 
 	bytes, err := hex.DecodeString(strings.TrimPrefix(data.Data.Randao, "0x"))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse state RANDAO value")
 	}
+	if len(bytes) != phase0.RootLength {
+		return nil, errors.New("incorrect length for state RANDAO value")
+	}
 	var stateRandao phase0.Root
 	copy(stateRandao[:], bytes)
 
@@ -16,11 +16,13 @@ package http_test
 import (
 	"context"
 	"fmt"
+	nethttp "net/http"
 	"os"
 	"testing"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/stretchr/testify/require"
 )
 
@@ -74,3 +76,33 @@ func TestBeaconStateRandao(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconStateRandaoAtEpoch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/beacon/states/head/randao" || r.URL.RawQuery != "epoch=5" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"randao":"0x0101010101010101010101010101010101010101010101010101010101010101"}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.BeaconStateRandaoAtEpochProvider).BeaconStateRandaoAtEpoch(ctx, "", 5)
+	require.EqualError(t, err, "no state ID specified")
+
+	randao, err := service.(client.BeaconStateRandaoAtEpochProvider).BeaconStateRandaoAtEpoch(ctx, "head", 5)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+	}, *randao)
+}
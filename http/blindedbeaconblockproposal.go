@@ -24,6 +24,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -43,6 +44,10 @@ type denebBlindedBeaconBlockProposalJSON struct {
 	Data *apiv1deneb.BlindedBeaconBlock `json:"data"`
 }
 
+type electraBlindedBeaconBlockProposalJSON struct {
+	Data *apiv1electra.BlindedBeaconBlock `json:"data"`
+}
+
 // BlindedBeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error) {
 	// Graffiti should be 32 bytes.
@@ -140,8 +145,13 @@ func (s *Service) blindedBeaconBlockProposalFromSSZ(res *httpResponse) (*api.Ver
 		if err := block.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb blinded beacon block proposal")
 		}
+	case spec.DataVersionElectra:
+		block.Electra = &apiv1electra.BlindedBeaconBlock{}
+		if err := block.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra blinded beacon block proposal")
+		}
 	default:
-		return nil, fmt.Errorf("unhandled block proposal version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unhandled block proposal version %s", res.consensusVersion)
 	}
 
 	return block, nil
@@ -172,8 +182,14 @@ func (s *Service) blindedBeaconBlockProposalFromJSON(res *httpResponse) (*api.Ve
 			return nil, errors.Wrap(err, "failed to parse deneb blinded beacon block proposal")
 		}
 		block.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraBlindedBeaconBlockProposalJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra blinded beacon block proposal")
+		}
+		block.Electra = resp.Data
 	default:
-		return nil, fmt.Errorf("unsupported block version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unsupported block version %s", res.consensusVersion)
 	}
 
 	return block, nil
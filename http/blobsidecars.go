@@ -0,0 +1,117 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type blobSidecarsJSON struct {
+	Data []*deneb.BlobSidecar `json:"data"`
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+// indices is a list of blob indices to restrict the returned values.  If no indices are supplied no filter
+// will be applied.
+// N.B if the block is not available this will return an error that matches api.ErrNotFound.
+func (s *Service) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID)
+	if len(indices) > 0 {
+		ids := make([]string, len(indices))
+		for i := range indices {
+			ids[i] = fmt.Sprintf("%d", indices[i])
+		}
+		url = fmt.Sprintf("%s?indices=%s", url, strings.Join(ids, ","))
+	}
+
+	// Blobs are large, so the response is decoded as it is received rather than buffered.
+	res, err := s.getStream(ctx, url, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request blob sidecars")
+	}
+	defer res.Close()
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.body == nil {
+		return nil, errors.New("no blob sidecars returned")
+	}
+
+	var blobSidecars []*deneb.BlobSidecar
+	switch res.contentType {
+	case ContentTypeSSZ:
+		blobSidecars, err = s.blobSidecarsFromSSZ(res)
+	case ContentTypeJSON:
+		blobSidecars, err = s.blobSidecarsFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Data is not guaranteed to be returned in index order, so fix that.
+	sort.Slice(blobSidecars, func(i int, j int) bool {
+		return blobSidecars[i].Index < blobSidecars[j].Index
+	})
+
+	return blobSidecars, nil
+}
+
+func (*Service) blobSidecarsFromSSZ(res *httpStreamResponse) ([]*deneb.BlobSidecar, error) {
+	data, err := res.readAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read blob sidecars")
+	}
+
+	// Blob sidecars are fixed size, so the list is a simple concatenation of its items.
+	itemSize := (&deneb.BlobSidecar{}).SizeSSZ()
+	if len(data)%itemSize != 0 {
+		return nil, fmt.Errorf("invalid length %d for blob sidecars", len(data))
+	}
+
+	blobSidecars := make([]*deneb.BlobSidecar, len(data)/itemSize)
+	for i := range blobSidecars {
+		blobSidecars[i] = &deneb.BlobSidecar{}
+		if err := blobSidecars[i].UnmarshalSSZ(data[i*itemSize : (i+1)*itemSize]); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode blob sidecar %d", i)
+		}
+	}
+
+	return blobSidecars, nil
+}
+
+func (*Service) blobSidecarsFromJSON(res *httpStreamResponse) ([]*deneb.BlobSidecar, error) {
+	var resp blobSidecarsJSON
+	if err := json.NewDecoder(res.body).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse blob sidecars")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no blob sidecars returned")
+	}
+
+	return resp.Data, nil
+}
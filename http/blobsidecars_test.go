@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSidecars(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Sidecars are served out of order, to confirm that they are sorted.
+	sidecars := []*deneb.BlobSidecar{
+		{Index: 1, Slot: 100, ProposerIndex: 2},
+		{Index: 0, Slot: 100, ProposerIndex: 2},
+	}
+	var sszData []byte
+	for _, sidecar := range sidecars {
+		data, err := sidecar.MarshalSSZ()
+		require.NoError(t, err)
+		sszData = append(sszData, data...)
+	}
+	jsonData, err := json.Marshal(&struct {
+		Data []*deneb.BlobSidecar `json:"data"`
+	}{
+		Data: sidecars,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		blockID     string
+		indices     []deneb.BlobIndex
+		contentType string
+		query       string
+		err         string
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:        "SSZ",
+			blockID:     "head",
+			contentType: "application/octet-stream",
+		},
+		{
+			name:        "JSON",
+			blockID:     "head",
+			contentType: "application/json",
+		},
+		{
+			name:        "Indices",
+			blockID:     "head",
+			indices:     []deneb.BlobIndex{0, 1},
+			contentType: "application/octet-stream",
+			query:       "indices=0,1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/beacon/blob_sidecars/head" || r.URL.RawQuery != test.query {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				if test.contentType == "application/json" {
+					_, _ = w.Write(jsonData)
+				} else {
+					_, _ = w.Write(sszData)
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.BlobSidecarsProvider).BlobSidecars(ctx, test.blockID, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+				require.Equal(t, deneb.BlobIndex(0), res[0].Index)
+				require.Equal(t, deneb.BlobIndex(1), res[1].Index)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type phase0BlockAttestationsJSON struct {
+	Data []*phase0.Attestation `json:"data"`
+}
+
+// BlockAttestations fetches the attestations in a beacon block given a block ID.
+// The v2 endpoint is used once the chain has reached Electra, and the v1 endpoint otherwise.
+func (s *Service) BlockAttestations(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error) {
+	version, err := s.currentDataVersion(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain data version")
+	}
+
+	if version >= spec.DataVersionElectra {
+		return s.blockAttestationsV2(ctx, blockID)
+	}
+
+	return s.blockAttestationsV1(ctx, blockID)
+}
+
+func (s *Service) blockAttestationsV1(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s/attestations", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request block attestations")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var blockAttestationsJSON phase0BlockAttestationsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&blockAttestationsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse block attestations")
+	}
+	if blockAttestationsJSON.Data == nil {
+		return nil, errors.New("block attestations not returned")
+	}
+
+	// The v1 endpoint does not provide the version of the block, so use the version
+	// at the slot of each attestation.
+	res := make([]*spec.VersionedAttestation, len(blockAttestationsJSON.Data))
+	for i, attestation := range blockAttestationsJSON.Data {
+		if attestation == nil || attestation.Data == nil {
+			return nil, errors.New("block attestation missing data")
+		}
+		version, err := s.dataVersionAtSlot(ctx, attestation.Data.Slot)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain data version")
+		}
+		res[i], err = newVersionedPhase0Attestation(version, attestation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) blockAttestationsV2(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error) {
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s/attestations", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request block attestations")
+	}
+	if res.body == nil {
+		return nil, nil
+	}
+
+	var blockAttestationsJSON versionedAttestationDataJSON
+	if err := json.Unmarshal(res.body, &blockAttestationsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse block attestations")
+	}
+	if blockAttestationsJSON.Data == nil || bytes.Equal(blockAttestationsJSON.Data, []byte("null")) {
+		return nil, errors.New("block attestations not returned")
+	}
+
+	return decodeVersionedAttestations(res.consensusVersion, blockAttestationsJSON.Data)
+}
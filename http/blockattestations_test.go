@@ -0,0 +1,94 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v2/beacon/blocks/head/attestations":
+			w.Header().Set("Eth-Consensus-Version", "electra")
+			_, _ = fmt.Fprintf(w, `{"version":"electra","execution_optimistic":false,"finalized":false,"data":[%s,%s]}`,
+				testElectraAttestationJSON(3200, 2), testElectraAttestationJSON(3200, 5))
+		case "/eth/v2/beacon/blocks/1/attestations":
+			w.Header().Set("Eth-Consensus-Version", "deneb")
+			_, _ = fmt.Fprintf(w, `{"version":"deneb","execution_optimistic":false,"finalized":true,"data":[%s]}`,
+				testPhase0AttestationJSON(0, 2))
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		blockID         string
+		expectedVersion spec.DataVersion
+		expectedCount   int
+		err             string
+	}{
+		{
+			name:            "Electra",
+			blockID:         "head",
+			expectedVersion: spec.DataVersionElectra,
+			expectedCount:   2,
+		},
+		{
+			name:            "Deneb",
+			blockID:         "1",
+			expectedVersion: spec.DataVersionDeneb,
+			expectedCount:   1,
+		},
+		{
+			name:    "Unknown",
+			blockID: "2",
+			err:     "failed to request block attestations: GET failed with status 404: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attestations, err := service.(client.BlockAttestationsProvider).BlockAttestations(ctx, test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, attestations, test.expectedCount)
+			for _, attestation := range attestations {
+				require.Equal(t, test.expectedVersion, attestation.Version)
+			}
+		})
+	}
+}
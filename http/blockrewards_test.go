@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/beacon/rewards/blocks/head" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":false,"data":{"proposer_index":"123","total":"1000","attestations":"800","sync_aggregate":"150","proposer_slashings":"30","attester_slashings":"20"}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		blockID  string
+		expected *api.BlockRewards
+		err      string
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:    "Good",
+			blockID: "head",
+			expected: &api.BlockRewards{
+				ProposerIndex:     123,
+				Total:             1000,
+				Attestations:      800,
+				SyncAggregate:     150,
+				ProposerSlashings: 30,
+				AttesterSlashings: 20,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blockRewards, err := service.(client.BlockRewardsProvider).BlockRewards(ctx, test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, blockRewards)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+type blsToExecutionChangePoolJSON struct {
+	Data []*capella.SignedBLSToExecutionChange `json:"data"`
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Service) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/pool/bls_to_execution_changes")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request BLS to execution change pool")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain BLS to execution change pool")
+	}
+
+	var blsToExecutionChangePoolJSON blsToExecutionChangePoolJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&blsToExecutionChangePoolJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse BLS to execution change pool")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	if blsToExecutionChangePoolJSON.Data == nil {
+		return nil, errors.New("BLS to execution change pool not returned")
+	}
+
+	return blsToExecutionChangePoolJSON.Data, nil
+}
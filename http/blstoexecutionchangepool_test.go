@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLSToExecutionChangePool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	change := &capella.SignedBLSToExecutionChange{
+		Message: &capella.BLSToExecutionChange{
+			ValidatorIndex: 1,
+		},
+	}
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodGet || r.URL.Path != "/eth/v1/beacon/pool/bls_to_execution_changes" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&struct {
+			Data []*capella.SignedBLSToExecutionChange `json:"data"`
+		}{
+			Data: []*capella.SignedBLSToExecutionChange{change},
+		})
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	pool, err := service.(client.BLSToExecutionChangePoolProvider).BLSToExecutionChangePool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*capella.SignedBLSToExecutionChange{change}, pool)
+}
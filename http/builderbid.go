@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSignedBuilderBidJSON struct {
+	Data *apiv1bellatrix.SignedBuilderBid `json:"data"`
+}
+
+type capellaSignedBuilderBidJSON struct {
+	Data *apiv1capella.SignedBuilderBid `json:"data"`
+}
+
+type denebSignedBuilderBidJSON struct {
+	Data *apiv1deneb.SignedBuilderBid `json:"data"`
+}
+
+// BuilderBid fetches the bid of a builder for the execution payload of the given slot,
+// built on the given parent hash, for the proposer with the given public key.
+// This is served by builders and relays rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+// N.B if the builder does not have a bid this will return an error that matches api.ErrNotFound.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", slot, parentHash, pubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request builder bid")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.statusCode == http.StatusNoContent {
+		// The builder does not have a bid.
+		if s.nilOnNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(api.ErrNotFound, "no builder bid returned")
+	}
+
+	var bid *api.VersionedSignedBuilderBid
+	switch res.contentType {
+	case ContentTypeSSZ:
+		bid, err = s.builderBidFromSSZ(res)
+	case ContentTypeJSON:
+		bid, err = s.builderBidFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	bidParentHash, err := bid.ParentHash()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(bidParentHash[:], parentHash[:]) {
+		return nil, errors.New("builder bid not for requested parent hash")
+	}
+	if _, err := bid.Value(); err != nil {
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+func (s *Service) builderBidFromSSZ(res *httpResponse) (*api.VersionedSignedBuilderBid, error) {
+	bid := &api.VersionedSignedBuilderBid{
+		Version: res.consensusVersion,
+	}
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		bid.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		if err := bid.Bellatrix.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix signed builder bid")
+		}
+	case spec.DataVersionCapella:
+		bid.Capella = &apiv1capella.SignedBuilderBid{}
+		if err := bid.Capella.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode capella signed builder bid")
+		}
+	case spec.DataVersionDeneb:
+		bid.Deneb = &apiv1deneb.SignedBuilderBid{}
+		if err := bid.Deneb.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb signed builder bid")
+		}
+	default:
+		return nil, newUnsupportedVersionError("unhandled builder bid version %s", res.consensusVersion)
+	}
+
+	return bid, nil
+}
+
+func (s *Service) builderBidFromJSON(res *httpResponse) (*api.VersionedSignedBuilderBid, error) {
+	bid := &api.VersionedSignedBuilderBid{
+		Version: res.consensusVersion,
+	}
+
+	reader := bytes.NewBuffer(res.body)
+	switch bid.Version {
+	case spec.DataVersionBellatrix:
+		var resp bellatrixSignedBuilderBidJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix signed builder bid")
+		}
+		bid.Bellatrix = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaSignedBuilderBidJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella signed builder bid")
+		}
+		bid.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebSignedBuilderBidJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb signed builder bid")
+		}
+		bid.Deneb = resp.Data
+	default:
+		return nil, newUnsupportedVersionError("unhandled builder bid version %s", res.consensusVersion)
+	}
+
+	return bid, nil
+}
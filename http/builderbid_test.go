@@ -0,0 +1,136 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderBid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentHash := phase0.Hash32{0x01, 0x02}
+	pubKey := phase0.BLSPubKey{0x03, 0x04}
+	bid := &apiv1capella.SignedBuilderBid{
+		Message: &apiv1capella.BuilderBid{
+			Header: &capella.ExecutionPayloadHeader{
+				ParentHash: parentHash,
+			},
+			Value:  uint256.NewInt(123456789),
+			Pubkey: phase0.BLSPubKey{0x05},
+		},
+	}
+	sszData, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+	jsonData, err := json.Marshal(&struct {
+		Version string                         `json:"version"`
+		Data    *apiv1capella.SignedBuilderBid `json:"data"`
+	}{
+		Version: "capella",
+		Data:    bid,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		parentHash  phase0.Hash32
+		contentType string
+		noContent   bool
+		err         string
+		errIs       error
+	}{
+		{
+			name:        "SSZ",
+			parentHash:  parentHash,
+			contentType: "application/octet-stream",
+		},
+		{
+			name:        "JSON",
+			parentHash:  parentHash,
+			contentType: "application/json",
+		},
+		{
+			name:       "NoBid",
+			parentHash: parentHash,
+			noContent:  true,
+			errIs:      api.ErrNotFound,
+		},
+		{
+			name:        "ParentHashMismatch",
+			parentHash:  phase0.Hash32{0xff},
+			contentType: "application/json",
+			err:         "builder bid not for requested parent hash",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				expectedPath := fmt.Sprintf("/eth/v1/builder/header/100/%#x/%#x", test.parentHash, pubKey)
+				if r.URL.Path != expectedPath {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				if test.noContent {
+					w.WriteHeader(nethttp.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				w.Header().Set("Eth-Consensus-Version", "capella")
+				if test.contentType == "application/json" {
+					_, _ = w.Write(jsonData)
+				} else {
+					_, _ = w.Write(sszData)
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithConfirmConnection(false),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.BuilderBidProvider).BuilderBid(ctx, 100, test.parentHash, pubKey)
+			switch {
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			case test.errIs != nil:
+				require.ErrorIs(t, err, test.errIs)
+			default:
+				require.NoError(t, err)
+				value, err := res.Value()
+				require.NoError(t, err)
+				require.Equal(t, uint256.NewInt(123456789), value)
+				builder, err := res.Builder()
+				require.NoError(t, err)
+				require.Equal(t, phase0.BLSPubKey{0x05}, builder)
+			}
+		})
+	}
+}
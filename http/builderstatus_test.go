@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		status int
+		err    string
+	}{
+		{
+			name:   "Ready",
+			status: nethttp.StatusOK,
+		},
+		{
+			name:   "NotReady",
+			status: nethttp.StatusServiceUnavailable,
+			err:    "failed to request builder status: GET failed with status 503: {\"code\":503,\"message\":\"builder not ready\"}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/builder/status" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.WriteHeader(test.status)
+				if test.status != nethttp.StatusOK {
+					_, _ = w.Write([]byte(`{"code":503,"message":"builder not ready"}`))
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithConfirmConnection(false),
+			)
+			require.NoError(t, err)
+
+			err = service.(client.BuilderStatusProvider).BuilderStatus(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// capabilityProbes are the provider and submitter interfaces implemented by the
+// service, along with an endpoint that can be called without side effects to
+// confirm that the connected node supports the interface.  Interfaces without a
+// probe endpoint cannot be checked in this way, and are reported as unknown.
+var capabilityProbes = []struct {
+	provider string
+	endpoint string
+}{
+	{"AggregateAttestationProvider", ""},
+	{"AggregateAttestationsSubmitter", ""},
+	{"AttestationDataProvider", ""},
+	{"AttestationPoolProvider", "/eth/v1/beacon/pool/attestations"},
+	{"AttestationRewardsProvider", ""},
+	{"AttestationsSubmitter", ""},
+	{"AttesterDutiesProvider", ""},
+	{"AttesterSlashingPoolProvider", "/eth/v1/beacon/pool/attester_slashings"},
+	{"AttesterSlashingSubmitter", ""},
+	{"BLSToExecutionChangePoolProvider", "/eth/v1/beacon/pool/bls_to_execution_changes"},
+	{"BLSToExecutionChangesSubmitter", ""},
+	{"BeaconBlockBlobsProvider", ""},
+	{"BeaconBlockHeadersProvider", "/eth/v1/beacon/headers/head"},
+	{"BeaconBlockProposalProvider", ""},
+	{"BeaconBlockRootProvider", "/eth/v1/beacon/blocks/head/root"},
+	{"BeaconBlockSubmitter", ""},
+	{"BeaconCommitteeSubscriptionsSubmitter", ""},
+	{"BeaconCommitteesProvider", "/eth/v1/beacon/states/head/committees"},
+	{"BeaconStateProvider", ""},
+	{"BeaconStateRandaoAtEpochProvider", "/eth/v1/beacon/states/head/randao"},
+	{"BeaconStateRandaoProvider", "/eth/v1/beacon/states/head/randao"},
+	{"BeaconStateRootProvider", "/eth/v1/beacon/states/head/root"},
+	{"BlindedBeaconBlockProposalProvider", ""},
+	{"BlindedBeaconBlockSubmitter", ""},
+	{"BlobSidecarsProvider", ""},
+	{"BlockAttestationsProvider", ""},
+	{"BlockRewardsProvider", "/eth/v1/beacon/rewards/blocks/head"},
+	{"BuilderBidProvider", ""},
+	{"BuilderBlindedBlockSubmitter", ""},
+	{"BuilderStatusProvider", ""},
+	{"DataColumnSidecarsProvider", ""},
+	{"DepositContractProvider", "/eth/v1/config/deposit_contract"},
+	{"DepositSnapshotProvider", "/eth/v1/beacon/deposit_snapshot"},
+	{"EventsProvider", ""},
+	{"EventsSubscriber", ""},
+	{"ExpectedWithdrawalsProvider", "/eth/v1/builder/states/head/expected_withdrawals"},
+	{"FeeRecipientManager", ""},
+	{"FilteredEventsProvider", ""},
+	{"FinalityProvider", "/eth/v1/beacon/states/head/finality_checkpoints"},
+	{"ForkChoiceProvider", "/eth/v1/debug/fork_choice"},
+	{"ForkProvider", "/eth/v1/beacon/states/head/fork"},
+	{"ForkScheduleProvider", "/eth/v1/config/fork_schedule"},
+	{"GasLimitManager", ""},
+	{"GenesisProvider", "/eth/v1/beacon/genesis"},
+	{"GraffitiManager", ""},
+	{"KeystoresManager", ""},
+	{"LightClientFinalityUpdateProvider", "/eth/v1/beacon/light_client/finality_update"},
+	{"LightClientOptimisticUpdateProvider", "/eth/v1/beacon/light_client/optimistic_update"},
+	{"NodeSyncingProvider", "/eth/v1/node/syncing"},
+	{"NodeVersionProvider", "/eth/v1/node/version"},
+	{"PendingConsolidationsProvider", "/eth/v1/beacon/states/head/pending_consolidations"},
+	{"ProposalPreparationsSubmitter", ""},
+	{"ProposalProvider", ""},
+	{"ProposerDutiesProvider", ""},
+	{"ProposerSlashingPoolProvider", "/eth/v1/beacon/pool/proposer_slashings"},
+	{"ProposerSlashingSubmitter", ""},
+	{"RemoteKeysManager", ""},
+	{"SignedBeaconBlockProvider", ""},
+	{"SignedBlindedBeaconBlockProvider", ""},
+	{"SpecProvider", "/eth/v1/config/spec"},
+	{"SyncCommitteeContributionProvider", ""},
+	{"SyncCommitteeContributionsSubmitter", ""},
+	{"SyncCommitteeDutiesProvider", ""},
+	{"SyncCommitteeMessagesSubmitter", ""},
+	{"SyncCommitteeSubscriptionsSubmitter", ""},
+	{"SyncCommitteesProvider", "/eth/v1/beacon/states/head/sync_committees"},
+	{"TypedEventsProvider", ""},
+	{"ValidatorBalancesProvider", ""},
+	{"ValidatorLivenessProvider", ""},
+	{"ValidatorRegistrationsSubmitter", ""},
+	{"ValidatorsProvider", ""},
+	{"VersionedAggregateAttestationProvider", ""},
+	{"VersionedAttestationPoolProvider", ""},
+	{"VersionedAttestationsSubmitter", ""},
+	{"VoluntaryExitPoolProvider", "/eth/v1/beacon/pool/voluntary_exits"},
+	{"VoluntaryExitSigner", ""},
+	{"VoluntaryExitSubmitter", ""},
+}
+
+// endpointProbes are endpoints that change how providers and submitters are served,
+// and whose support by the connected node is reported alongside them.  All of them
+// only accept POST requests.
+var endpointProbes = []string{
+	blockPublishEndpoints.v2,
+	blindedBlockPublishEndpoints.v2,
+}
+
+// Capabilities provides the provider and submitter interfaces that are usable
+// against the connected node.
+// Each endpoint is probed the first time it is required, and the result cached.
+// Probes that fail are reported as unknown, and retried on the next call.
+func (s *Service) Capabilities(ctx context.Context) (*api.Capabilities, error) {
+	capabilities := &api.Capabilities{
+		Providers: make(map[string]api.CapabilitySupport, len(capabilityProbes)),
+		Endpoints: make(map[string]api.CapabilitySupport, len(endpointProbes)),
+	}
+	for _, probe := range capabilityProbes {
+		if probe.endpoint == "" {
+			capabilities.Providers[probe.provider] = api.CapabilityUnknown
+			continue
+		}
+		capabilities.Providers[probe.provider] = s.capabilitySupport(ctx, probe.endpoint, false)
+	}
+	for _, endpoint := range endpointProbes {
+		capabilities.Endpoints[endpoint] = s.capabilitySupport(ctx, endpoint, true)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to probe capabilities")
+	}
+
+	return capabilities, nil
+}
+
+// capabilitySupport returns the support of the node for the given endpoint.
+func (s *Service) capabilitySupport(ctx context.Context, endpoint string, postOnly bool) api.CapabilitySupport {
+	supported, err := s.endpointSupported(ctx, endpoint, postOnly)
+	switch {
+	case err != nil:
+		s.log.Debug().Str("endpoint", endpoint).Err(err).Msg("Failed to probe endpoint")
+		return api.CapabilityUnknown
+	case supported:
+		return api.CapabilitySupported
+	default:
+		return api.CapabilityUnsupported
+	}
+}
+
+// postEndpointSupported returns true if the node supports the given POST endpoint.
+func (s *Service) postEndpointSupported(ctx context.Context, endpoint string) (bool, error) {
+	return s.endpointSupported(ctx, endpoint, true)
+}
+
+// endpointSupported returns true if the node supports the given endpoint.
+// The endpoint is probed the first time this is called, and the result cached.
+// Failed probes are not cached, so are retried the next time this is called.
+func (s *Service) endpointSupported(ctx context.Context, endpoint string, postOnly bool) (bool, error) {
+	s.endpointSupportMutex.RLock()
+	supported, exists := s.endpointSupport[endpoint]
+	s.endpointSupportMutex.RUnlock()
+	if exists {
+		return supported, nil
+	}
+
+	// Probe without holding the lock, so that other callers are not blocked.
+	// Concurrent callers may probe the same endpoint, but probes have no side effects.
+	supported, err := s.probe(ctx, endpoint, postOnly)
+	if err != nil {
+		return false, err
+	}
+
+	s.setEndpointSupported(endpoint, supported)
+
+	return supported, nil
+}
+
+// setEndpointSupported caches the support of the node for the given endpoint.
+func (s *Service) setEndpointSupported(endpoint string, supported bool) {
+	s.endpointSupportMutex.Lock()
+	defer s.endpointSupportMutex.Unlock()
+
+	if s.endpointSupport == nil {
+		s.endpointSupport = make(map[string]bool)
+	}
+	s.endpointSupport[endpoint] = supported
+}
+
+// probe calls an endpoint to find out if it is supported by the node.
+// Only the status code is examined; any response other than one stating that
+// the endpoint is not present is considered to indicate support.
+// Endpoints that only accept POST requests are probed without a body, so are
+// present if the node refuses the GET request as not allowed.
+func (s *Service) probe(ctx context.Context, endpoint string, postOnly bool) (bool, error) {
+	log := s.log.With().Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("Probing endpoint")
+
+	opCtx, cancel := s.opContext(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint), nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create probe request")
+	}
+	s.addExtraHeaders(req)
+	s.setRequestID(req, s.requestID(ctx))
+	if err := s.setAuthorization(req); err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(s.redactor.Error(err), "failed to call probe endpoint")
+	}
+	// We do not require the body.
+	resp.Body.Close()
+
+	log.Trace().Int("status_code", resp.StatusCode).Msg("Probe response")
+	switch resp.StatusCode {
+	case http.StatusMethodNotAllowed:
+		return postOnly, nil
+	case http.StatusNotFound, http.StatusNotImplemented:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
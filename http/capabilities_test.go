@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilities(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	capabilities, err := service.(client.CapabilitiesProvider).Capabilities(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, capabilities)
+	require.True(t, capabilities.Supports("NodeVersionProvider"))
+	require.True(t, capabilities.Supports("GenesisProvider"))
+}
+
+func TestCapabilitiesProbes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/debug/fork_choice":
+			w.WriteHeader(nethttp.StatusNotFound)
+		case "/eth/v2/beacon/blocks":
+			w.WriteHeader(nethttp.StatusMethodNotAllowed)
+		case "/eth/v1/beacon/pool/attestations":
+			if failing.Load() {
+				// Drop the connection to fail the probe.
+				conn, _, err := w.(nethttp.Hijacker).Hijack()
+				require.NoError(t, err)
+				conn.Close()
+				return
+			}
+			w.WriteHeader(nethttp.StatusOK)
+		default:
+			w.WriteHeader(nethttp.StatusOK)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(srv.URL),
+	)
+	require.NoError(t, err)
+
+	capabilities, err := service.(client.CapabilitiesProvider).Capabilities(ctx)
+	require.NoError(t, err)
+	require.Equal(t, api.CapabilitySupported, capabilities.Providers["NodeVersionProvider"])
+	require.Equal(t, api.CapabilityUnsupported, capabilities.Providers["ForkChoiceProvider"])
+	require.Equal(t, api.CapabilityUnknown, capabilities.Providers["AttestationPoolProvider"])
+	require.Equal(t, api.CapabilityUnknown, capabilities.Providers["EventsProvider"])
+	require.True(t, capabilities.SupportsEndpoint("/eth/v2/beacon/blocks"))
+
+	// Failed probes are retried.
+	failing.Store(false)
+	capabilities, err = service.(client.CapabilitiesProvider).Capabilities(ctx)
+	require.NoError(t, err)
+	require.Equal(t, api.CapabilitySupported, capabilities.Providers["AttestationPoolProvider"])
+	require.Equal(t, api.CapabilityUnsupported, capabilities.Providers["ForkChoiceProvider"])
+}
@@ -0,0 +1,262 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed means that requests are passed to the endpoint as normal.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen means that requests to the endpoint fail immediately.
+	CircuitOpen
+	// CircuitHalfOpen means that a trial request is allowed through to the endpoint
+	// to find out if it has recovered.
+	CircuitHalfOpen
+)
+
+var circuitStateStrings = [...]string{
+	"closed",
+	"open",
+	"half-open",
+}
+
+// String returns a string representation of the circuit state.
+func (c CircuitState) String() string {
+	if c < 0 || int(c) >= len(circuitStateStrings) {
+		return "unknown"
+	}
+	return circuitStateStrings[c]
+}
+
+// CircuitBreakerState is the state of the circuit breaker for an endpoint.
+type CircuitBreakerState struct {
+	// Endpoint is the endpoint, with request-specific path components replaced by placeholders.
+	Endpoint string
+	// State is the state of the circuit.
+	State CircuitState
+	// ConsecutiveFailures is the number of consecutive failed requests to the endpoint.
+	ConsecutiveFailures int
+	// OpenedAt is the time at which the circuit was last opened.
+	OpenedAt time.Time
+}
+
+// CircuitOpenError is returned when a request is not made because the circuit
+// breaker for its endpoint is open.
+type CircuitOpenError struct {
+	Endpoint string
+	Until    time.Time
+}
+
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s until %s", e.Endpoint, e.Until.Format(time.RFC3339))
+}
+
+// Retryable returns true, as the request can be retried once the circuit closes.
+func (CircuitOpenError) Retryable() bool {
+	return true
+}
+
+// endpointVariableRegex matches path components that vary between requests to the same endpoint,
+// such as slots, epochs, roots and named block or state identifiers.
+var endpointVariableRegex = regexp.MustCompile(`^(\d+|0x[0-9a-fA-F]+|head|genesis|finalized|justified)$`)
+
+// endpointKey returns the key for an endpoint, removing request-specific information.
+func endpointKey(endpoint string) string {
+	if idx := strings.Index(endpoint, "?"); idx != -1 {
+		endpoint = endpoint[:idx]
+	}
+	components := strings.Split(endpoint, "/")
+	for i := range components {
+		if endpointVariableRegex.MatchString(components[i]) {
+			components[i] = "{id}"
+		}
+	}
+
+	return strings.Join(components, "/")
+}
+
+// circuitBreakers tracks the circuit breakers for each endpoint.
+type circuitBreakers struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+type circuitBreaker struct {
+	state               CircuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	// trialInProgress is true when a half-open circuit has allowed a trial request through.
+	trialInProgress bool
+	trialStarted    time.Time
+}
+
+func newCircuitBreakers(threshold int, cooldown time.Duration) *circuitBreakers {
+	return &circuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		breakers:  make(map[string]*circuitBreaker),
+	}
+}
+
+// allow returns an error if a request should not be made to the endpoint.
+func (c *circuitBreakers) allow(endpoint string) error {
+	if c == nil {
+		return nil
+	}
+	key := endpointKey(endpoint)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, exists := c.breakers[key]
+	if !exists {
+		return nil
+	}
+	switch breaker.state {
+	case CircuitOpen:
+		if time.Since(breaker.openedAt) < c.cooldown {
+			return CircuitOpenError{Endpoint: key, Until: breaker.openedAt.Add(c.cooldown)}
+		}
+		breaker.state = CircuitHalfOpen
+		breaker.trialInProgress = true
+		breaker.trialStarted = time.Now()
+	case CircuitHalfOpen:
+		// Allow another trial if the previous one did not complete in reasonable time.
+		if breaker.trialInProgress && time.Since(breaker.trialStarted) < c.cooldown {
+			return CircuitOpenError{Endpoint: key, Until: breaker.trialStarted.Add(c.cooldown)}
+		}
+		breaker.trialInProgress = true
+		breaker.trialStarted = time.Now()
+	}
+
+	return nil
+}
+
+// record records the result of a request to the endpoint.
+func (c *circuitBreakers) record(endpoint string, success bool) {
+	if c == nil {
+		return
+	}
+	key := endpointKey(endpoint)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, exists := c.breakers[key]
+	if !exists {
+		if success {
+			// No need to track endpoints that have not failed.
+			return
+		}
+		breaker = &circuitBreaker{}
+		c.breakers[key] = breaker
+	}
+
+	breaker.trialInProgress = false
+	if success {
+		breaker.state = CircuitClosed
+		breaker.consecutiveFailures = 0
+		return
+	}
+
+	breaker.consecutiveFailures++
+	if breaker.state == CircuitHalfOpen || breaker.consecutiveFailures >= c.threshold {
+		breaker.state = CircuitOpen
+		breaker.openedAt = time.Now()
+	}
+}
+
+// recordResponse records the result of a request to the endpoint given its response status code.
+// Server errors are considered failures; all other responses are considered successes.
+func (c *circuitBreakers) recordResponse(endpoint string, statusCode int) {
+	c.record(endpoint, statusCode < 500)
+}
+
+// recordError records a request to the endpoint that failed to obtain a response.
+// Failures due to the caller's context are not the fault of the endpoint, and so ignored.
+func (c *circuitBreakers) recordError(ctx context.Context, endpoint string) {
+	if c == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		c.release(endpoint)
+		return
+	}
+	c.record(endpoint, false)
+}
+
+// release releases any trial request to the endpoint without changing its state.
+// This is used when a request allowed through is not sent, so that the half-open
+// circuit does not wait for the cooldown before allowing another trial.
+func (c *circuitBreakers) release(endpoint string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if breaker, exists := c.breakers[endpointKey(endpoint)]; exists {
+		breaker.trialInProgress = false
+	}
+}
+
+// states returns the state of the circuit breakers.
+func (c *circuitBreakers) states() []*CircuitBreakerState {
+	if c == nil {
+		return []*CircuitBreakerState{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*CircuitBreakerState, 0, len(c.breakers))
+	for key, breaker := range c.breakers {
+		state := breaker.state
+		if state == CircuitOpen && time.Since(breaker.openedAt) >= c.cooldown {
+			state = CircuitHalfOpen
+		}
+		res = append(res, &CircuitBreakerState{
+			Endpoint:            key,
+			State:               state,
+			ConsecutiveFailures: breaker.consecutiveFailures,
+			OpenedAt:            breaker.openedAt,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Endpoint < res[j].Endpoint
+	})
+
+	return res
+}
+
+// CircuitBreakerStates returns the state of the circuit breakers for endpoints
+// that have had failed requests.  Endpoints without failures are not returned.
+func (s *Service) CircuitBreakerStates() []*CircuitBreakerState {
+	return s.circuitBreakers.states()
+}
@@ -0,0 +1,193 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointKey(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected string
+	}{
+		{
+			endpoint: "/eth/v1/node/version",
+			expected: "/eth/v1/node/version",
+		},
+		{
+			endpoint: "/eth/v1/validator/duties/attester/12345",
+			expected: "/eth/v1/validator/duties/attester/{id}",
+		},
+		{
+			endpoint: "/eth/v1/beacon/states/head/validators?id=1,2,3",
+			expected: "/eth/v1/beacon/states/{id}/validators",
+		},
+		{
+			endpoint: "/eth/v2/beacon/blocks/0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+			expected: "/eth/v2/beacon/blocks/{id}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.expected, endpointKey(test.endpoint))
+		})
+	}
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	ctx := context.Background()
+	endpoint := "/eth/v1/validator/duties/attester/1"
+	cooldown := 50 * time.Millisecond
+	c := newCircuitBreakers(2, cooldown)
+
+	// Initially closed.
+	require.NoError(t, c.allow(endpoint))
+	require.Empty(t, c.states())
+
+	// Single failure does not open the circuit.
+	c.recordResponse(endpoint, 500)
+	require.NoError(t, c.allow(endpoint))
+	require.Equal(t, CircuitClosed, c.states()[0].State)
+
+	// Success resets the failure count.
+	c.recordResponse(endpoint, 200)
+	c.recordResponse(endpoint, 503)
+	require.NoError(t, c.allow(endpoint))
+	require.Equal(t, 1, c.states()[0].ConsecutiveFailures)
+
+	// Client errors are not failures.
+	c.recordResponse(endpoint, 400)
+	require.Equal(t, 0, c.states()[0].ConsecutiveFailures)
+
+	// Threshold reached opens the circuit, including for other requests to the same endpoint.
+	c.recordError(ctx, endpoint)
+	c.recordError(ctx, endpoint)
+	require.Equal(t, CircuitOpen, c.states()[0].State)
+	var circuitOpenErr CircuitOpenError
+	require.True(t, errors.As(c.allow("/eth/v1/validator/duties/attester/2"), &circuitOpenErr))
+	require.Equal(t, "/eth/v1/validator/duties/attester/{id}", circuitOpenErr.Endpoint)
+
+	// Other endpoints are unaffected.
+	require.NoError(t, c.allow("/eth/v1/node/version"))
+
+	// After the cooldown a single trial is allowed.
+	time.Sleep(cooldown)
+	require.Equal(t, CircuitHalfOpen, c.states()[0].State)
+	require.NoError(t, c.allow(endpoint))
+	require.Error(t, c.allow(endpoint))
+
+	// Failed trial re-opens the circuit.
+	c.recordResponse(endpoint, 500)
+	require.Equal(t, CircuitOpen, c.states()[0].State)
+	require.Error(t, c.allow(endpoint))
+
+	// Successful trial closes the circuit.
+	time.Sleep(cooldown)
+	require.NoError(t, c.allow(endpoint))
+	c.recordResponse(endpoint, 200)
+	require.Equal(t, CircuitClosed, c.states()[0].State)
+	require.NoError(t, c.allow(endpoint))
+}
+
+func TestCircuitBreakersCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	endpoint := "/eth/v1/node/version"
+	c := newCircuitBreakers(1, time.Minute)
+
+	c.recordError(ctx, endpoint)
+	require.NoError(t, c.allow(endpoint))
+	require.Empty(t, c.states())
+}
+
+func TestCircuitBreakersRelease(t *testing.T) {
+	endpoint := "/eth/v1/node/version"
+	cooldown := 50 * time.Millisecond
+	c := newCircuitBreakers(1, cooldown)
+
+	c.recordError(context.Background(), endpoint)
+	time.Sleep(cooldown)
+	require.NoError(t, c.allow(endpoint))
+	require.Error(t, c.allow(endpoint))
+
+	// Released trial allows another trial without waiting for the cooldown.
+	c.release(endpoint)
+	require.Equal(t, CircuitHalfOpen, c.states()[0].State)
+	require.NoError(t, c.allow(endpoint))
+}
+
+func TestCircuitBreakersUnsentTrial(t *testing.T) {
+	ctx := context.Background()
+	responses := map[string]string{
+		"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+		"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`,
+		"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+		"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+		"/eth/v1/node/version":            `{"data":{"version":"test/v1.0.0"}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	cooldown := 50 * time.Millisecond
+	service, err := New(ctx,
+		WithAddress(srv.URL),
+		WithCircuitBreaker(1, cooldown),
+	)
+	require.NoError(t, err)
+	s := service.(*Service)
+
+	endpoint := "/eth/v1/node/version"
+	s.circuitBreakers.recordError(ctx, endpoint)
+	time.Sleep(cooldown)
+
+	// Trial request that fails before it is sent releases the trial.
+	s.tokenProvider = func(_ context.Context) (string, error) {
+		return "", errors.New("no token")
+	}
+	_, err = s.doGet(ctx, endpoint)
+	require.ErrorContains(t, err, "no token")
+	require.Equal(t, CircuitHalfOpen, s.circuitBreakers.states()[0].State)
+
+	// Next request is allowed as the trial, and closes the circuit.
+	s.tokenProvider = nil
+	_, err = s.doGet(ctx, endpoint)
+	require.NoError(t, err)
+	require.Equal(t, CircuitClosed, s.circuitBreakers.states()[0].State)
+}
+
+func TestCircuitBreakersDisabled(t *testing.T) {
+	var c *circuitBreakers
+	c.recordResponse("/eth/v1/node/version", 500)
+	c.recordError(context.Background(), "/eth/v1/node/version")
+	c.release("/eth/v1/node/version")
+	require.NoError(t, c.allow("/eth/v1/node/version"))
+	require.Empty(t, c.states())
+}
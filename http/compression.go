@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// setAcceptEncoding sets the encodings acceptable in the response to the request.
+// Note that setting this header explicitly disables the transparent decompression
+// provided by the standard library, so responses must be decoded with responseBody().
+func (s *Service) setAcceptEncoding(req *http.Request) {
+	switch {
+	case !s.compression:
+		req.Header.Set("Accept-Encoding", "identity")
+	case s.zstdCompression:
+		req.Header.Set("Accept-Encoding", "zstd, gzip;q=0.9")
+	default:
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
+// responseBody returns a reader for the body of the response to the endpoint, decompressing
+// it if required.  The reader is limited to the service's maximum response size, if any.
+func (s *Service) responseBody(resp *http.Response, endpoint string) (io.ReadCloser, error) {
+	if s.maxResponseSize > 0 && resp.ContentLength > s.maxResponseSize && resp.Header.Get("Content-Encoding") == "" {
+		// The content length of an unencoded body is its full size, so this can be rejected up front.
+		// The content length of an encoded body is its compressed size, so it is instead limited as
+		// it is decompressed.
+		return nil, ResponseTooLargeError{Endpoint: endpoint, Limit: s.maxResponseSize}
+	}
+
+	body, err := decompressedBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.limitResponseBody(body, endpoint), nil
+}
+
+// decompressedBody returns a reader for the body of the response, decompressing it if required.
+func decompressedBody(resp *http.Response) (io.ReadCloser, error) {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	switch encoding {
+	case "", "identity":
+		return resp.Body, nil
+	case "gzip":
+		reader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create gzip reader")
+		}
+		return reader, nil
+	case "zstd":
+		decoder, err := zstd.NewReader(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd reader")
+		}
+		return decoder.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+}
+
+// readResponseBody reads the entire body of the response to the endpoint, decompressing it if required.
+func (s *Service) readResponseBody(resp *http.Response, endpoint string) ([]byte, error) {
+	body, err := s.responseBody(resp, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncing := []byte(`{"data":{"head_slot":"12345","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`)
+
+	tests := []struct {
+		name             string
+		params           []http.Parameter
+		expectedEncoding string
+	}{
+		{
+			name:             "Default",
+			expectedEncoding: "gzip",
+		},
+		{
+			name: "Zstd",
+			params: []http.Parameter{
+				http.WithZstdCompression(true),
+			},
+			expectedEncoding: "zstd",
+		},
+		{
+			name: "Disabled",
+			params: []http.Parameter{
+				http.WithCompression(false),
+				http.WithZstdCompression(true),
+			},
+			expectedEncoding: "identity",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var encoding string
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				acceptEncoding := r.Header.Get("Accept-Encoding")
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.Contains(acceptEncoding, "zstd"):
+					encoding = "zstd"
+					var buf bytes.Buffer
+					encoder, err := zstd.NewWriter(&buf)
+					require.NoError(t, err)
+					_, err = encoder.Write(syncing)
+					require.NoError(t, err)
+					require.NoError(t, encoder.Close())
+					w.Header().Set("Content-Encoding", "zstd")
+					_, _ = w.Write(buf.Bytes())
+				case strings.Contains(acceptEncoding, "gzip"):
+					encoding = "gzip"
+					w.Header().Set("Content-Encoding", "gzip")
+					writer := gzip.NewWriter(w)
+					_, _ = writer.Write(syncing)
+					_ = writer.Close()
+				default:
+					encoding = acceptEncoding
+					_, _ = w.Write(syncing)
+				}
+			})
+
+			service, err := http.New(ctx, append(test.params, http.WithAddress(srv.URL))...)
+			require.NoError(t, err)
+
+			syncState, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedEncoding, encoding)
+			require.Equal(t, uint64(12345), uint64(syncState.HeadSlot))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"strings"
+)
+
+// ContentNegotiation defines how the content type of responses is negotiated with the node.
+type ContentNegotiation int
+
+const (
+	// ContentNegotiationPreferSSZ requests SSZ responses, accepting JSON if SSZ is unavailable.
+	ContentNegotiationPreferSSZ ContentNegotiation = iota
+	// ContentNegotiationJSONOnly requests JSON responses only.
+	ContentNegotiationJSONOnly
+	// ContentNegotiationSSZOnly requests SSZ responses only, failing if SSZ is unavailable.
+	ContentNegotiationSSZOnly
+)
+
+var contentNegotiationStrings = [...]string{
+	"prefer SSZ",
+	"JSON only",
+	"SSZ only",
+}
+
+// String returns a string representation of the content negotiation.
+func (c ContentNegotiation) String() string {
+	if int(c) < 0 || int(c) >= len(contentNegotiationStrings) {
+		return "unknown"
+	}
+
+	return contentNegotiationStrings[c]
+}
+
+// accept returns the value of the Accept header for the content negotiation.
+func (c ContentNegotiation) accept() string {
+	switch c {
+	case ContentNegotiationJSONOnly:
+		return "application/json"
+	case ContentNegotiationSSZOnly:
+		return "application/octet-stream"
+	default:
+		return "application/octet-stream;q=1,application/json;q=0.9"
+	}
+}
+
+// acceptHeader returns the value of the Accept header for a request to the given endpoint,
+// and if the header was overridden for the endpoint.
+// Endpoint overrides are matched on the longest prefix of the endpoint's path.
+func (s *Service) acceptHeader(endpoint string) (string, bool) {
+	path := endpoint
+	if idx := strings.Index(path, "?"); idx != -1 {
+		path = path[:idx]
+	}
+
+	accept := ""
+	matched := ""
+	for prefix, value := range s.endpointAccept {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(matched) {
+			matched = prefix
+			accept = value
+		}
+	}
+	if matched != "" {
+		return accept, true
+	}
+
+	return s.contentNegotiation.accept(), false
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentNegotiation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	proposal, err := mockClient.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	block := &phase0.SignedBeaconBlock{
+		Message: proposal.Phase0,
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		params         []http.Parameter
+		sszAvailable   bool
+		expectedAccept string
+		err            string
+	}{
+		{
+			name:           "Default",
+			sszAvailable:   true,
+			expectedAccept: "application/octet-stream;q=1,application/json;q=0.9",
+		},
+		{
+			name: "JSONOnly",
+			params: []http.Parameter{
+				http.WithContentNegotiation(http.ContentNegotiationJSONOnly),
+			},
+			sszAvailable:   true,
+			expectedAccept: "application/json",
+		},
+		{
+			name: "SSZOnly",
+			params: []http.Parameter{
+				http.WithContentNegotiation(http.ContentNegotiationSSZOnly),
+			},
+			sszAvailable:   true,
+			expectedAccept: "application/octet-stream",
+		},
+		{
+			name: "SSZOnlyUnavailable",
+			params: []http.Parameter{
+				http.WithContentNegotiation(http.ContentNegotiationSSZOnly),
+			},
+			expectedAccept: "application/octet-stream",
+			err:            "SSZ response required but received JSON",
+		},
+		{
+			name: "EndpointOverride",
+			params: []http.Parameter{
+				http.WithContentNegotiation(http.ContentNegotiationSSZOnly),
+				http.WithEndpointAccept(map[string]string{
+					"/eth/v2/beacon":        "application/octet-stream",
+					"/eth/v2/beacon/blocks": "application/json",
+				}),
+			},
+			sszAvailable:   true,
+			expectedAccept: "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var accept string
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				accept = r.Header.Get("Accept")
+				if test.sszAvailable && strings.HasPrefix(accept, "application/octet-stream") {
+					w.Header().Set("Content-Type", "application/octet-stream")
+					w.Header().Set("Eth-Consensus-Version", "phase0")
+					_, _ = w.Write(blockSSZ)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version":"phase0","data":` + string(blockJSON) + `}`))
+			})
+
+			service, err := http.New(ctx, append(test.params, http.WithAddress(srv.URL))...)
+			require.NoError(t, err)
+
+			res, err := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+			require.Equal(t, test.expectedAccept, accept)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, block, res.Phase0)
+			}
+		})
+	}
+}
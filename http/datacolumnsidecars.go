@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/pkg/errors"
+)
+
+type dataColumnSidecarsJSON struct {
+	Data []*fulu.DataColumnSidecar `json:"data"`
+}
+
+// sszOffsetLength is the number of bytes in an SSZ offset.
+const sszOffsetLength = 4
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+// indices is a list of column indices to restrict the returned values.  If no indices are supplied no filter
+// will be applied.
+func (s *Service) DataColumnSidecars(ctx context.Context, blockID string, indices []fulu.ColumnIndex) ([]*fulu.DataColumnSidecar, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/data_column_sidecars/%s", blockID)
+	if len(indices) > 0 {
+		ids := make([]string, len(indices))
+		for i := range indices {
+			ids[i] = fmt.Sprintf("%d", indices[i])
+		}
+		url = fmt.Sprintf("%s?indices=%s", url, strings.Join(ids, ","))
+	}
+
+	// Columns are large, so the response is decoded as it is received rather than buffered.
+	res, err := s.getStream(ctx, url, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request data column sidecars")
+	}
+	defer res.Close()
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.body == nil {
+		return nil, errors.New("no data column sidecars returned")
+	}
+
+	var dataColumnSidecars []*fulu.DataColumnSidecar
+	switch res.contentType {
+	case ContentTypeSSZ:
+		dataColumnSidecars, err = s.dataColumnSidecarsFromSSZ(res)
+	case ContentTypeJSON:
+		dataColumnSidecars, err = s.dataColumnSidecarsFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Data is not guaranteed to be returned in index order, so fix that.
+	sort.Slice(dataColumnSidecars, func(i int, j int) bool {
+		return dataColumnSidecars[i].Index < dataColumnSidecars[j].Index
+	})
+
+	return dataColumnSidecars, nil
+}
+
+func (*Service) dataColumnSidecarsFromSSZ(res *httpStreamResponse) ([]*fulu.DataColumnSidecar, error) {
+	data, err := res.readAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read data column sidecars")
+	}
+	if len(data) == 0 {
+		return []*fulu.DataColumnSidecar{}, nil
+	}
+
+	// Data column sidecars are variable size, so the list starts with an offset for each item.
+	if len(data) < sszOffsetLength {
+		return nil, fmt.Errorf("invalid length %d for data column sidecars", len(data))
+	}
+	firstOffset := int(binary.LittleEndian.Uint32(data[:sszOffsetLength]))
+	if firstOffset == 0 || firstOffset%sszOffsetLength != 0 || firstOffset > len(data) {
+		return nil, fmt.Errorf("invalid first offset %d for data column sidecars", firstOffset)
+	}
+	offsets := make([]int, firstOffset/sszOffsetLength)
+	for i := range offsets {
+		offsets[i] = int(binary.LittleEndian.Uint32(data[i*sszOffsetLength : (i+1)*sszOffsetLength]))
+		if offsets[i] > len(data) || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, fmt.Errorf("invalid offset %d for data column sidecar %d", offsets[i], i)
+		}
+	}
+
+	dataColumnSidecars := make([]*fulu.DataColumnSidecar, len(offsets))
+	for i := range dataColumnSidecars {
+		end := len(data)
+		if i < len(offsets)-1 {
+			end = offsets[i+1]
+		}
+		dataColumnSidecars[i] = &fulu.DataColumnSidecar{}
+		if err := dataColumnSidecars[i].UnmarshalSSZ(data[offsets[i]:end]); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode data column sidecar %d", i)
+		}
+	}
+
+	return dataColumnSidecars, nil
+}
+
+func (*Service) dataColumnSidecarsFromJSON(res *httpStreamResponse) ([]*fulu.DataColumnSidecar, error) {
+	var resp dataColumnSidecarsJSON
+	if err := json.NewDecoder(res.body).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse data column sidecars")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no data column sidecars returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func testDataColumnSidecar(index fulu.ColumnIndex) *fulu.DataColumnSidecar {
+	return &fulu.DataColumnSidecar{
+		Index:          index,
+		Column:         []fulu.Cell{{0x01, 0x02}},
+		KzgCommitments: []deneb.KzgCommitment{{0x03}},
+		KzgProofs:      []fulu.KzgCellProof{{0x04}},
+		SignedBlockHeader: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:          100,
+				ProposerIndex: 2,
+			},
+		},
+		KzgCommitmentsInclusionProof: make([]phase0.Root, 4),
+	}
+}
+
+func TestDataColumnSidecars(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Sidecars are served out of order, to confirm that they are sorted.
+	sidecars := []*fulu.DataColumnSidecar{
+		testDataColumnSidecar(7),
+		testDataColumnSidecar(3),
+	}
+	// Sidecars are variable size, so the SSZ list is prefixed with the offset of each item.
+	var sszItems []byte
+	sszData := make([]byte, 4*len(sidecars))
+	for i, sidecar := range sidecars {
+		binary.LittleEndian.PutUint32(sszData[i*4:], uint32(len(sszData)+len(sszItems)))
+		data, err := sidecar.MarshalSSZ()
+		require.NoError(t, err)
+		sszItems = append(sszItems, data...)
+	}
+	sszData = append(sszData, sszItems...)
+	jsonData, err := json.Marshal(&struct {
+		Data []*fulu.DataColumnSidecar `json:"data"`
+	}{
+		Data: sidecars,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		blockID     string
+		indices     []fulu.ColumnIndex
+		contentType string
+		data        []byte
+		query       string
+		expected    []fulu.ColumnIndex
+		err         string
+	}{
+		{
+			name: "BlockIDMissing",
+			err:  "no block ID specified",
+		},
+		{
+			name:        "SSZ",
+			blockID:     "head",
+			contentType: "application/octet-stream",
+			data:        sszData,
+			expected:    []fulu.ColumnIndex{3, 7},
+		},
+		{
+			name:        "SSZEmpty",
+			blockID:     "head",
+			contentType: "application/octet-stream",
+			data:        []byte{},
+			expected:    []fulu.ColumnIndex{},
+		},
+		{
+			name:        "SSZBadOffset",
+			blockID:     "head",
+			contentType: "application/octet-stream",
+			data:        []byte{0x03, 0x00, 0x00, 0x00},
+			err:         "invalid first offset 3 for data column sidecars",
+		},
+		{
+			name:        "JSON",
+			blockID:     "head",
+			contentType: "application/json",
+			data:        jsonData,
+			expected:    []fulu.ColumnIndex{3, 7},
+		},
+		{
+			name:        "Indices",
+			blockID:     "head",
+			indices:     []fulu.ColumnIndex{3, 7},
+			contentType: "application/octet-stream",
+			data:        sszData,
+			query:       "indices=3,7",
+			expected:    []fulu.ColumnIndex{3, 7},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/beacon/data_column_sidecars/head" || r.URL.RawQuery != test.query {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				_, _ = w.Write(test.data)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.DataColumnSidecarsProvider).DataColumnSidecars(ctx, test.blockID, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				indices := make([]fulu.ColumnIndex, len(res))
+				for i := range res {
+					indices[i] = res[i].Index
+				}
+				require.Equal(t, test.expected, indices)
+				if len(res) > 0 {
+					require.Equal(t, sidecars[1], res[0])
+				}
+			}
+		})
+	}
+}
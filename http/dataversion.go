@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// forkEpochKeys are the spec keys for the epochs at which each fork activates,
+// in order of activation.
+var forkEpochKeys = []struct {
+	version spec.DataVersion
+	key     string
+}{
+	{spec.DataVersionAltair, "ALTAIR_FORK_EPOCH"},
+	{spec.DataVersionBellatrix, "BELLATRIX_FORK_EPOCH"},
+	{spec.DataVersionCapella, "CAPELLA_FORK_EPOCH"},
+	{spec.DataVersionDeneb, "DENEB_FORK_EPOCH"},
+	{spec.DataVersionElectra, "ELECTRA_FORK_EPOCH"},
+}
+
+// dataVersionAtEpoch returns the data version of the chain at the given epoch.
+// Forks whose epoch is not present in the chain's spec are considered to be unscheduled.
+func (s *Service) dataVersionAtEpoch(ctx context.Context, epoch phase0.Epoch) (spec.DataVersion, error) {
+	chainSpec, err := s.Spec(ctx)
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	version := spec.DataVersionPhase0
+	for _, forkEpochKey := range forkEpochKeys {
+		forkEpoch, isUint := chainSpec[forkEpochKey.key].(uint64)
+		if !isUint {
+			break
+		}
+		if uint64(epoch) < forkEpoch {
+			break
+		}
+		version = forkEpochKey.version
+	}
+
+	return version, nil
+}
+
+// dataVersionAtSlot returns the data version of the chain at the given slot.
+func (s *Service) dataVersionAtSlot(ctx context.Context, slot phase0.Slot) (spec.DataVersion, error) {
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return s.dataVersionAtEpoch(ctx, phase0.Epoch(uint64(slot)/slotsPerEpoch))
+}
+
+// currentDataVersion returns the data version of the chain at the current wall clock time.
+func (s *Service) currentDataVersion(ctx context.Context) (spec.DataVersion, error) {
+	genesis, err := s.Genesis(ctx)
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Wrap(err, "failed to obtain genesis")
+	}
+	if time.Now().Before(genesis.GenesisTime) {
+		return s.dataVersionAtSlot(ctx, 0)
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return spec.DataVersionUnknown, errors.Wrap(err, "failed to obtain slot duration")
+	}
+
+	return s.dataVersionAtSlot(ctx, phase0.Slot(uint64(time.Since(genesis.GenesisTime)/slotDuration)))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type depositSnapshotJSON struct {
+	Data *apiv1.DepositTreeSnapshot `json:"data"`
+}
+
+// DepositSnapshot provides a snapshot of the deposit contract Merkle tree.
+func (s *Service) DepositSnapshot(ctx context.Context) (*apiv1.DepositTreeSnapshot, error) {
+	res, err := s.getStream(ctx, "/eth/v1/beacon/deposit_snapshot", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request deposit snapshot")
+	}
+	defer res.Close()
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.body == nil {
+		return nil, errors.New("no deposit snapshot returned")
+	}
+
+	switch res.contentType {
+	case ContentTypeSSZ:
+		data, err := res.readAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read deposit snapshot")
+		}
+		snapshot := &apiv1.DepositTreeSnapshot{}
+		if err := snapshot.UnmarshalSSZ(data); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deposit snapshot")
+		}
+
+		return snapshot, nil
+	case ContentTypeJSON:
+		var resp depositSnapshotJSON
+		if err := json.NewDecoder(res.body).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deposit snapshot")
+		}
+		if resp.Data == nil {
+			return nil, errors.New("no deposit snapshot returned")
+		}
+
+		return resp.Data, nil
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	snapshot := &apiv1.DepositTreeSnapshot{
+		Finalized:            []phase0.Root{{0x01}, {0x02}},
+		DepositRoot:          phase0.Root{0x03},
+		DepositCount:         2,
+		ExecutionBlockHash:   phase0.Hash32{0x04},
+		ExecutionBlockHeight: 100,
+	}
+	sszData, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	jsonData, err := json.Marshal(&struct {
+		Data *apiv1.DepositTreeSnapshot `json:"data"`
+	}{
+		Data: snapshot,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+	}{
+		{
+			name:        "SSZ",
+			contentType: "application/octet-stream",
+		},
+		{
+			name:        "JSON",
+			contentType: "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/beacon/deposit_snapshot" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				if test.contentType == "application/json" {
+					_, _ = w.Write(jsonData)
+				} else {
+					_, _ = w.Write(sszData)
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.DepositSnapshotProvider).DepositSnapshot(ctx)
+			require.NoError(t, err)
+			require.Equal(t, snapshot, res)
+		})
+	}
+}
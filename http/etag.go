@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+)
+
+// etagEndpoints are the endpoints for which responses are cached by entity tag,
+// allowing conditional requests to be made for them.  These endpoints return
+// information that rarely changes.
+var etagEndpoints = map[string]bool{
+	"/eth/v1/beacon/genesis":          true,
+	"/eth/v1/config/spec":             true,
+	"/eth/v1/config/deposit_contract": true,
+	"/eth/v1/config/fork_schedule":    true,
+}
+
+// etagEntry is a response cached by entity tag.
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// setIfNoneMatch makes the request conditional if a response for the endpoint is cached.
+func (s *Service) setIfNoneMatch(req *http.Request, endpoint string) {
+	if !etagEndpoints[endpoint] {
+		return
+	}
+
+	s.etagsMutex.RLock()
+	entry, exists := s.etags[endpoint]
+	s.etagsMutex.RUnlock()
+	if exists {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+}
+
+// etagBody returns the cached response body for the endpoint, or nil if there is none.
+func (s *Service) etagBody(endpoint string) []byte {
+	s.etagsMutex.RLock()
+	defer s.etagsMutex.RUnlock()
+
+	entry, exists := s.etags[endpoint]
+	if !exists {
+		return nil
+	}
+
+	return entry.body
+}
+
+// storeETag caches the response body for the endpoint if the response has an entity tag.
+func (s *Service) storeETag(endpoint string, resp *http.Response, body []byte) {
+	if !etagEndpoints[endpoint] {
+		return
+	}
+	etag := resp.Header.Get("ETag")
+
+	s.etagsMutex.Lock()
+	defer s.etagsMutex.Unlock()
+	if etag == "" {
+		delete(s.etags, endpoint)
+		return
+	}
+	s.etags[endpoint] = &etagEntry{
+		etag: etag,
+		body: body,
+	}
+}
+
+// clearETags clears the cached responses.
+func (s *Service) clearETags() {
+	s.etagsMutex.Lock()
+	s.etags = make(map[string]*etagEntry)
+	s.etagsMutex.Unlock()
+}
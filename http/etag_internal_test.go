@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestETags(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	responses := map[string]string{
+		"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+		"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`,
+		"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+		"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+		"/eth/v1/node/version":            `{"data":{"version":"test/v1.0.0"}}`,
+	}
+
+	var mu sync.Mutex
+	conditional := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		etag := `"` + r.URL.Path + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			mu.Lock()
+			conditional[r.URL.Path]++
+			mu.Unlock()
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	service, err := New(ctx, WithAddress(srv.URL))
+	require.NoError(t, err)
+	s := service.(*Service)
+
+	spec, err := s.Spec(ctx)
+	require.NoError(t, err)
+	genesis, err := s.Genesis(ctx)
+	require.NoError(t, err)
+
+	// Refetch static values; these should be conditional requests.
+	s.clearStaticValues()
+	refetchedSpec, err := s.Spec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec, refetchedSpec)
+	refetchedGenesis, err := s.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis, refetchedGenesis)
+	_, err = s.NodeVersion(ctx)
+	require.NoError(t, err)
+
+	mu.Lock()
+	require.Equal(t, 1, conditional["/eth/v1/config/spec"])
+	require.Equal(t, 1, conditional["/eth/v1/beacon/genesis"])
+	// Node version is not cached by entity tag.
+	require.Equal(t, 0, conditional["/eth/v1/node/version"])
+	mu.Unlock()
+
+	// A cleared cache results in unconditional requests.
+	s.clearStaticValues()
+	s.clearETags()
+	_, err = s.Spec(ctx)
+	require.NoError(t, err)
+	mu.Lock()
+	require.Equal(t, 1, conditional["/eth/v1/config/spec"])
+	mu.Unlock()
+}
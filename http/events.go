@@ -18,10 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
@@ -35,57 +35,190 @@ import (
 
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	var overflows atomic.Uint64
+	_, err := s.events(ctx, topics, handler, &overflows)
+
+	return err
+}
+
+// events feeds requested events with the given topics to the supplied handler until
+// either the context is done or the service is closed, counting events dropped by its
+// buffer in overflows.  It returns a channel that is closed once the events stream has
+// been torn down.
+func (s *Service) events(ctx context.Context,
+	topics []string,
+	handler client.EventHandlerFunc,
+	overflows *atomic.Uint64,
+) (
+	<-chan struct{},
+	error,
+) {
 	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Logger()
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Logger()
 	ctx = log.WithContext(ctx)
 
 	if len(topics) == 0 {
-		return errors.New("no topics supplied")
+		return nil, errors.New("no topics supplied")
 	}
 
 	// Ensure we support the requested topic(s).
 	for i := range topics {
 		if _, exists := api.SupportedEventTopics[topics[i]]; !exists {
-			return fmt.Errorf("unsupported event topic %s", topics[i])
+			return nil, fmt.Errorf("unsupported event topic %s", topics[i])
 		}
 	}
 
+	buffering, err := s.eventsBufferFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	reference, err := url.Parse(fmt.Sprintf("eth/v1/events?topics=%s", strings.Join(topics, "&topics=")))
 	if err != nil {
-		return errors.Wrap(err, "invalid endpoint")
+		return nil, errors.Wrap(err, "invalid endpoint")
 	}
-	url := s.base.ResolveReference(reference).String()
-	log.Trace().Str("url", url).Msg("GET request to events stream")
+	url := s.baseURL().ResolveReference(reference).String()
+	log.Trace().Str("url", s.redactor.String(url)).Msg("GET request to events stream")
 
 	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
-		Dial: (&net.Dialer{
-			Timeout:   2 * time.Second,
-			KeepAlive: 2 * time.Second,
-		}).Dial,
+	if s.customClient {
+		client.Connection = s.client
+	} else {
+		var transport http.RoundTripper = &http.Transport{
+			Proxy:           proxyFunc(s.proxy),
+			DialContext:     dialContext(2*time.Second, 2*time.Second, s.socketPath),
+			TLSClientConfig: s.tlsConfig,
+		}
+		if len(s.interceptors) > 0 {
+			transport = newInterceptedTransport(transport, s.interceptors)
+		}
+		client.Connection.Transport = transport
+	}
+
+	// Reconnection is handled by the loop below rather than by the SSE client, so that
+	// authorization is refreshed and the reconnection is reported on each attempt.
+	client.ReconnectStrategy = &noRetryBackOff{}
+	var connected atomic.Bool
+	client.OnConnect(func(_ *sse.Client) {
+		connected.Store(true)
+	})
+
+	s.closeMu.RLock()
+	if s.closed {
+		s.closeMu.RUnlock()
+		return nil, errors.New("service closed")
 	}
+	s.eventsWG.Add(1)
+	s.closeMu.RUnlock()
 
+	// Tear down the stream if the service is closed.
+	ctx, cancel := context.WithCancel(ctx)
 	go func() {
+		select {
+		case <-s.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if handler != nil && buffering.size > 0 {
+		handler = bufferedEventHandler(ctx, buffering, overflows, handler)
+	}
+
+	var gaps *eventsGapFiller
+	if handler != nil && s.eventsGapFill {
+		gaps = s.newEventsGapFiller(topics, handler)
+		if gaps != nil {
+			handler = gaps.trackingHandler(ctx)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer s.eventsWG.Done()
+		defer cancel()
+
+		attempt := 0
+		delay := s.eventsReconnectDelay
+		var lastErr error
 		for {
 			select {
-			case <-time.After(time.Second):
-				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, handler)
-				}); err != nil {
-					log.Error().Err(err).Msg("Failed to subscribe to event stream")
-				}
-				log.Trace().Msg("Events stream disconnected")
+			case <-time.After(delay):
 			case <-ctx.Done():
 				log.Debug().Msg("Context done")
 				return
 			}
+
+			if attempt > 0 {
+				log.Debug().Int("attempt", attempt).Msg("Reconnecting to events stream")
+				if s.eventsReconnectHandler != nil {
+					s.eventsReconnectHandler(ctx, topics, attempt, lastErr)
+				}
+				if gaps != nil {
+					gaps.reconnected(ctx)
+				}
+			}
+
+			log.Trace().Msg("Connecting to events stream")
+			connected.Store(false)
+			// The SSE client only calls its connect callback once unless told it is disconnected.
+			client.Connected = false
+			// Obtain authorization on each connection, as the token may have changed.
+			authorization, err := s.authorization(ctx)
+			if err != nil {
+				log.Error().Err(s.redactor.Error(err)).Msg("Failed to obtain authorization token for event stream")
+				lastErr = err
+			} else {
+				if authorization != "" {
+					client.Headers["Authorization"] = authorization
+				}
+				lastErr = client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+					s.handleEvent(ctx, msg, handler)
+				})
+				if lastErr != nil && ctx.Err() == nil {
+					log.Error().Err(s.redactor.Error(lastErr)).Msg("Failed to subscribe to event stream")
+				}
+				log.Trace().Msg("Events stream disconnected")
+			}
+
+			if connected.Load() {
+				// The stream was up, so start backing off afresh.
+				attempt = 0
+			}
+			attempt++
+			delay = s.eventsReconnectBackoff(attempt)
 		}
 	}()
 
-	return nil
+	return done, nil
+}
+
+// eventsReconnectBackoff returns the delay before the given reconnection attempt,
+// doubling for each consecutive attempt up to the maximum delay.
+func (s *Service) eventsReconnectBackoff(attempt int) time.Duration {
+	delay := s.eventsReconnectDelay
+	for i := 1; i < attempt && delay < s.eventsReconnectMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > s.eventsReconnectMaxDelay {
+		delay = s.eventsReconnectMaxDelay
+	}
+
+	return delay
 }
 
+// noRetryBackOff is a reconnection strategy for the SSE client that never retries.
+type noRetryBackOff struct{}
+
+// NextBackOff returns the duration to wait before retrying; -1 stops retries.
+func (*noRetryBackOff) NextBackOff() time.Duration {
+	return -1
+}
+
+// Reset resets the strategy.
+func (*noRetryBackOff) Reset() {}
+
 // handleEvent parses an event and passes it on to the handler.
 func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler client.EventHandlerFunc) {
 	log := zerolog.Ctx(ctx)
@@ -103,7 +236,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		Topic: string(msg.Event),
 	}
 	switch string(msg.Event) {
-	case "head":
+	case api.EventTopicHead:
 		headEvent := &api.HeadEvent{}
 		err := json.Unmarshal(msg.Data, headEvent)
 		if err != nil {
@@ -111,7 +244,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = headEvent
-	case "block":
+	case api.EventTopicBlock:
 		blockEvent := &api.BlockEvent{}
 		err := json.Unmarshal(msg.Data, blockEvent)
 		if err != nil {
@@ -119,7 +252,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = blockEvent
-	case "attestation":
+	case api.EventTopicAttestation:
 		attestation := &phase0.Attestation{}
 		err := json.Unmarshal(msg.Data, attestation)
 		if err != nil {
@@ -127,7 +260,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = attestation
-	case "voluntary_exit":
+	case api.EventTopicVoluntaryExit:
 		voluntaryExit := &phase0.SignedVoluntaryExit{}
 		err := json.Unmarshal(msg.Data, voluntaryExit)
 		if err != nil {
@@ -135,7 +268,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = voluntaryExit
-	case "finalized_checkpoint":
+	case api.EventTopicFinalizedCheckpoint:
 		finalizedCheckpointEvent := &api.FinalizedCheckpointEvent{}
 		err := json.Unmarshal(msg.Data, finalizedCheckpointEvent)
 		if err != nil {
@@ -143,7 +276,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = finalizedCheckpointEvent
-	case "chain_reorg":
+	case api.EventTopicChainReorg:
 		chainReorgEvent := &api.ChainReorgEvent{}
 		err := json.Unmarshal(msg.Data, chainReorgEvent)
 		if err != nil {
@@ -151,7 +284,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = chainReorgEvent
-	case "contribution_and_proof":
+	case api.EventTopicContributionAndProof:
 		contributionAndProofEvent := &altair.SignedContributionAndProof{}
 		err := json.Unmarshal(msg.Data, contributionAndProofEvent)
 		if err != nil {
@@ -159,7 +292,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = contributionAndProofEvent
-	case "payload_attributes":
+	case api.EventTopicPayloadAttributes:
 		payloadAttributesEvent := &api.PayloadAttributesEvent{}
 		err := json.Unmarshal(msg.Data, payloadAttributesEvent)
 		if err != nil {
@@ -167,6 +300,30 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 			return
 		}
 		event.Data = payloadAttributesEvent
+	case api.EventTopicBlobSidecar:
+		blobSidecarEvent := &api.BlobSidecarEvent{}
+		err := json.Unmarshal(msg.Data, blobSidecarEvent)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse blob sidecar event")
+			return
+		}
+		event.Data = blobSidecarEvent
+	case api.EventTopicProposerSlashing:
+		proposerSlashing := &phase0.ProposerSlashing{}
+		err := json.Unmarshal(msg.Data, proposerSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse proposer slashing")
+			return
+		}
+		event.Data = proposerSlashing
+	case api.EventTopicAttesterSlashing:
+		attesterSlashing := &phase0.AttesterSlashing{}
+		err := json.Unmarshal(msg.Data, attesterSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attester slashing")
+			return
+		}
+		event.Data = attesterSlashing
 	case "":
 		// Used as keepalive.  Ignore.
 		return
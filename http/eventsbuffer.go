@@ -0,0 +1,140 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync/atomic"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// EventsOverflowPolicy defines what happens when an event arrives for a subscription
+// whose buffer is full.
+type EventsOverflowPolicy int
+
+const (
+	// EventsOverflowBlock blocks the events stream until there is space in the buffer.
+	EventsOverflowBlock EventsOverflowPolicy = iota
+	// EventsOverflowDropOldest drops the oldest buffered event to make space for the new event.
+	EventsOverflowDropOldest
+	// EventsOverflowDropNewest drops the new event.
+	EventsOverflowDropNewest
+)
+
+var eventsOverflowPolicyStrings = [...]string{
+	"block",
+	"drop oldest",
+	"drop newest",
+}
+
+// String returns a string representation of the overflow policy.
+func (p EventsOverflowPolicy) String() string {
+	if int(p) < 0 || int(p) >= len(eventsOverflowPolicyStrings) {
+		return "unknown"
+	}
+
+	return eventsOverflowPolicyStrings[p]
+}
+
+type eventsBufferKey struct{}
+
+// eventsBuffer is the buffering applied to an individual events subscription.
+type eventsBuffer struct {
+	size   int
+	policy EventsOverflowPolicy
+}
+
+// WithSubscriptionEventsBuffer returns a copy of the context that sets the number of events
+// buffered, and the policy to apply when the buffer is full, for events subscriptions
+// started with it.  This overrides the service-wide buffering set with WithEventsBuffer().
+func WithSubscriptionEventsBuffer(ctx context.Context, size int, policy EventsOverflowPolicy) context.Context {
+	return context.WithValue(ctx, eventsBufferKey{}, &eventsBuffer{
+		size:   size,
+		policy: policy,
+	})
+}
+
+// eventsBufferFor returns the buffering for an events subscription started with the context.
+func (s *Service) eventsBufferFor(ctx context.Context) (*eventsBuffer, error) {
+	buffer, ok := ctx.Value(eventsBufferKey{}).(*eventsBuffer)
+	if !ok {
+		return &eventsBuffer{
+			size:   s.eventsBufferSize,
+			policy: s.eventsOverflowPolicy,
+		}, nil
+	}
+
+	if buffer.size < 0 {
+		return nil, errors.New("events buffer size cannot be negative")
+	}
+	if buffer.policy < EventsOverflowBlock || buffer.policy > EventsOverflowDropNewest {
+		return nil, errors.New("invalid events overflow policy")
+	}
+
+	return buffer, nil
+}
+
+// bufferedEventHandler returns a handler that places events in a buffer, from which they
+// are passed to the supplied handler by a separate goroutine until the context is done.
+// Events dropped because the buffer is full are counted in overflows.
+func bufferedEventHandler(ctx context.Context,
+	buffering *eventsBuffer,
+	overflows *atomic.Uint64,
+	handler client.EventHandlerFunc,
+) client.EventHandlerFunc {
+	buffer := make(chan *api.Event, buffering.size)
+
+	go func() {
+		for {
+			select {
+			case event := <-buffer:
+				handler(event)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func(event *api.Event) {
+		switch buffering.policy {
+		case EventsOverflowDropNewest:
+			select {
+			case buffer <- event:
+			default:
+				overflows.Add(1)
+			}
+		case EventsOverflowDropOldest:
+			for {
+				select {
+				case buffer <- event:
+					return
+				default:
+				}
+				select {
+				case <-buffer:
+					overflows.Add(1)
+				default:
+				}
+			}
+		default:
+			select {
+			case buffer <- event:
+			case <-ctx.Done():
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferedEventHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    EventsOverflowPolicy
+		delivered []phase0.Slot
+		overflows uint64
+	}{
+		{
+			name:      "Block",
+			policy:    EventsOverflowBlock,
+			delivered: []phase0.Slot{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "DropOldest",
+			policy:    EventsOverflowDropOldest,
+			delivered: []phase0.Slot{1, 4, 5},
+			overflows: 2,
+		},
+		{
+			name:      "DropNewest",
+			policy:    EventsOverflowDropNewest,
+			delivered: []phase0.Slot{1, 2, 3},
+			overflows: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var overflows atomic.Uint64
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var mu sync.Mutex
+			delivered := make([]phase0.Slot, 0)
+			handler := bufferedEventHandler(ctx, &eventsBuffer{size: 2, policy: test.policy}, &overflows, func(event *api.Event) {
+				slot := event.Data.(*api.HeadEvent).Slot
+				if slot == 1 {
+					close(started)
+					<-release
+				}
+				mu.Lock()
+				delivered = append(delivered, slot)
+				mu.Unlock()
+			})
+
+			// Occupy the handler with the first event, then fill the buffer and overflow it.
+			handler(&api.Event{Topic: api.EventTopicHead, Data: &api.HeadEvent{Slot: 1}})
+			<-started
+			done := make(chan struct{})
+			go func() {
+				for slot := phase0.Slot(2); slot <= 5; slot++ {
+					handler(&api.Event{Topic: api.EventTopicHead, Data: &api.HeadEvent{Slot: slot}})
+				}
+				close(done)
+			}()
+			if test.policy != EventsOverflowBlock {
+				// Non-blocking policies return immediately.
+				<-done
+			}
+			close(release)
+			<-done
+
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return len(delivered) == len(test.delivered)
+			}, 5*time.Second, time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, test.delivered, delivered)
+			require.Equal(t, test.overflows, overflows.Load())
+		})
+	}
+}
+
+func TestEventsBufferFor(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		eventsBufferSize:     2,
+		eventsOverflowPolicy: EventsOverflowDropOldest,
+	}
+
+	buffer, err := s.eventsBufferFor(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &eventsBuffer{size: 2, policy: EventsOverflowDropOldest}, buffer)
+
+	buffer, err = s.eventsBufferFor(WithSubscriptionEventsBuffer(ctx, 8, EventsOverflowDropNewest))
+	require.NoError(t, err)
+	require.Equal(t, &eventsBuffer{size: 8, policy: EventsOverflowDropNewest}, buffer)
+
+	_, err = s.eventsBufferFor(WithSubscriptionEventsBuffer(ctx, -1, EventsOverflowBlock))
+	require.EqualError(t, err, "events buffer size cannot be negative")
+
+	_, err = s.eventsBufferFor(WithSubscriptionEventsBuffer(ctx, 1, EventsOverflowPolicy(3)))
+	require.EqualError(t, err, "invalid events overflow policy")
+}
+
+func TestEventsOverflowPolicyString(t *testing.T) {
+	require.Equal(t, "block", EventsOverflowBlock.String())
+	require.Equal(t, "drop oldest", EventsOverflowDropOldest.String())
+	require.Equal(t, "drop newest", EventsOverflowDropNewest.String())
+	require.Equal(t, "unknown", EventsOverflowPolicy(-1).String())
+}
@@ -0,0 +1,294 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// eventsGapFillMaxSlots is the maximum number of slots backfilled after a reconnection.
+const eventsGapFillMaxSlots = 256
+
+// eventsGapFiller tracks the head, block and finalized checkpoint events seen on an
+// events stream, and backfills those missed whilst the stream was disconnected.
+type eventsGapFiller struct {
+	service *Service
+	handler client.EventHandlerFunc
+
+	head      bool
+	block     bool
+	finalized bool
+
+	mu sync.Mutex
+	// pending is set after a reconnection, until the gap has been filled.
+	pending       bool
+	haveSlot      bool
+	lastSlot      phase0.Slot
+	haveFinalized bool
+	lastFinalized phase0.Epoch
+}
+
+// newEventsGapFiller creates a gap filler for the given topics, or returns nil if
+// none of the topics can be backfilled.
+func (s *Service) newEventsGapFiller(topics []string, handler client.EventHandlerFunc) *eventsGapFiller {
+	g := &eventsGapFiller{
+		service: s,
+		handler: handler,
+	}
+	for _, topic := range topics {
+		switch topic {
+		case apiv1.EventTopicHead:
+			g.head = true
+		case apiv1.EventTopicBlock:
+			g.block = true
+		case apiv1.EventTopicFinalizedCheckpoint:
+			g.finalized = true
+		}
+	}
+	if !g.head && !g.block && !g.finalized {
+		return nil
+	}
+
+	return g
+}
+
+// trackingHandler returns an event handler that tracks events from the stream, filling
+// any outstanding gap before passing them on.
+func (g *eventsGapFiller) trackingHandler(ctx context.Context) client.EventHandlerFunc {
+	return func(event *apiv1.Event) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		switch data := event.Data.(type) {
+		case *apiv1.HeadEvent:
+			g.fillSlots(ctx, data.Slot)
+			g.seenSlot(data.Slot)
+		case *apiv1.BlockEvent:
+			g.fillSlots(ctx, data.Slot)
+			g.seenSlot(data.Slot)
+		case *apiv1.FinalizedCheckpointEvent:
+			g.fillFinality(ctx)
+			if g.haveFinalized && data.Epoch <= g.lastFinalized {
+				// Already delivered by the gap fill.
+				return
+			}
+			g.haveFinalized = true
+			g.lastFinalized = data.Epoch
+		}
+		g.handler(event)
+	}
+}
+
+// reconnected is called when the events stream is about to be reconnected, and fills
+// the gap up to the current head.
+func (g *eventsGapFiller) reconnected(ctx context.Context) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.pending = true
+	if g.haveSlot {
+		header, err := g.service.BeaconBlockHeader(ctx, "head")
+		switch {
+		case err != nil:
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("Failed to obtain head for events gap fill")
+		case header == nil || header.Header == nil || header.Header.Message == nil:
+			zerolog.Ctx(ctx).Debug().Msg("Incomplete head for events gap fill")
+		default:
+			g.fillSlots(ctx, header.Header.Message.Slot+1)
+		}
+	}
+	g.fillFinality(ctx)
+}
+
+// seenSlot records a slot seen on the events stream.
+func (g *eventsGapFiller) seenSlot(slot phase0.Slot) {
+	g.pending = false
+	if !g.haveSlot || slot > g.lastSlot {
+		g.haveSlot = true
+		g.lastSlot = slot
+	}
+}
+
+// fillSlots passes synthesized head and block events for the blocks after the last slot
+// seen and before the given slot to the handler.
+// This must be called with the lock held.
+func (g *eventsGapFiller) fillSlots(ctx context.Context, until phase0.Slot) {
+	if !g.pending || !g.haveSlot || until <= g.lastSlot+1 || (!g.head && !g.block) {
+		return
+	}
+	to := until - 1
+	log := zerolog.Ctx(ctx)
+
+	from := g.lastSlot + 1
+	if to-from >= eventsGapFillMaxSlots {
+		log.Warn().Uint64("from", uint64(from)).Uint64("to", uint64(to)).Msg("Events gap too large; only filling most recent slots")
+		from = to - eventsGapFillMaxSlots + 1
+	}
+	var slotsPerEpoch uint64
+	if g.head {
+		// Required to synthesize the epoch transition and dependent roots of head events.
+		var err error
+		slotsPerEpoch, err = g.service.SlotsPerEpoch(ctx)
+		if err == nil && slotsPerEpoch == 0 {
+			err = errors.New("slots per epoch is 0")
+		}
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to obtain slots per epoch for events gap fill")
+
+			return
+		}
+	}
+	dependentRoots := make(map[phase0.Slot]phase0.Root)
+
+	log.Trace().Uint64("from", uint64(from)).Uint64("to", uint64(to)).Msg("Filling events gap")
+	for slot := from; slot <= to; slot++ {
+		header, err := g.service.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			log.Debug().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to obtain block header for events gap fill")
+
+			return
+		}
+		if header == nil || header.Header == nil || header.Header.Message == nil || !header.Canonical {
+			// Empty slot.
+			continue
+		}
+
+		if g.block {
+			g.handler(&apiv1.Event{
+				Topic: apiv1.EventTopicBlock,
+				Data: &apiv1.BlockEvent{
+					Slot:  slot,
+					Block: header.Root,
+				},
+			})
+		}
+		if g.head {
+			epoch := uint64(slot) / slotsPerEpoch
+			currentDutyDependentRoot, err := g.dependentRoot(ctx, dependentRoots, slotsPerEpoch, epoch)
+			if err != nil {
+				log.Debug().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to obtain current duty dependent root for events gap fill")
+
+				return
+			}
+			previousDutyDependentRoot := currentDutyDependentRoot
+			if epoch > 0 {
+				previousDutyDependentRoot, err = g.dependentRoot(ctx, dependentRoots, slotsPerEpoch, epoch-1)
+				if err != nil {
+					log.Debug().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to obtain previous duty dependent root for events gap fill")
+
+					return
+				}
+			}
+			g.handler(&apiv1.Event{
+				Topic: apiv1.EventTopicHead,
+				Data: &apiv1.HeadEvent{
+					Slot:                      slot,
+					Block:                     header.Root,
+					State:                     header.Header.Message.StateRoot,
+					EpochTransition:           epoch != uint64(g.lastSlot)/slotsPerEpoch,
+					CurrentDutyDependentRoot:  currentDutyDependentRoot,
+					PreviousDutyDependentRoot: previousDutyDependentRoot,
+				},
+			})
+		}
+		g.lastSlot = slot
+	}
+}
+
+// dependentRoot returns the duty dependent root for the given epoch, being the root of the
+// block at the last slot of the previous epoch, or of the genesis block for epoch 0.
+// Roots are cached by slot in roots.
+func (g *eventsGapFiller) dependentRoot(ctx context.Context,
+	roots map[phase0.Slot]phase0.Root,
+	slotsPerEpoch uint64,
+	epoch uint64,
+) (
+	phase0.Root,
+	error,
+) {
+	var dependentSlot phase0.Slot
+	if epoch > 0 {
+		dependentSlot = phase0.Slot(epoch*slotsPerEpoch - 1)
+	}
+	if root, exists := roots[dependentSlot]; exists {
+		return root, nil
+	}
+
+	// The root at an empty slot is that of the most recent block before it.
+	for slot, checked := dependentSlot, 0; checked < eventsGapFillMaxSlots; slot, checked = slot-1, checked+1 {
+		header, err := g.service.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return phase0.Root{}, err
+		}
+		if header != nil && header.Canonical {
+			roots[dependentSlot] = header.Root
+
+			return header.Root, nil
+		}
+		if slot == 0 {
+			break
+		}
+	}
+
+	return phase0.Root{}, fmt.Errorf("no block found at or before slot %d", dependentSlot)
+}
+
+// fillFinality passes a synthesized finalized checkpoint event to the handler if the
+// chain has finalized since the last finalized checkpoint event seen.
+// This must be called with the lock held.
+func (g *eventsGapFiller) fillFinality(ctx context.Context) {
+	if !g.pending || !g.finalized || !g.haveFinalized {
+		return
+	}
+	log := zerolog.Ctx(ctx)
+
+	finality, err := g.service.Finality(ctx, "head")
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain finality for events gap fill")
+
+		return
+	}
+	if finality == nil || finality.Finalized == nil || finality.Finalized.Epoch <= g.lastFinalized {
+		return
+	}
+
+	header, err := g.service.BeaconBlockHeader(ctx, fmt.Sprintf("%#x", finality.Finalized.Root))
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain finalized block header for events gap fill")
+
+		return
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return
+	}
+
+	g.handler(&apiv1.Event{
+		Topic: apiv1.EventTopicFinalizedCheckpoint,
+		Data: &apiv1.FinalizedCheckpointEvent{
+			Block: finality.Finalized.Root,
+			State: header.Header.Message.StateRoot,
+			Epoch: finality.Finalized.Epoch,
+		},
+	})
+	g.lastFinalized = finality.Finalized.Epoch
+}
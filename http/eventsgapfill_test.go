@@ -0,0 +1,216 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// gapFillRoot returns a distinct root for the given slot.
+func gapFillRoot(slot uint64) string {
+	return fmt.Sprintf("%#064x", slot)
+}
+
+func gapFillHeader(slot uint64) string {
+	return fmt.Sprintf(`{"data":{"root":"%s","canonical":true,"header":{"message":{"slot":"%d","proposer_index":"1","parent_root":"%s","state_root":"%s","body_root":"%s"},"signature":"0x%0192x"}}}`,
+		gapFillRoot(slot), slot, gapFillRoot(slot-1), gapFillRoot(slot+1000), gapFillRoot(0), 0)
+}
+
+func TestEventsGapFill(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	connections := 0
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/events":
+			mu.Lock()
+			connections++
+			connection := connections
+			mu.Unlock()
+			w.Header().Set("Content-Type", "text/event-stream")
+			if connection == 1 {
+				// Send events and then drop the stream.
+				_, _ = fmt.Fprintf(w, "event: block\ndata: {\"slot\":\"10\",\"block\":\"%s\",\"execution_optimistic\":false}\n\n", gapFillRoot(10))
+				_, _ = fmt.Fprintf(w, "event: finalized_checkpoint\ndata: {\"block\":\"%s\",\"state\":\"%s\",\"epoch\":\"1\"}\n\n", gapFillRoot(8), gapFillRoot(1008))
+
+				return
+			}
+			_, _ = fmt.Fprintf(w, "event: block\ndata: {\"slot\":\"15\",\"block\":\"%s\",\"execution_optimistic\":false}\n\n", gapFillRoot(15))
+			_, _ = fmt.Fprintf(w, "event: finalized_checkpoint\ndata: {\"block\":\"%s\",\"state\":\"%s\",\"epoch\":\"2\"}\n\n", gapFillRoot(16), gapFillRoot(1016))
+			w.(nethttp.Flusher).Flush()
+			<-r.Context().Done()
+		case "/eth/v1/beacon/headers/head":
+			_, _ = w.Write([]byte(gapFillHeader(13)))
+		case "/eth/v1/beacon/headers/12", "/eth/v1/beacon/headers/13", "/eth/v1/beacon/headers/14":
+			var slot uint64
+			_, _ = fmt.Sscanf(r.URL.Path, "/eth/v1/beacon/headers/%d", &slot)
+			_, _ = w.Write([]byte(gapFillHeader(slot)))
+		case fmt.Sprintf("/eth/v1/beacon/headers/%s", gapFillRoot(16)):
+			_, _ = w.Write([]byte(gapFillHeader(16)))
+		case "/eth/v1/beacon/states/head/finality_checkpoints":
+			_, _ = fmt.Fprintf(w, `{"data":{"previous_justified":{"epoch":"2","root":"%s"},"current_justified":{"epoch":"2","root":"%s"},"finalized":{"epoch":"2","root":"%s"}}}`,
+				gapFillRoot(16), gapFillRoot(16), gapFillRoot(16))
+		default:
+			// Includes slot 11, which is empty.
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(10*time.Millisecond, 40*time.Millisecond),
+		http.WithEventsGapFill(true),
+	)
+	require.NoError(t, err)
+
+	var eventsMu sync.Mutex
+	events := make([]*api.Event, 0)
+	subscription, err := service.(client.EventsSubscriber).Subscribe(ctx,
+		[]string{"block", "finalized_checkpoint"},
+		func(event *api.Event) {
+			eventsMu.Lock()
+			events = append(events, event)
+			eventsMu.Unlock()
+		},
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+
+		return len(events) >= 7
+	}, 5*time.Second, 10*time.Millisecond)
+	subscription.Close()
+
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	require.Len(t, events, 7)
+	// Block at 10 and finality at epoch 1 from the first stream.
+	require.Equal(t, phase0.Slot(10), events[0].Data.(*api.BlockEvent).Slot)
+	require.Equal(t, phase0.Epoch(1), events[1].Data.(*api.FinalizedCheckpointEvent).Epoch)
+	// Synthesized blocks at 12 and 13, skipping empty slot 11, and finality at epoch 2.
+	require.Equal(t, phase0.Slot(12), events[2].Data.(*api.BlockEvent).Slot)
+	require.Equal(t, gapFillRoot(12), fmt.Sprintf("%#x", events[2].Data.(*api.BlockEvent).Block))
+	require.Equal(t, phase0.Slot(13), events[3].Data.(*api.BlockEvent).Slot)
+	finalized := events[4].Data.(*api.FinalizedCheckpointEvent)
+	require.Equal(t, phase0.Epoch(2), finalized.Epoch)
+	require.Equal(t, gapFillRoot(1016), fmt.Sprintf("%#x", finalized.State))
+	// Block at 14, missed between the gap fill and the new stream, then block at 15 from the new stream.
+	// The finalized checkpoint at epoch 2 from the new stream is not repeated.
+	require.Equal(t, phase0.Slot(14), events[5].Data.(*api.BlockEvent).Slot)
+	require.Equal(t, phase0.Slot(15), events[6].Data.(*api.BlockEvent).Slot)
+}
+
+func gapFillHeadEvent(slot uint64) string {
+	return fmt.Sprintf(`{"slot":"%d","block":"%s","state":"%s","epoch_transition":false,"current_duty_dependent_root":"%s","previous_duty_dependent_root":"%s","execution_optimistic":false}`,
+		slot, gapFillRoot(slot), gapFillRoot(slot+1000), gapFillRoot(0), gapFillRoot(0))
+}
+
+func TestEventsGapFillHead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	connections := 0
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/events":
+			mu.Lock()
+			connections++
+			connection := connections
+			mu.Unlock()
+			w.Header().Set("Content-Type", "text/event-stream")
+			if connection == 1 {
+				_, _ = fmt.Fprintf(w, "event: head\ndata: %s\n\n", gapFillHeadEvent(62))
+
+				return
+			}
+			_, _ = fmt.Fprintf(w, "event: head\ndata: %s\n\n", gapFillHeadEvent(66))
+			w.(nethttp.Flusher).Flush()
+			<-r.Context().Done()
+		case "/eth/v1/beacon/headers/head":
+			_, _ = w.Write([]byte(gapFillHeader(65)))
+		case "/eth/v1/beacon/headers/0", "/eth/v1/beacon/headers/30", "/eth/v1/beacon/headers/63", "/eth/v1/beacon/headers/65":
+			var slot uint64
+			_, _ = fmt.Sscanf(r.URL.Path, "/eth/v1/beacon/headers/%d", &slot)
+			_, _ = w.Write([]byte(gapFillHeader(slot)))
+		default:
+			// Includes slots 31 and 64, which are empty.
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(10*time.Millisecond, 40*time.Millisecond),
+		http.WithEventsGapFill(true),
+	)
+	require.NoError(t, err)
+
+	var eventsMu sync.Mutex
+	events := make([]*api.Event, 0)
+	subscription, err := service.(client.EventsSubscriber).Subscribe(ctx,
+		[]string{"head"},
+		func(event *api.Event) {
+			eventsMu.Lock()
+			events = append(events, event)
+			eventsMu.Unlock()
+		},
+	)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+
+		return len(events) >= 4
+	}, 5*time.Second, 10*time.Millisecond)
+	subscription.Close()
+
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	require.Len(t, events, 4)
+	require.Equal(t, phase0.Slot(62), events[0].Data.(*api.HeadEvent).Slot)
+	// Synthesized head at 63, with dependent roots from slot 30 (as slot 31 is empty) and genesis.
+	head := events[1].Data.(*api.HeadEvent)
+	require.Equal(t, phase0.Slot(63), head.Slot)
+	require.False(t, head.EpochTransition)
+	require.Equal(t, gapFillRoot(30), fmt.Sprintf("%#x", head.CurrentDutyDependentRoot))
+	require.Equal(t, gapFillRoot(0), fmt.Sprintf("%#x", head.PreviousDutyDependentRoot))
+	// Synthesized head at 65, skipping empty slot 64, in the next epoch.
+	head = events[2].Data.(*api.HeadEvent)
+	require.Equal(t, phase0.Slot(65), head.Slot)
+	require.True(t, head.EpochTransition)
+	require.Equal(t, gapFillRoot(63), fmt.Sprintf("%#x", head.CurrentDutyDependentRoot))
+	require.Equal(t, gapFillRoot(30), fmt.Sprintf("%#x", head.PreviousDutyDependentRoot))
+	require.Equal(t, phase0.Slot(66), events[3].Data.(*api.HeadEvent).Slot)
+}
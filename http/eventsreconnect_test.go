@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	requests := 0
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		require.Equal(t, []string{"head"}, r.URL.Query()["topics"])
+		w.Header().Set("Content-Type", "text/event-stream")
+		// Send a single event and then drop the stream.
+		_, _ = w.Write([]byte("event: head\ndata: {\"slot\":\"4095943\",\"block\":\"0x1c3981b7439cd2dc53dca1a99122e1cacb36a13796d426d4c8a03ba745cb0c8b\",\"state\":\"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28\",\"epoch_transition\":false,\"current_duty_dependent_root\":\"0x907a3462a2905e3df2624869aa7f9a8635eb35bdcf9ce68a26fab691f9dada61\",\"previous_duty_dependent_root\":\"0x935569bdc1aaad65dbeb532a125390d039058924ea81799238ed53e4e4639a11\",\"execution_optimistic\":false}\n\n"))
+	})
+
+	var reconnectsMu sync.Mutex
+	reconnects := make([]int, 0)
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(10*time.Millisecond, 40*time.Millisecond),
+		http.WithEventsReconnectHandler(func(_ context.Context, topics []string, attempt int, _ error) {
+			require.Equal(t, []string{"head"}, topics)
+			reconnectsMu.Lock()
+			reconnects = append(reconnects, attempt)
+			reconnectsMu.Unlock()
+		}),
+	)
+	require.NoError(t, err)
+
+	var eventsMu sync.Mutex
+	events := 0
+	require.NoError(t, service.(client.EventsProvider).Events(ctx, []string{"head"}, func(*api.Event) {
+		eventsMu.Lock()
+		events++
+		eventsMu.Unlock()
+	}))
+
+	require.Eventually(t, func() bool {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+
+		return events >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+
+	reconnectsMu.Lock()
+	defer reconnectsMu.Unlock()
+	require.GreaterOrEqual(t, len(reconnects), 2)
+	// Each connection delivered an event before it dropped, so the attempt count restarts.
+	for _, attempt := range reconnects {
+		require.Equal(t, 1, attempt)
+	}
+}
+
+func TestEventsReconnectParameters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := http.New(ctx,
+		http.WithAddress("http://localhost:1"),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(0, time.Second),
+	)
+	require.EqualError(t, err, "problem with parameters: no events reconnect delay specified")
+
+	_, err = http.New(ctx,
+		http.WithAddress("http://localhost:1"),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(2*time.Second, time.Second),
+	)
+	require.EqualError(t, err, "problem with parameters: events reconnect maximum delay cannot be less than delay")
+}
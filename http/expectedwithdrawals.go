@@ -0,0 +1,52 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type expectedWithdrawalsJSON struct {
+	Data []*api.ExpectedWithdrawal `json:"data"`
+}
+
+// ExpectedWithdrawals fetches the withdrawals expected to be included in the next block built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string) ([]*api.ExpectedWithdrawal, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/builder/states/%s/expected_withdrawals", stateID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request expected withdrawals")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var expectedWithdrawalsJSON expectedWithdrawalsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&expectedWithdrawalsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse expected withdrawals")
+	}
+	if expectedWithdrawalsJSON.Data == nil {
+		return nil, errors.New("no expected withdrawals returned")
+	}
+
+	return expectedWithdrawalsJSON.Data, nil
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type feeRecipientJSON struct {
+	Data *apiv1.FeeRecipient `json:"data"`
+}
+
+type setFeeRecipientRequestJSON struct {
+	EthAddress string `json:"ethaddress"`
+}
+
+// FeeRecipient fetches the fee recipient for the validator with the given public key.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.FeeRecipient, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request fee recipient")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain fee recipient")
+	}
+
+	var resp feeRecipientJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fee recipient")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("fee recipient not returned")
+	}
+
+	return resp.Data, nil
+}
+
+// SetFeeRecipient sets the fee recipient for the validator with the given public key.
+func (s *Service) SetFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey, ethAddress bellatrix.ExecutionAddress) error {
+	reqBody, err := json.Marshal(&setFeeRecipientRequestJSON{
+		EthAddress: ethAddress.String(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	_, err = s.post2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey), reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to set fee recipient")
+	}
+
+	return nil
+}
+
+// DeleteFeeRecipient removes the fee recipient for the validator with the given public key,
+// returning it to the validator client's default.
+func (s *Service) DeleteFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.delete2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/feerecipient", pubKey), nil, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete fee recipient")
+	}
+
+	return nil
+}
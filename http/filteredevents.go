@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/events"
+)
+
+// FilteredEvents feeds requested events with the given topics that pass all of the
+// filters to the supplied handler.
+func (s *Service) FilteredEvents(ctx context.Context,
+	topics []string,
+	filters []apiv1.EventFilter,
+	handler client.EventHandlerFunc,
+) error {
+	return events.Filtered(ctx, s, topics, filters, handler)
+}
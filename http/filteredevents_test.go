@@ -0,0 +1,76 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilteredEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for i := 1; i <= 3; i++ {
+			_, _ = w.Write([]byte(fmt.Sprintf("event: voluntary_exit\ndata: {\"message\":{\"epoch\":\"1\",\"validator_index\":\"%d\"},\"signature\":\"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f\"}\n\n", i)))
+		}
+		w.(nethttp.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	received := make([]phase0.ValidatorIndex, 0)
+	require.NoError(t, service.(client.FilteredEventsProvider).FilteredEvents(ctx,
+		[]string{api.EventTopicVoluntaryExit},
+		[]api.EventFilter{api.ValidatorsEventFilter(1, 3)},
+		func(event *api.Event) {
+			mu.Lock()
+			received = append(received, event.Data.(*phase0.SignedVoluntaryExit).Message.ValidatorIndex)
+			mu.Unlock()
+		},
+	))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(received) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []phase0.ValidatorIndex{1, 3}, received)
+}
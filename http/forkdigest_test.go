@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkDigest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, nil)
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	forkDigest, err := service.(client.ForkDigestProvider).ForkDigest(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ForkDigest{0xb5, 0x30, 0x3f, 0x2a}, forkDigest)
+
+	forkVersion, err := service.(client.ForkDigestProvider).ForkVersion(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x00}, forkVersion)
+
+	nextForkVersion, nextForkEpoch, err := service.(client.ForkDigestProvider).NextForkVersion(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Version{0x00, 0x00, 0x00, 0x00}, nextForkVersion)
+	require.Equal(t, phase0.Epoch(0xffffffffffffffff), nextForkEpoch)
+}
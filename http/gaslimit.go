@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type gasLimitJSON struct {
+	Data *apiv1.GasLimit `json:"data"`
+}
+
+type setGasLimitRequestJSON struct {
+	GasLimit string `json:"gas_limit"`
+}
+
+// GasLimit fetches the gas limit for the validator with the given public key.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.GasLimit, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request gas limit")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain gas limit")
+	}
+
+	var resp gasLimitJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse gas limit")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("gas limit not returned")
+	}
+
+	return resp.Data, nil
+}
+
+// SetGasLimit sets the gas limit for the validator with the given public key.
+func (s *Service) SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error {
+	reqBody, err := json.Marshal(&setGasLimitRequestJSON{
+		GasLimit: fmt.Sprintf("%d", gasLimit),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	_, err = s.post2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to set gas limit")
+	}
+
+	return nil
+}
+
+// DeleteGasLimit removes the gas limit for the validator with the given public key,
+// returning it to the validator client's default.
+func (s *Service) DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.delete2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/gas_limit", pubKey), nil, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete gas limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type graffitiJSON struct {
+	Data *apiv1.Graffiti `json:"data"`
+}
+
+type setGraffitiRequestJSON struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// Graffiti fetches the graffiti for the validator with the given public key.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.Graffiti, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request graffiti")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain graffiti")
+	}
+
+	var resp graffitiJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse graffiti")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("graffiti not returned")
+	}
+
+	return resp.Data, nil
+}
+
+// SetGraffiti sets the graffiti for the validator with the given public key.
+func (s *Service) SetGraffiti(ctx context.Context, pubKey phase0.BLSPubKey, graffiti string) error {
+	if len(graffiti) > 32 {
+		return errors.New("graffiti too long")
+	}
+
+	reqBody, err := json.Marshal(&setGraffitiRequestJSON{
+		Graffiti: graffiti,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	_, err = s.post2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey), reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to set graffiti")
+	}
+
+	return nil
+}
+
+// DeleteGraffiti removes the graffiti for the validator with the given public key,
+// returning it to the validator client's default.
+func (s *Service) DeleteGraffiti(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	_, err := s.delete2(ctx, fmt.Sprintf("/eth/v1/validator/%#x/graffiti", pubKey), nil, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete graffiti")
+	}
+
+	return nil
+}
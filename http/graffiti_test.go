@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraffiti(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pubKey := phase0.BLSPubKey{0x93, 0x24}
+	path := "/eth/v1/validator/0x9324" + strings.Repeat("00", 46) + "/graffiti"
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case nethttp.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"pubkey":"0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a","graffiti":"hello"}}`))
+		case nethttp.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, `{"graffiti":"goodbye"}`, string(body))
+			w.WriteHeader(nethttp.StatusAccepted)
+		case nethttp.MethodDelete:
+			w.WriteHeader(nethttp.StatusNoContent)
+		default:
+			w.WriteHeader(nethttp.StatusMethodNotAllowed)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+	manager := service.(client.GraffitiManager)
+
+	res, err := manager.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "hello", res.Graffiti)
+
+	require.NoError(t, manager.SetGraffiti(ctx, pubKey, "goodbye"))
+	require.EqualError(t, manager.SetGraffiti(ctx, pubKey, strings.Repeat("x", 33)), "graffiti too long")
+	require.NoError(t, manager.DeleteGraffiti(ctx, pubKey))
+
+	_, err = manager.Graffiti(ctx, phase0.BLSPubKey{})
+	require.ErrorContains(t, err, "failed to request graffiti")
+}
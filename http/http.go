@@ -23,11 +23,13 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 )
 
@@ -37,47 +39,211 @@ type Error struct {
 	Endpoint   string
 	StatusCode int
 	Data       []byte
+	// RequestID is the correlation ID sent with the request, if any.
+	RequestID string
+	// Code is the error code in the body of the response, if any.
+	Code int
+	// Message is the error message in the body of the response, if any.
+	Message string
+	// Failures are the failures of individual items in the body of the response to a
+	// batch submission, if any.
+	Failures []*IndexedError
+}
+
+// IndexedError is the failure of an individual item in a batch submission.
+type IndexedError struct {
+	// Index is the index of the item in the submission.
+	Index int
+	// Message is the reason for the failure.
+	Message string
+}
+
+// errorJSON is the structured error returned by beacon nodes.
+type errorJSON struct {
+	Code     json.Number         `json:"code"`
+	Message  string              `json:"message"`
+	Failures []*indexedErrorJSON `json:"failures"`
+}
+
+type indexedErrorJSON struct {
+	Index   json.Number `json:"index"`
+	Message string      `json:"message"`
+}
+
+// newError creates an error for a failed request, populating the structured fields
+// from the response data where it contains a beacon node error.
+// Sensitive information is removed from the error by the redactor.
+func newError(method string, endpoint string, statusCode int, data []byte, requestID string, redactor *redactor) Error {
+	res := parseError(data)
+	res.Method = method
+	res.Endpoint = endpoint
+	res.StatusCode = statusCode
+	res.RequestID = requestID
+
+	// Redaction takes place after parsing, as it could otherwise change the structure of the data.
+	res.Data = redactor.Bytes(data)
+	res.Message = redactor.String(res.Message)
+	for _, failure := range res.Failures {
+		failure.Message = redactor.String(failure.Message)
+	}
+
+	return res
+}
+
+// parseError populates the structured fields of an error from the response data where
+// it contains a beacon node error.
+func parseError(data []byte) Error {
+	res := Error{}
+
+	var errorData errorJSON
+	if err := json.Unmarshal(data, &errorData); err != nil {
+		// Not a structured error.
+		return res
+	}
+	if code, err := errorData.Code.Int64(); err == nil {
+		res.Code = int(code)
+	}
+	res.Message = errorData.Message
+	for _, failure := range errorData.Failures {
+		if failure == nil {
+			continue
+		}
+		index, err := failure.Index.Int64()
+		if err != nil {
+			continue
+		}
+		res.Failures = append(res.Failures, &IndexedError{
+			Index:   int(index),
+			Message: failure.Message,
+		})
+	}
+
+	return res
 }
 
 func (e Error) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%s failed with status %d (request ID %s): %s", e.Method, e.StatusCode, e.RequestID, e.Data)
+	}
+
 	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
 }
 
-// get sends an HTTP get request and returns the body.
-// If the response from the server is a 404 this will return nil for both the reader and the error.
-func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
+// Is reports if the error matches the target, mapping the status code of the error
+// onto the errors defined in the api package.
+func (e Error) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return target == api.ErrNotFound
+	case http.StatusServiceUnavailable:
+		return target == api.ErrNotSynced
+	case http.StatusUnsupportedMediaType:
+		return target == api.ErrUnsupportedVersion
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return target == api.ErrInvalidRequest
+	case http.StatusTooManyRequests:
+		return target == api.ErrRateLimited
+	case http.StatusAccepted:
+		return target == api.ErrBroadcastValidationFailed
+	default:
+		return false
+	}
+}
+
+// Retryable returns true if the request could succeed if retried, which is the case for
+// server errors, timeouts and rate limiting.
+func (e Error) Retryable() bool {
+	return e.StatusCode >= http.StatusInternalServerError ||
+		e.StatusCode == http.StatusRequestTimeout ||
+		e.StatusCode == http.StatusTooManyRequests
+}
+
+// unsupportedVersionError is returned when data is of a version that is not handled.
+type unsupportedVersionError struct {
+	msg string
+}
+
+func (e unsupportedVersionError) Error() string {
+	return e.msg
+}
+
+func (unsupportedVersionError) Unwrap() error {
+	return api.ErrUnsupportedVersion
+}
+
+// newUnsupportedVersionError creates an error for an unhandled data version.
+func newUnsupportedVersionError(format string, args ...interface{}) error {
+	return unsupportedVersionError{msg: fmt.Sprintf(format, args...)}
+}
+
+// doGet sends an HTTP get request and returns the body.
+// If the response from the server is a 404 this will return an error that matches api.ErrNotFound,
+// or nil for both the reader and the error if configured with WithNilOnNotFound().
+func (s *Service) doGet(ctx context.Context, endpoint string) (io.Reader, error) {
 	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Str("endpoint", endpoint).Logger()
+	requestID := s.requestID(ctx)
+	if requestID != "" {
+		log = log.With().Str("request_id", requestID).Logger()
+	}
 	log.Trace().Msg("GET request")
 
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	if err := s.circuitBreakers.allow(endpoint); err != nil {
+		return nil, err
+	}
+
+	opCtx, cancel := s.opContext(ctx)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
 	if err != nil {
+		s.circuitBreakers.release(endpoint)
 		cancel()
 		return nil, errors.Wrap(err, "failed to create GET request")
 	}
 	s.addExtraHeaders(req)
+	s.setRequestID(req, requestID)
+	if err := s.setAuthorization(req); err != nil {
+		s.circuitBreakers.release(endpoint)
+		cancel()
+		return nil, err
+	}
 	req.Header.Set("Accept", "application/json")
+	s.setAcceptEncoding(req)
+	s.setIfNoneMatch(req, endpoint)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
+		s.circuitBreakers.recordError(ctx, endpoint)
 		cancel()
-		return nil, errors.Wrap(err, "failed to call GET endpoint")
+		return nil, errors.Wrap(s.redactor.Error(err), "failed to call GET endpoint")
 	}
 	defer resp.Body.Close()
+	s.circuitBreakers.recordResponse(endpoint, resp.StatusCode)
+	recordResponseMetadata(ctx, resp)
+
+	if resp.StatusCode == http.StatusNotModified {
+		cancel()
+		body := s.etagBody(endpoint)
+		if body == nil {
+			return nil, errors.New("not modified response without cached value")
+		}
+		log.Trace().Msg("GET response not modified; using cached value")
+		recordResponseBodyMetadata(ctx, body)
+
+		return bytes.NewReader(body), nil
+	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		// Nothing found.  This is not an error, so we return nil on both counts.
+	if resp.StatusCode == http.StatusNotFound && s.nilOnNotFound {
+		// Nothing found, which has been configured to not be an error.
 		cancel()
 		return nil, nil
 	}
 
-	data, err := io.ReadAll(resp.Body)
+	data, err := s.readResponseBody(resp, endpoint)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read GET response")
@@ -86,17 +252,14 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
-		return nil, Error{
-			Method:     http.MethodGet,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       data,
-		}
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", s.redactor.String(string(data))).Msg("GET failed")
+		return nil, newError(http.MethodGet, endpoint, resp.StatusCode, data, requestID, s.redactor)
 	}
 	cancel()
+	s.storeETag(endpoint, resp, data)
 
 	log.Trace().Str("response", string(data)).Msg("GET response")
+	recordResponseBodyMetadata(ctx, data)
 
 	return bytes.NewReader(data), nil
 }
@@ -104,7 +267,11 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 // post sends an HTTP post request and returns the body.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
 	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Str("endpoint", endpoint).Logger()
+	requestID := s.requestID(ctx)
+	if requestID != "" {
+		log = log.With().Str("request_id", requestID).Logger()
+	}
 	if e := log.Trace(); e.Enabled() {
 		bodyBytes, err := io.ReadAll(body)
 		if err != nil {
@@ -115,32 +282,47 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		e.Str("body", string(bodyBytes)).Msg("POST request")
 	}
 
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	if err := s.circuitBreakers.allow(endpoint); err != nil {
+		return nil, err
+	}
+
+	opCtx, cancel := s.opContext(ctx)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url.String(), body)
 	if err != nil {
+		s.circuitBreakers.release(endpoint)
 		cancel()
 		return nil, errors.Wrap(err, "failed to create POST request")
 	}
 	s.addExtraHeaders(req)
+	s.setRequestID(req, requestID)
+	if err := s.setAuthorization(req); err != nil {
+		s.circuitBreakers.release(endpoint)
+		cancel()
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	s.setAcceptEncoding(req)
 	if req.Header.Get("User-Agent") == "" {
 		req.Header.Set("User-Agent", "go-eth2-client/0.18.3")
 	}
 
 	resp, err := s.client.Do(req)
 	if err != nil {
+		s.circuitBreakers.recordError(ctx, endpoint)
 		cancel()
-		return nil, errors.Wrap(err, "failed to call POST endpoint")
+		return nil, errors.Wrap(s.redactor.Error(err), "failed to call POST endpoint")
 	}
 	defer resp.Body.Close()
+	s.circuitBreakers.recordResponse(endpoint, resp.StatusCode)
+	recordResponseMetadata(ctx, resp)
 
-	data, err := io.ReadAll(resp.Body)
+	data, err := s.readResponseBody(resp, endpoint)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read POST response")
@@ -148,121 +330,253 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("POST failed")
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", s.redactor.String(string(data))).Msg("POST failed")
 		cancel()
-		return nil, Error{
-			Method:     http.MethodPost,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       data,
-		}
+		return nil, newError(http.MethodPost, endpoint, resp.StatusCode, data, requestID, s.redactor)
 	}
 	cancel()
 
 	log.Trace().Str("response", string(data)).Msg("POST response")
+	recordResponseBodyMetadata(ctx, data)
 
 	return bytes.NewReader(data), nil
 }
 
-func (s *Service) addExtraHeaders(req *http.Request) {
-	for k, v := range s.extraHeaders {
-		req.Header.Add(k, v)
+// post2 sends an HTTP post request with the given content type and headers, and returns the response.
+func (s *Service) post2(ctx context.Context,
+	endpoint string,
+	body []byte,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.send2(ctx, http.MethodPost, endpoint, body, contentType, headers)
+}
+
+// postJSON encodes the value as JSON into a pooled buffer and sends it as an HTTP post
+// request with the given headers, returning the response.
+func (s *Service) postJSON(ctx context.Context,
+	endpoint string,
+	value any,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	data, release, err := codecs.MarshalJSONPooled(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
 	}
+	body := newRequestBody(data, release)
+	defer body.done()
+
+	return s.sendBody(ctx, http.MethodPost, endpoint, body, ContentTypeJSON, headers)
 }
 
-// responseMetadata returns metadata related to responses.
-type responseMetadata struct {
-	Version spec.DataVersion `json:"version"`
+// delete2 sends an HTTP delete request with the given content type and headers, and returns the response.
+func (s *Service) delete2(ctx context.Context,
+	endpoint string,
+	body []byte,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	return s.send2(ctx, http.MethodDelete, endpoint, body, contentType, headers)
 }
 
-type httpResponse struct {
-	statusCode       int
-	contentType      ContentType
-	consensusVersion spec.DataVersion
-	body             []byte
+// send2 sends an HTTP request with a body using the given method, content type and headers,
+// and returns the response.
+func (s *Service) send2(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	reqBody := newRequestBody(body, nil)
+	defer reqBody.done()
+
+	return s.sendBody(ctx, method, endpoint, reqBody, contentType, headers)
 }
 
-// get2 sends an HTTP get request and returns the body.
-// If the response from the server is a 404 this will return nil for both the reader and the error.
-func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, error) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get2")
+// sendBody sends an HTTP request with a request body using the given method, content type
+// and headers, and returns the response.
+func (s *Service) sendBody(ctx context.Context,
+	method string,
+	endpoint string,
+	body *requestBody,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, strings.ToLower(method)+"2")
 	defer span.End()
 
 	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
-	log.Trace().Msg("GET request")
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Str("endpoint", endpoint).Logger()
+	requestID := s.requestID(ctx)
+	if requestID != "" {
+		log = log.With().Str("request_id", requestID).Logger()
+	}
+	if e := log.Trace(); e.Enabled() {
+		if contentType == ContentTypeJSON {
+			e.Str("body", string(body.data)).Msg(method + " request")
+		} else {
+			e.Str("content_type", contentType.MediaType()).Int("body_length", len(body.data)).Msg(method + " request")
+		}
+	}
 
-	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	if err := s.circuitBreakers.allow(endpoint); err != nil {
+		return nil, err
+	}
+
+	opCtx, cancel := s.opContext(ctx)
 	defer cancel()
-	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	req, err := newBodyRequest(opCtx, method, url.String(), body)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+		s.circuitBreakers.release(endpoint)
+		return nil, errors.Wrapf(err, "failed to create %s request", method)
 	}
 	s.addExtraHeaders(req)
-	// Prefer SSZ, JSON if not.
-	req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
+	s.setRequestID(req, requestID)
+	if err := s.setAuthorization(req); err != nil {
+		s.circuitBreakers.release(endpoint)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType.MediaType())
+	req.Header.Set("Accept", "application/json")
+	s.setAcceptEncoding(req)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", "go-eth2-client/0.18.3")
+	}
 	span.AddEvent("Sending request")
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		span.RecordError(errors.New("Request failed"))
-		return nil, errors.Wrap(err, "failed to call GET endpoint")
+		s.circuitBreakers.recordError(ctx, endpoint)
+		span.RecordError(err)
+		return nil, errors.Wrapf(s.redactor.Error(err), "failed to call %s endpoint", method)
 	}
 	defer resp.Body.Close()
+	s.circuitBreakers.recordResponse(endpoint, resp.StatusCode)
+	recordResponseMetadata(ctx, resp)
 	log = log.With().Int("status_code", resp.StatusCode).Logger()
 
 	res := &httpResponse{
 		statusCode: resp.StatusCode,
+		requestID:  requestID,
 	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		// Nothing found.  Note that this is not considered an error.
-		span.RecordError(errors.New("endpoint not found"))
-		log.Debug().Msg("Endpoint not found")
-		return res, nil
-	}
-
-	if resp.StatusCode == http.StatusNoContent {
-		// Nothing returned.  Note that this is not considered an error.
-		span.AddEvent("Received empty response")
-		log.Trace().Msg("Endpoint returned no content")
-		return res, nil
-	}
-
-	res.body, err = io.ReadAll(resp.Body)
+	res.body, err = s.readResponseBody(resp, endpoint)
 	if err != nil {
 		span.RecordError(err)
-		log.Warn().Err(err).Msg("Failed to read body")
-		return nil, errors.Wrap(err, "failed to read body")
+		return nil, errors.Wrapf(err, "failed to read %s response", method)
 	}
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
-		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(res.body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
-		log.Debug().Int("status_code", resp.StatusCode).RawJSON("response", trimmedResponse).Msg("GET failed")
-		return nil, Error{
-			Method:     http.MethodGet,
-			StatusCode: resp.StatusCode,
-			Endpoint:   endpoint,
-			Data:       res.body,
+		log.Trace().Str("data", s.redactor.String(string(res.body))).Msg(method + " failed")
+		return nil, newError(method, endpoint, resp.StatusCode, res.body, requestID, s.redactor)
+	}
+
+	log.Trace().Str("response", string(res.body)).Msg(method + " response")
+	recordResponseBodyMetadata(ctx, res.body)
+
+	return res, nil
+}
+
+// opContext returns a context for an individual operation.
+// The operation is bounded by the service's timeout, unless the call options
+// attached to the context supply their own deadline or timeout.
+func (s *Service) opContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	opts := api.CallOptsFromContext(ctx)
+	if opts == nil || (opts.Deadline.IsZero() && opts.Timeout <= 0) {
+		return context.WithTimeout(ctx, s.timeout)
+	}
+
+	deadline := opts.Deadline
+	if opts.Timeout > 0 {
+		if timeoutDeadline := time.Now().Add(opts.Timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
 		}
 	}
 
-	if err := populateContentType(res, resp); err != nil {
-		// For now, assume that unknown type is JSON.
-		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
-		res.contentType = ContentTypeJSON
+	return context.WithDeadline(ctx, deadline)
+}
+
+func (s *Service) addExtraHeaders(req *http.Request) {
+	for k, v := range s.extraHeaders {
+		req.Header.Add(k, v)
+	}
+}
+
+// responseMetadata returns metadata related to responses.
+type responseMetadata struct {
+	Version string `json:"version"`
+}
+
+type httpResponse struct {
+	statusCode       int
+	contentType      ContentType
+	consensusVersion spec.DataVersion
+	// rawConsensusVersion is the consensus version as supplied by the node.
+	rawConsensusVersion string
+	body                []byte
+	// requestID is the ID of the request that obtained the response, if any.
+	requestID string
+}
+
+// doGet2 sends an HTTP get request and returns the body.
+// If the response from the server is a 404 this will return an error that matches api.ErrNotFound,
+// or a response without a body if configured with WithNilOnNotFound().
+func (s *Service) doGet2(ctx context.Context, endpoint string) (*httpResponse, error) {
+	stream, err := s.getStream(ctx, endpoint, "")
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	res := &httpResponse{
+		statusCode:  stream.statusCode,
+		contentType: stream.contentType,
+	}
+	if stream.body == nil {
+		return res, nil
 	}
-	span.SetAttributes(attribute.String("content-type", res.contentType.String()))
 
-	if err := populateConsensusVersion(res, resp); err != nil {
+	res.body, err = stream.readAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+	if res.contentType == ContentTypeJSON {
+		recordResponseBodyMetadata(ctx, res.body)
+	}
+
+	if err := populateConsensusVersion(res, stream.resp); err != nil {
+		if s.allowUnknownVersions && res.rawConsensusVersion != "" {
+			return nil, &api.UnknownVersionError{
+				Version:     res.rawConsensusVersion,
+				ContentType: res.contentType.MediaType(),
+				Data:        res.body,
+			}
+		}
 		return nil, errors.Wrap(err, "failed to parse consensus version")
 	}
 
@@ -281,12 +595,19 @@ func populateConsensusVersion(res *httpResponse, resp *http.Response) error {
 		if err := json.Unmarshal(res.body, &metadata); err != nil {
 			return errors.Wrap(err, "no consensus version header and failed to parse response")
 		}
-		res.consensusVersion = metadata.Version
+		if metadata.Version == "" {
+			return nil
+		}
+		res.rawConsensusVersion = metadata.Version
+		if err := res.consensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", metadata.Version))); err != nil {
+			return errors.Wrap(err, "failed to parse consensus version")
+		}
 		return nil
 	}
 	if len(respConsensusVersions) != 1 {
 		return fmt.Errorf("malformed consensus version (%d entries)", len(respConsensusVersions))
 	}
+	res.rawConsensusVersion = respConsensusVersions[0]
 	if err := res.consensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", respConsensusVersions[0]))); err != nil {
 		return errors.Wrap(err, "failed to parse consensus version")
 	}
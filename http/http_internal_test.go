@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpContext(t *testing.T) {
+	s := &Service{
+		timeout: time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		opts     *api.CallOpts
+		expected time.Duration
+	}{
+		{
+			name:     "Default",
+			expected: time.Minute,
+		},
+		{
+			name: "Timeout",
+			opts: &api.CallOpts{
+				Timeout: 5 * time.Minute,
+			},
+			expected: 5 * time.Minute,
+		},
+		{
+			name: "Deadline",
+			opts: &api.CallOpts{
+				Deadline: time.Now().Add(10 * time.Second),
+			},
+			expected: 10 * time.Second,
+		},
+		{
+			name: "TimeoutEarlier",
+			opts: &api.CallOpts{
+				Deadline: time.Now().Add(10 * time.Second),
+				Timeout:  5 * time.Second,
+			},
+			expected: 5 * time.Second,
+		},
+		{
+			name: "DeadlineEarlier",
+			opts: &api.CallOpts{
+				Deadline: time.Now().Add(5 * time.Second),
+				Timeout:  10 * time.Second,
+			},
+			expected: 5 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.opts != nil {
+				ctx = api.WithCallOpts(ctx, test.opts)
+			}
+			opCtx, cancel := s.opContext(ctx)
+			defer cancel()
+
+			deadline, exists := opCtx.Deadline()
+			require.True(t, exists)
+			require.WithinDuration(t, time.Now().Add(test.expected), deadline, time.Second)
+		})
+	}
+}
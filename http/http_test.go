@@ -6,7 +6,10 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -35,6 +38,128 @@ func TestError(t *testing.T) {
 	require.Equal(t, "/eth/v1/beacon/genesis", httpError.Endpoint)
 }
 
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		target     error
+	}{
+		{
+			name:       "NotFound",
+			statusCode: nethttp.StatusNotFound,
+			target:     api.ErrNotFound,
+		},
+		{
+			name:       "NotSynced",
+			statusCode: nethttp.StatusServiceUnavailable,
+			target:     api.ErrNotSynced,
+		},
+		{
+			name:       "UnsupportedVersion",
+			statusCode: nethttp.StatusUnsupportedMediaType,
+			target:     api.ErrUnsupportedVersion,
+		},
+		{
+			name:       "BadRequest",
+			statusCode: nethttp.StatusBadRequest,
+			target:     api.ErrInvalidRequest,
+		},
+		{
+			name:       "UnprocessableEntity",
+			statusCode: nethttp.StatusUnprocessableEntity,
+			target:     api.ErrInvalidRequest,
+		},
+		{
+			name:       "RateLimited",
+			statusCode: nethttp.StatusTooManyRequests,
+			target:     api.ErrRateLimited,
+		},
+		{
+			name:       "BroadcastValidationFailed",
+			statusCode: nethttp.StatusAccepted,
+			target:     api.ErrBroadcastValidationFailed,
+		},
+		{
+			name:       "Unmapped",
+			statusCode: nethttp.StatusTeapot,
+		},
+	}
+
+	targets := []error{
+		api.ErrNotFound,
+		api.ErrNotSynced,
+		api.ErrUnsupportedVersion,
+		api.ErrInvalidRequest,
+		api.ErrRateLimited,
+		api.ErrBroadcastValidationFailed,
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := errors.Wrap(http.Error{Method: nethttp.MethodGet, StatusCode: test.statusCode}, "failed")
+			for _, target := range targets {
+				require.Equal(t, target == test.target, errors.Is(err, target), target.Error())
+			}
+		})
+	}
+}
+
+func TestStructuredError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		data     string
+		code     int
+		message  string
+		failures []*http.IndexedError
+	}{
+		{
+			name: "Unstructured",
+			data: "bad request",
+		},
+		{
+			name:    "Structured",
+			data:    `{"code":400,"message":"invalid attestation"}`,
+			code:    400,
+			message: "invalid attestation",
+		},
+		{
+			name:    "Failures",
+			data:    `{"code":"400","message":"some failures","failures":[{"index":"1","message":"bad signature"},{"index":3,"message":"unknown block"}]}`,
+			code:    400,
+			message: "some failures",
+			failures: []*http.IndexedError{
+				{Index: 1, Message: "bad signature"},
+				{Index: 3, Message: "unknown block"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+				w.WriteHeader(nethttp.StatusBadRequest)
+				_, _ = w.Write([]byte(test.data))
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			err = service.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+			var httpError http.Error
+			require.True(t, errors.As(err, &httpError))
+			require.Equal(t, test.code, httpError.Code)
+			require.Equal(t, test.message, httpError.Message)
+			require.Equal(t, test.failures, httpError.Failures)
+		})
+	}
+}
+
 func TestClientShouldSendExtraHeadersWhenProvided(t *testing.T) {
 	authorizationHeader := "Authorization"
 	authorizationToken := "Bearer token"
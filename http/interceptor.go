@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+)
+
+// RoundTripFunc sends an HTTP request and returns the response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Interceptor wraps the sending of HTTP requests, allowing requests and responses
+// to be inspected or altered.  It must call next to send the request onwards, unless
+// it is supplying its own response.
+type Interceptor func(next RoundTripFunc) RoundTripFunc
+
+// interceptedTransport is a transport that passes requests through a chain of interceptors.
+type interceptedTransport struct {
+	next      http.RoundTripper
+	roundTrip RoundTripFunc
+}
+
+// newInterceptedTransport creates a transport that passes requests through the
+// interceptors before sending them with the given transport.  The first interceptor
+// is the first to see each request.
+func newInterceptedTransport(next http.RoundTripper, interceptors []Interceptor) *interceptedTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	roundTrip := next.RoundTrip
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		roundTrip = interceptors[i](roundTrip)
+	}
+
+	return &interceptedTransport{
+		next:      next,
+		roundTrip: roundTrip,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *interceptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.roundTrip(req)
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *interceptedTransport) CloseIdleConnections() {
+	if closer, isCloser := t.next.(interface{ CloseIdleConnections() }); isCloser {
+		closer.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var signature string
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		signature = r.Header.Get("X-Signature")
+		syncingHandler("1")(w, r)
+	})
+
+	var mu sync.Mutex
+	calls := make([]string, 0)
+	record := func(name string) http.Interceptor {
+		return func(next http.RoundTripFunc) http.RoundTripFunc {
+			return func(req *nethttp.Request) (*nethttp.Response, error) {
+				mu.Lock()
+				calls = append(calls, name+" "+req.URL.Path)
+				mu.Unlock()
+				return next(req)
+			}
+		}
+	}
+	sign := func(next http.RoundTripFunc) http.RoundTripFunc {
+		return func(req *nethttp.Request) (*nethttp.Response, error) {
+			req.Header.Set("X-Signature", "signed")
+			return next(req)
+		}
+	}
+	chaos := false
+	fail := func(next http.RoundTripFunc) http.RoundTripFunc {
+		return func(req *nethttp.Request) (*nethttp.Response, error) {
+			if chaos {
+				return nil, errors.New("chaos")
+			}
+			return next(req)
+		}
+	}
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithInterceptor(record("first")),
+		http.WithInterceptor(record("second")),
+		http.WithInterceptor(sign),
+		http.WithInterceptor(fail),
+	)
+	require.NoError(t, err)
+
+	mu.Lock()
+	calls = calls[:0]
+	mu.Unlock()
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "signed", signature)
+	mu.Lock()
+	require.Equal(t, []string{"first /eth/v1/node/syncing", "second /eth/v1/node/syncing"}, calls)
+	mu.Unlock()
+
+	chaos = true
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.ErrorContains(t, err, "chaos")
+}
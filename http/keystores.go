@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type keystoresJSON struct {
+	Data []*apiv1.Keystore `json:"data"`
+}
+
+type importKeystoresRequestJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type keyOperationResultsJSON struct {
+	Data []*apiv1.KeyOperationResult `json:"data"`
+}
+
+type deleteKeysRequestJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+// Keystores lists the local keystores of the validator client.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) Keystores(ctx context.Context) ([]*apiv1.Keystore, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/keystores")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request keystores")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain keystores")
+	}
+
+	var resp keystoresJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse keystores")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("keystores not returned")
+	}
+
+	return resp.Data, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores, with their passwords and optional
+// EIP-3076 slashing protection interchange data, in to the validator client.
+func (s *Service) ImportKeystores(ctx context.Context,
+	keystores []string,
+	passwords []string,
+	slashingProtection string,
+) (
+	[]*apiv1.KeyOperationResult,
+	error,
+) {
+	if len(keystores) == 0 {
+		return nil, errors.New("no keystores supplied")
+	}
+	if len(passwords) != len(keystores) {
+		return nil, errors.New("number of passwords does not match number of keystores")
+	}
+
+	reqBody, err := json.Marshal(&importKeystoresRequestJSON{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	res, err := s.post2(ctx, "/eth/v1/keystores", reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import keystores")
+	}
+
+	return keyOperationResults(res, len(keystores))
+}
+
+// DeleteKeystores deletes the keystores with the given public keys from the validator
+// client, returning the slashing protection interchange data for the keys.
+func (s *Service) DeleteKeystores(ctx context.Context, pubKeys []phase0.BLSPubKey) (*apiv1.DeletedKeystores, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys supplied")
+	}
+
+	reqBody, err := deleteKeysRequest(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.delete2(ctx, "/eth/v1/keystores", reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete keystores")
+	}
+
+	var resp apiv1.DeletedKeystores
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse deleted keystores")
+	}
+	if len(resp.Results) != len(pubKeys) {
+		return nil, fmt.Errorf("%d results returned for %d keys", len(resp.Results), len(pubKeys))
+	}
+
+	return &resp, nil
+}
+
+// deleteKeysRequest creates the body of a request to delete keys.
+func deleteKeysRequest(pubKeys []phase0.BLSPubKey) ([]byte, error) {
+	req := &deleteKeysRequestJSON{
+		Pubkeys: make([]string, len(pubKeys)),
+	}
+	for i := range pubKeys {
+		req.Pubkeys[i] = fmt.Sprintf("%#x", pubKeys[i])
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return reqBody, nil
+}
+
+// keyOperationResults parses the results of an operation on keys, ensuring that
+// there is a result for each key.
+func keyOperationResults(res *httpResponse, keys int) ([]*apiv1.KeyOperationResult, error) {
+	var resp keyOperationResultsJSON
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse key operation results")
+	}
+	if len(resp.Data) != keys {
+		return nil, fmt.Errorf("%d results returned for %d keys", len(resp.Data), keys)
+	}
+
+	return resp.Data, nil
+}
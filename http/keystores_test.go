@@ -0,0 +1,174 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystores(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/keystores" || r.Method != nethttp.MethodGet {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"validating_pubkey":"0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a","derivation_path":"m/12381/3600/0/0/0","readonly":false}]}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	keystores, err := service.(client.KeystoresManager).Keystores(ctx)
+	require.NoError(t, err)
+	require.Len(t, keystores, 1)
+	require.Equal(t, "m/12381/3600/0/0/0", keystores[0].DerivationPath)
+}
+
+func TestImportKeystores(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/keystores" || r.Method != nethttp.MethodPost {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := make(map[string]json.RawMessage)
+		require.NoError(t, json.Unmarshal(body, &req))
+		keystores := make([]string, 0)
+		require.NoError(t, json.Unmarshal(req["keystores"], &keystores))
+		results := make([]*apiv1.KeyOperationResult, len(keystores))
+		for i := range keystores {
+			results[i] = &apiv1.KeyOperationResult{Status: apiv1.KeyOperationStatusImported}
+		}
+		data, err := json.Marshal(map[string]interface{}{"data": results})
+		require.NoError(t, err)
+		_, _ = w.Write(data)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		keystores []string
+		passwords []string
+		err       string
+	}{
+		{
+			name: "Empty",
+			err:  "no keystores supplied",
+		},
+		{
+			name:      "PasswordsMismatch",
+			keystores: []string{"{}", "{}"},
+			passwords: []string{"secret"},
+			err:       "number of passwords does not match number of keystores",
+		},
+		{
+			name:      "Good",
+			keystores: []string{"{}", "{}"},
+			passwords: []string{"secret1", "secret2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := service.(client.KeystoresManager).ImportKeystores(ctx, test.keystores, test.passwords, "")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, results, len(test.keystores))
+				require.Equal(t, apiv1.KeyOperationStatusImported, results[0].Status)
+			}
+		})
+	}
+}
+
+func TestDeleteKeystores(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/keystores" || r.Method != nethttp.MethodDelete {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"status":"deleted"}],"slashing_protection":"{}"}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pubKeys []phase0.BLSPubKey
+		err     string
+	}{
+		{
+			name: "Empty",
+			err:  "no public keys supplied",
+		},
+		{
+			name:    "ResultsMismatch",
+			pubKeys: []phase0.BLSPubKey{{0x01}, {0x02}},
+			err:     "1 results returned for 2 keys",
+		},
+		{
+			name:    "Good",
+			pubKeys: []phase0.BLSPubKey{{0x01}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.KeystoresManager).DeleteKeystores(ctx, test.pubKeys)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, apiv1.KeyOperationStatusDeleted, res.Results[0].Status)
+				require.Equal(t, "{}", res.SlashingProtection)
+			}
+		})
+	}
+}
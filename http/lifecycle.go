@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// monitoredTransport wraps a transport, requesting a refresh of connections once
+// a number of consecutive requests have failed to connect.
+type monitoredTransport struct {
+	next      http.RoundTripper
+	threshold int
+	refreshCh chan struct{}
+
+	mu       sync.Mutex
+	failures int
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *monitoredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil {
+		t.failures = 0
+		return resp, nil
+	}
+	if req.Context().Err() != nil {
+		// Failure was due to the caller's context, not the connection.
+		return resp, err
+	}
+	t.failures++
+	if t.failures >= t.threshold {
+		t.failures = 0
+		select {
+		case t.refreshCh <- struct{}{}:
+		default:
+			// Refresh already pending.
+		}
+	}
+
+	return resp, err
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *monitoredTransport) CloseIdleConnections() {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if closer, isCloser := next.(interface{ CloseIdleConnections() }); isCloser {
+		closer.CloseIdleConnections()
+	}
+}
+
+// refreshConnections refreshes connections to the endpoint periodically, or when requested.
+func (s *Service) refreshConnections(ctx context.Context, interval time.Duration, refreshCh chan struct{}) {
+	var tickerCh <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tickerCh = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tickerCh:
+		case <-refreshCh:
+		}
+		if err := s.refreshConnection(ctx); err != nil {
+			s.log.Warn().Err(s.redactor.Error(err)).Msg("Failed to refresh connection")
+		}
+	}
+}
+
+// refreshConnection closes idle connections so that the endpoint's address is
+// resolved again, and updates the address if an address provider is supplied.
+func (s *Service) refreshConnection(ctx context.Context) error {
+	s.log.Trace().Msg("Refreshing connection")
+	s.client.CloseIdleConnections()
+
+	if s.addressProvider == nil {
+		return nil
+	}
+	address, err := s.addressProvider(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain address")
+	}
+	if address == "" {
+		return errors.New("address provider returned empty address")
+	}
+	if _, isUnixSocket := unixSocketPath(address); isUnixSocket {
+		return errors.New("address provider returned Unix socket address")
+	}
+
+	return s.setAddress(address)
+}
+
+// setAddress sets the address of the endpoint.
+func (s *Service) setAddress(address string) error {
+	base, err := url.Parse(normaliseAddress(address))
+	if err != nil {
+		return errors.Wrap(err, "invalid URL")
+	}
+
+	redactedAddress := address
+	if s.redactSensitive {
+		// Credentials in the new address must be redacted from now on.
+		s.redactor.setAddress(normaliseAddress(address))
+		redactedAddress = s.redactor.String(base.Redacted())
+	}
+
+	s.endpointMu.Lock()
+	if base.String() == s.base.String() {
+		s.endpointMu.Unlock()
+		return nil
+	}
+	s.base = base
+	s.address = redactedAddress
+	s.endpointMu.Unlock()
+
+	s.log.Debug().Str("address", redactedAddress).Msg("Endpoint address changed")
+
+	// The new endpoint may be a different node, so forget what is known about the old one.
+	s.clearStaticValues()
+	s.clearETags()
+	s.sszSubmissionsMutex.Lock()
+	s.sszSubmissionsUnsupported = false
+	s.sszSubmissionsMutex.Unlock()
+
+	return nil
+}
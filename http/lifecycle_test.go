@@ -0,0 +1,127 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// syncingHandler returns a handler that responds to sync state requests with the given head slot.
+func syncingHandler(headSlot string) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/node/syncing" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"head_slot":"` + headSlot + `","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`))
+	}
+}
+
+func TestAddressProvider(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srvA := newTestServer(t, syncingHandler("1"))
+	srvB := newTestServer(t, syncingHandler("2"))
+
+	var mu sync.Mutex
+	address := srvA.URL
+	provider := func(_ context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return address, nil
+	}
+
+	_, err := http.New(ctx,
+		http.WithAddressProvider(func(_ context.Context) (string, error) {
+			return "", errors.New("no address available")
+		}),
+	)
+	require.EqualError(t, err, "failed to obtain address: no address available")
+
+	_, err = http.New(ctx,
+		http.WithAddress(srvA.URL),
+		http.WithConnectionRefreshInterval(-1),
+	)
+	require.EqualError(t, err, "problem with parameters: connection refresh interval cannot be negative")
+
+	service, err := http.New(ctx,
+		http.WithAddressProvider(provider),
+		http.WithConnectionRefreshInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.Equal(t, srvA.URL, service.Address())
+
+	syncState, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), uint64(syncState.HeadSlot))
+
+	mu.Lock()
+	address = srvB.URL
+	mu.Unlock()
+	require.Eventually(t, func() bool {
+		return service.Address() == srvB.URL
+	}, time.Second, 10*time.Millisecond)
+
+	syncState, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), uint64(syncState.HeadSlot))
+}
+
+func TestConnectionRefreshFailures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srvA := newTestServer(t, syncingHandler("1"))
+	srvB := newTestServer(t, syncingHandler("2"))
+
+	var mu sync.Mutex
+	address := srvA.URL
+	service, err := http.New(ctx,
+		http.WithAddressProvider(func(_ context.Context) (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return address, nil
+		}),
+		http.WithConnectionRefreshFailures(2),
+	)
+	require.NoError(t, err)
+
+	// Take down the first server; the refresh will pick up the second.
+	mu.Lock()
+	address = srvB.URL
+	mu.Unlock()
+	srvA.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+		require.Error(t, err)
+	}
+	require.Eventually(t, func() bool {
+		syncState, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+		return err == nil && syncState.HeadSlot == 2
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type altairLightClientOptimisticUpdateJSON struct {
+	Data *altair.LightClientOptimisticUpdate `json:"data"`
+}
+
+type capellaLightClientOptimisticUpdateJSON struct {
+	Data *capella.LightClientOptimisticUpdate `json:"data"`
+}
+
+type denebLightClientOptimisticUpdateJSON struct {
+	Data *deneb.LightClientOptimisticUpdate `json:"data"`
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update known to the beacon node.
+// N.B if the beacon node does not have a light client optimistic update available this will return an error that matches api.ErrNotFound.
+func (s *Service) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	res, err := s.get2(ctx, "/eth/v1/beacon/light_client/optimistic_update")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client optimistic update")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	switch res.contentType {
+	case ContentTypeSSZ:
+		return s.lightClientOptimisticUpdateFromSSZ(res)
+	case ContentTypeJSON:
+		return s.lightClientOptimisticUpdateFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
+
+func (s *Service) lightClientOptimisticUpdateFromSSZ(res *httpResponse) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	update := &spec.VersionedLightClientOptimisticUpdate{
+		Version: res.consensusVersion,
+	}
+
+	switch res.consensusVersion {
+	case spec.DataVersionAltair:
+		update.Altair = &altair.LightClientOptimisticUpdate{}
+		if err := update.Altair.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode altair light client optimistic update")
+		}
+	case spec.DataVersionBellatrix:
+		update.Bellatrix = &altair.LightClientOptimisticUpdate{}
+		if err := update.Bellatrix.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix light client optimistic update")
+		}
+	case spec.DataVersionCapella:
+		update.Capella = &capella.LightClientOptimisticUpdate{}
+		if err := update.Capella.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode capella light client optimistic update")
+		}
+	case spec.DataVersionDeneb:
+		update.Deneb = &deneb.LightClientOptimisticUpdate{}
+		if err := update.Deneb.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb light client optimistic update")
+		}
+	default:
+		return nil, newUnsupportedVersionError("unhandled light client optimistic update version %s", res.consensusVersion)
+	}
+
+	return update, nil
+}
+
+func (s *Service) lightClientOptimisticUpdateFromJSON(res *httpResponse) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	update := &spec.VersionedLightClientOptimisticUpdate{
+		Version: res.consensusVersion,
+	}
+
+	reader := bytes.NewBuffer(res.body)
+	switch update.Version {
+	case spec.DataVersionAltair:
+		var resp altairLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse altair light client optimistic update")
+		}
+		update.Altair = resp.Data
+	case spec.DataVersionBellatrix:
+		var resp altairLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix light client optimistic update")
+		}
+		update.Bellatrix = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella light client optimistic update")
+		}
+		update.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebLightClientOptimisticUpdateJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb light client optimistic update")
+		}
+		update.Deneb = resp.Data
+	default:
+		return nil, newUnsupportedVersionError("unhandled light client optimistic update version %s", res.consensusVersion)
+	}
+
+	return update, nil
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
 
@@ -60,3 +61,13 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 	s.nodeVersion = resp.Data.Version
 	return s.nodeVersion, nil
 }
+
+// ParsedNodeVersion provides the version information of the node parsed in to its component parts.
+func (s *Service) ParsedNodeVersion(ctx context.Context) (*apiv1.NodeVersion, error) {
+	nodeVersion, err := s.NodeVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiv1.ParseNodeVersion(nodeVersion), nil
+}
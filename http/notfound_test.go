@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotFound(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		nilOnNotFound bool
+	}{
+		{
+			name: "Error",
+		},
+		{
+			name:          "Nil",
+			nilOnNotFound: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The test server returns 404 for all non-static requests.
+			srv := newTestServer(t, nil)
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithNilOnNotFound(test.nilOnNotFound),
+			)
+			require.NoError(t, err)
+
+			root, err := service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+			require.Nil(t, root)
+			block, err2 := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+			require.Nil(t, block)
+			state, err3 := service.(client.BeaconStateProvider).BeaconState(ctx, "head")
+			require.Nil(t, state)
+
+			for _, err := range []error{err, err2, err3} {
+				if test.nilOnNotFound {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, api.ErrNotFound)
+				}
+			}
+		})
+	}
+}
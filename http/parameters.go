@@ -14,21 +14,80 @@
 package http
 
 import (
+	"context"
+	"net/http"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel        zerolog.Level
-	address         string
-	timeout         time.Duration
-	indexChunkSize  int
-	pubKeyChunkSize int
-	extraHeaders    map[string]string
+	logLevel                  zerolog.Level
+	address                   string
+	timeout                   time.Duration
+	indexChunkSize            int
+	pubKeyChunkSize           int
+	validatorsPostThreshold   int
+	extraHeaders              map[string]string
+	httpClient                *http.Client
+	broadcastValidation       api.BroadcastValidation
+	redactSensitive           bool
+	circuitBreakerThreshold   int
+	circuitBreakerCooldown    time.Duration
+	compression               bool
+	zstdCompression           bool
+	maxResponseSize           int64
+	maxIdleConns              int
+	maxConnsPerHost           int
+	idleConnTimeout           time.Duration
+	tlsHandshakeTimeout       time.Duration
+	http2                     bool
+	bearerToken               string
+	tokenProvider             TokenProviderFunc
+	clientCert                []byte
+	clientKey                 []byte
+	caCerts                   []byte
+	proxyURL                  string
+	requestIDHeader           string
+	requestIDFunc             RequestIDFunc
+	addressProvider           AddressProviderFunc
+	connectionRefreshInterval time.Duration
+	connectionRefreshFailures int
+	sszSubmissions            bool
+	contentNegotiation        ContentNegotiation
+	endpointAccept            map[string]string
+	interceptors              []Interceptor
+	responseHook              ResponseHookFunc
+	singleflight              bool
+	nilOnNotFound             bool
+	allowUnknownVersions      bool
+	confirmConnection         bool
+	eventsReconnectDelay      time.Duration
+	eventsReconnectMaxDelay   time.Duration
+	eventsReconnectHandler    EventsReconnectHandlerFunc
+	eventsBufferSize          int
+	eventsOverflowPolicy      EventsOverflowPolicy
+	eventsGapFill             bool
 }
 
+// TokenProviderFunc provides a bearer token with which to authorize requests.
+// It is called for each request, so should cache tokens where appropriate.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// AddressProviderFunc provides the current address of the endpoint.
+type AddressProviderFunc func(ctx context.Context) (string, error)
+
+// EventsReconnectHandlerFunc is called when an events stream has dropped and is about to be
+// reconnected.  attempt is the number of consecutive reconnection attempts since the stream
+// was last connected, and err is the reason that the previous connection ended, if known.
+type EventsReconnectHandlerFunc func(ctx context.Context, topics []string, attempt int, err error)
+
+// RequestIDFunc provides the correlation ID for a request from the context with which
+// it is made.  An empty string means that no ID is sent.
+type RequestIDFunc func(ctx context.Context) string
+
 // Parameter is the interface for service parameters.
 type Parameter interface {
 	apply(*parameters)
@@ -48,6 +107,7 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 }
 
 // WithAddress provides the address for the endpoint.
+// An address of the form unix:///path/to/socket connects over a Unix domain socket.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.address = address
@@ -75,6 +135,15 @@ func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
 	})
 }
 
+// WithValidatorsPostThreshold sets the number of validator indices or public keys above
+// which validators are requested with a POST rather than a GET, avoiding URL length limits.
+// If not set, POST is used whenever the request would otherwise need to be split in to chunks.
+func WithValidatorsPostThreshold(threshold int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsPostThreshold = threshold
+	})
+}
+
 // WithExtraHeaders sets additional headers to be sent with each HTTP request.
 func WithExtraHeaders(headers map[string]string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -82,14 +151,337 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithHTTPClient provides a custom HTTP client for communication with the endpoint.
+// If supplied, the client is used as-is for all requests including the events
+// stream, and is responsible for all connection management.  Note that the client
+// should not set its own timeout, as this would also apply to the events stream;
+// request timeouts are governed by the timeout parameter.
+func WithHTTPClient(client *http.Client) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.httpClient = client
+	})
+}
+
+// WithBroadcastValidation sets the level of validation the node carries out on
+// submitted blocks prior to broadcasting them, if the node supports it.
+func WithBroadcastValidation(broadcastValidation api.BroadcastValidation) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.broadcastValidation = broadcastValidation
+	})
+}
+
+// WithRedactSensitive redacts credentials and API keys present in the address,
+// credential-bearing extra headers and bearer tokens from log output and errors.
+func WithRedactSensitive(redactSensitive bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.redactSensitive = redactSensitive
+	})
+}
+
+// WithCircuitBreaker enables a circuit breaker for each endpoint.  Once the given number
+// of consecutive requests to an endpoint have failed, further requests to the endpoint
+// fail immediately until the cooldown has passed, after which a single trial request is
+// allowed through to find out if the endpoint has recovered.
+// A threshold of 0 disables the circuit breaker.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.circuitBreakerThreshold = threshold
+		p.circuitBreakerCooldown = cooldown
+	})
+}
+
+// WithCompression sets whether to request compressed responses from the endpoint.
+// Compression is enabled by default, requesting gzip responses as the standard library
+// does for a default HTTP client, but can be disabled for nodes that misbehave when
+// returning compressed responses.
+func WithCompression(compression bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compression = compression
+	})
+}
+
+// WithZstdCompression sets whether to request zstd compressed responses from the endpoint
+// in preference to gzip.  This has no effect if compression is disabled.
+func WithZstdCompression(zstdCompression bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.zstdCompression = zstdCompression
+	})
+}
+
+// WithMaxResponseSize sets the maximum size, in bytes, of a decompressed response body.
+// Responses that exceed this size return a ResponseTooLargeError.
+// A value of 0 means no limit, which is the default.
+func WithMaxResponseSize(maxResponseSize int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxResponseSize = maxResponseSize
+	})
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections to keep open to the endpoint.
+// This is ignored if a custom HTTP client is supplied.
+func WithMaxIdleConns(maxIdleConns int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxIdleConns = maxIdleConns
+	})
+}
+
+// WithMaxConnsPerHost sets the maximum number of connections, active or idle, to the endpoint.
+// A value of 0 means no limit.
+// This is ignored if a custom HTTP client is supplied.
+func WithMaxConnsPerHost(maxConnsPerHost int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxConnsPerHost = maxConnsPerHost
+	})
+}
+
+// WithIdleConnTimeout sets the time after which an idle connection to the endpoint is closed.
+// This is ignored if a custom HTTP client is supplied.
+func WithIdleConnTimeout(idleConnTimeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.idleConnTimeout = idleConnTimeout
+	})
+}
+
+// WithTLSHandshakeTimeout sets the maximum time to wait for a TLS handshake with the endpoint.
+// This is ignored if a custom HTTP client is supplied.
+func WithTLSHandshakeTimeout(tlsHandshakeTimeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tlsHandshakeTimeout = tlsHandshakeTimeout
+	})
+}
+
+// WithHTTP2 sets whether to attempt HTTP/2 when connecting to an endpoint over TLS.
+// This is ignored if a custom HTTP client is supplied.
+func WithHTTP2(http2 bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.http2 = http2
+	})
+}
+
+// WithBearerToken sets a bearer token with which to authorize requests to the endpoint.
+func WithBearerToken(token string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bearerToken = token
+	})
+}
+
+// WithTokenProvider sets a function that provides a bearer token with which to authorize
+// requests to the endpoint, allowing tokens to be rotated without recreating the service.
+func WithTokenProvider(provider TokenProviderFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tokenProvider = provider
+	})
+}
+
+// WithClientCertificate sets the PEM-encoded certificate and key with which the client
+// authenticates itself to endpoints that require mutual TLS.
+// This is ignored if a custom HTTP client is supplied.
+func WithClientCertificate(cert []byte, key []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clientCert = cert
+		p.clientKey = key
+	})
+}
+
+// WithCACertificates sets the PEM-encoded certificates of the authorities used to verify
+// the endpoint's certificate, in place of the system certificate pool.
+// This is ignored if a custom HTTP client is supplied.
+func WithCACertificates(certs []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.caCerts = certs
+	})
+}
+
+// WithProxyURL sets the URL of a proxy through which to connect to the endpoint.
+// Supported schemes are http, https, socks5 and socks5h; credentials for the proxy
+// can be supplied as part of the URL.
+// This is ignored if a custom HTTP client is supplied.
+func WithProxyURL(proxyURL string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proxyURL = proxyURL
+	})
+}
+
+// WithRequestIDHeader sets a function that provides a correlation ID for each request,
+// which is sent to the endpoint in the given header and included in logs and errors.
+func WithRequestIDHeader(header string, requestIDFunc RequestIDFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestIDHeader = header
+		p.requestIDFunc = requestIDFunc
+	})
+}
+
+// WithAddressProvider sets a function that provides the address of the endpoint.
+// The function is called when the service starts if no address is supplied, and
+// each time connections are refreshed thereafter, allowing the endpoint to be rotated
+// without recreating the service.
+func WithAddressProvider(provider AddressProviderFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.addressProvider = provider
+	})
+}
+
+// WithConnectionRefreshInterval sets the interval at which connections to the endpoint
+// are refreshed, closing idle connections so that the endpoint's address is resolved
+// again.  An interval of 0 disables periodic refreshes.
+func WithConnectionRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionRefreshInterval = interval
+	})
+}
+
+// WithConnectionRefreshFailures sets the number of consecutive failed connections to
+// the endpoint after which connections are refreshed.  A value of 0 disables refreshes
+// on failure.
+func WithConnectionRefreshFailures(failures int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionRefreshFailures = failures
+	})
+}
+
+// WithSSZSubmissions sets whether to encode block submissions as SSZ rather than JSON.
+// SSZ is considerably faster to encode and decode for large blocks.  If the node does
+// not accept SSZ submissions the service falls back to JSON.
+func WithSSZSubmissions(sszSubmissions bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.sszSubmissions = sszSubmissions
+	})
+}
+
+// WithSingleflight sets whether identical concurrent GET requests are collapsed into a
+// single request to the node, with the result shared between the callers.
+// The shared request is bound by the service timeout rather than the context of any one caller,
+// and requests that carry their own call options or request ID are never shared.
+func WithSingleflight(singleflight bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.singleflight = singleflight
+	})
+}
+
+// WithNilOnNotFound sets whether providers return nil without an error, rather than an error
+// that matches api.ErrNotFound, when the requested item does not exist.  This preserves the
+// behavior of earlier releases, and is intended to ease migration.
+func WithNilOnNotFound(nilOnNotFound bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nilOnNotFound = nilOnNotFound
+	})
+}
+
+// WithAllowUnknownVersions sets whether responses containing data of a version that is not
+// known to the client, for example from a fork that post-dates this release, are passed on
+// to the caller rather than failing.  If enabled, such responses result in an error of type
+// *api.UnknownVersionError that carries the version and raw data returned by the node.
+func WithAllowUnknownVersions(allowUnknownVersions bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allowUnknownVersions = allowUnknownVersions
+	})
+}
+
+// WithConfirmConnection sets whether the service confirms its connection to the node when it
+// starts, by fetching static values such as the genesis and spec.  This should be disabled when
+// connecting to an endpoint that is not a beacon node, such as a builder relay.
+func WithConfirmConnection(confirmConnection bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.confirmConnection = confirmConnection
+	})
+}
+
+// WithContentNegotiation sets how the content type of responses is negotiated with the node.
+// By default SSZ is preferred, with JSON accepted if SSZ is unavailable.
+func WithContentNegotiation(contentNegotiation ContentNegotiation) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.contentNegotiation = contentNegotiation
+	})
+}
+
+// WithEndpointAccept sets the Accept header sent to specific endpoints, overriding the
+// content negotiation.  The map is keyed by endpoint path prefix, for example
+// "/eth/v2/debug/beacon/states", with the longest matching prefix being used.
+func WithEndpointAccept(endpointAccept map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpointAccept = endpointAccept
+	})
+}
+
+// WithInterceptor adds an interceptor through which all requests to the endpoint pass.
+// This can be supplied multiple times, in which case the first interceptor supplied is
+// the first to see each request.
+func WithInterceptor(interceptor Interceptor) Parameter {
+	return parameterFunc(func(p *parameters) {
+		if interceptor != nil {
+			p.interceptors = append(p.interceptors, interceptor)
+		}
+	})
+}
+
+// WithResponseHook sets a function that is called on completion of each request to the
+// endpoint, providing information such as the status code and duration of the request.
+// The hook is not called for the events stream.
+func WithResponseHook(hook ResponseHookFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.responseHook = hook
+	})
+}
+
+// WithEventsReconnectDelay sets the delay before reconnecting a dropped events stream.
+// The delay doubles with each consecutive failed reconnection, up to the supplied maximum,
+// and is reset once the stream is connected again.
+func WithEventsReconnectDelay(delay time.Duration, maxDelay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsReconnectDelay = delay
+		p.eventsReconnectMaxDelay = maxDelay
+	})
+}
+
+// WithEventsReconnectHandler sets a handler that is called each time a dropped events
+// stream is reconnected, for example to record the reconnection in metrics.
+func WithEventsReconnectHandler(handler EventsReconnectHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsReconnectHandler = handler
+	})
+}
+
+// WithEventsBuffer sets the number of events buffered for each events subscription, and
+// the policy to apply when the buffer is full.  Buffered events are passed to the handler
+// by a separate goroutine, so a slow handler does not stall the events stream unless the
+// policy is to block.
+// A size of 0, the default, passes events to the handler as they are read from the stream.
+// This can be overridden for individual subscriptions with WithSubscriptionEventsBuffer().
+func WithEventsBuffer(size int, policy EventsOverflowPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsBufferSize = size
+		p.eventsOverflowPolicy = policy
+	})
+}
+
+// WithEventsGapFill sets whether head, block and finalized checkpoint events missed while
+// a dropped events stream is reconnecting are backfilled.  If enabled, block headers between
+// the last slot seen and the current head are fetched and synthesized events are passed to
+// the handler in slot order ahead of the events from the new stream.
+func WithEventsGapFill(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsGapFill = enabled
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:        zerolog.GlobalLevel(),
-		timeout:         2 * time.Second,
-		indexChunkSize:  -1,
-		pubKeyChunkSize: -1,
-		extraHeaders:    make(map[string]string),
+		logLevel:                zerolog.GlobalLevel(),
+		timeout:                 2 * time.Second,
+		indexChunkSize:          -1,
+		pubKeyChunkSize:         -1,
+		validatorsPostThreshold: -1,
+		extraHeaders:            make(map[string]string),
+		broadcastValidation:     api.BroadcastValidationGossip,
+		compression:             true,
+		maxIdleConns:            64,
+		maxConnsPerHost:         64,
+		idleConnTimeout:         600 * time.Second,
+		tlsHandshakeTimeout:     10 * time.Second,
+		confirmConnection:       true,
+		eventsReconnectDelay:    time.Second,
+		eventsReconnectMaxDelay: 30 * time.Second,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -97,7 +489,7 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		}
 	}
 
-	if parameters.address == "" {
+	if parameters.address == "" && parameters.addressProvider == nil {
 		return nil, errors.New("no address specified")
 	}
 	if parameters.timeout == 0 {
@@ -106,9 +498,57 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.indexChunkSize == 0 {
 		return nil, errors.New("no index chunk size specified")
 	}
+	if parameters.circuitBreakerThreshold < 0 {
+		return nil, errors.New("circuit breaker threshold cannot be negative")
+	}
+	if parameters.circuitBreakerThreshold > 0 && parameters.circuitBreakerCooldown <= 0 {
+		return nil, errors.New("no circuit breaker cooldown specified")
+	}
+	if parameters.maxResponseSize < 0 {
+		return nil, errors.New("max response size cannot be negative")
+	}
+	if parameters.maxIdleConns < 0 {
+		return nil, errors.New("max idle connections cannot be negative")
+	}
+	if parameters.maxConnsPerHost < 0 {
+		return nil, errors.New("max connections per host cannot be negative")
+	}
+	if parameters.bearerToken != "" && parameters.tokenProvider != nil {
+		return nil, errors.New("cannot specify both bearer token and token provider")
+	}
+	if (len(parameters.clientCert) == 0) != (len(parameters.clientKey) == 0) {
+		return nil, errors.New("client certificate and key must be supplied together")
+	}
+	if parameters.requestIDFunc != nil && parameters.requestIDHeader == "" {
+		return nil, errors.New("no request ID header specified")
+	}
+	if parameters.connectionRefreshInterval < 0 {
+		return nil, errors.New("connection refresh interval cannot be negative")
+	}
+	if parameters.connectionRefreshFailures < 0 {
+		return nil, errors.New("connection refresh failures cannot be negative")
+	}
+	if parameters.contentNegotiation < ContentNegotiationPreferSSZ || parameters.contentNegotiation > ContentNegotiationSSZOnly {
+		return nil, errors.New("invalid content negotiation")
+	}
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.validatorsPostThreshold == 0 {
+		return nil, errors.New("no validators POST threshold specified")
+	}
+	if parameters.eventsReconnectDelay <= 0 {
+		return nil, errors.New("no events reconnect delay specified")
+	}
+	if parameters.eventsReconnectMaxDelay < parameters.eventsReconnectDelay {
+		return nil, errors.New("events reconnect maximum delay cannot be less than delay")
+	}
+	if parameters.eventsBufferSize < 0 {
+		return nil, errors.New("events buffer size cannot be negative")
+	}
+	if parameters.eventsOverflowPolicy < EventsOverflowBlock || parameters.eventsOverflowPolicy > EventsOverflowDropNewest {
+		return nil, errors.New("invalid events overflow policy")
+	}
 
 	return &parameters, nil
 }
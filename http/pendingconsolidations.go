@@ -0,0 +1,52 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/pkg/errors"
+)
+
+type pendingConsolidationsJSON struct {
+	Data []*electra.PendingConsolidation `json:"data"`
+}
+
+// PendingConsolidations fetches the pending consolidations given a state ID.
+func (s *Service) PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/pending_consolidations", stateID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request pending consolidations")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var pendingConsolidationsJSON pendingConsolidationsJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&pendingConsolidationsJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse pending consolidations")
+	}
+	if pendingConsolidationsJSON.Data == nil {
+		return nil, errors.New("no pending consolidations returned")
+	}
+
+	return pendingConsolidationsJSON.Data, nil
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingConsolidations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/beacon/states/head/pending_consolidations":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":false,"data":[{"source_index":"1","target_index":"2"},{"source_index":"3","target_index":"4"}]}`))
+		case "/eth/v1/beacon/states/finalized/pending_consolidations":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"execution_optimistic":false,"finalized":true,"data":[]}`))
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		stateID  string
+		expected []*electra.PendingConsolidation
+		err      string
+	}{
+		{
+			name: "StateIDMissing",
+			err:  "no state ID specified",
+		},
+		{
+			name:    "Good",
+			stateID: "head",
+			expected: []*electra.PendingConsolidation{
+				{
+					SourceIndex: 1,
+					TargetIndex: 2,
+				},
+				{
+					SourceIndex: 3,
+					TargetIndex: 4,
+				},
+			},
+		},
+		{
+			name:     "Empty",
+			stateID:  "finalized",
+			expected: []*electra.PendingConsolidation{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pendingConsolidations, err := service.(client.PendingConsolidationsProvider).PendingConsolidations(ctx, test.stateID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, pendingConsolidations)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// proposalMetadataJSON contains the metadata of a JSON proposal response, used
+// if the node does not supply the equivalent headers.
+type proposalMetadataJSON struct {
+	Version                 *spec.DataVersion `json:"version"`
+	ExecutionPayloadBlinded *bool             `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string            `json:"execution_payload_value"`
+	ConsensusBlockValue     string            `json:"consensus_block_value"`
+}
+
+// Proposal fetches a proposal for signing, which may be blinded or unblinded according
+// to the node's choice of execution payload.
+// builderBoostFactor is a percentage multiplier applied to the builder's payload value when
+// the node compares it with the value of its local payload; 0 requests the local payload
+// and 100 compares the values unaltered.
+func (s *Service) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+	builderBoostFactor uint64,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "Proposal")
+	defer span.End()
+
+	// Graffiti should be 32 bytes.
+	var fixedGraffiti [32]byte
+	copy(fixedGraffiti[:], graffiti)
+
+	url := fmt.Sprintf("/eth/v3/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x&builder_boost_factor=%d",
+		slot, randaoReveal, fixedGraffiti, builderBoostFactor)
+	stream, err := s.getStream(ctx, url, "")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to request proposal")
+		return nil, errors.Wrap(err, "failed to request proposal")
+	}
+	defer stream.Close()
+	if stream.statusCode == http.StatusNotFound {
+		span.SetStatus(codes.Error, "Client returned 404")
+		return nil, nil
+	}
+	body, err := stream.readAll()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read proposal")
+	}
+	if len(body) == 0 {
+		return nil, errors.New("no proposal returned")
+	}
+
+	proposal, err := s.proposalFromResponse(stream, body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to decode body")
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	proposalSlot, err := proposal.Slot()
+	if err != nil {
+		return nil, err
+	}
+	if proposalSlot != slot {
+		span.SetStatus(codes.Error, fmt.Sprintf("Proposal slot %d; expected %d", proposalSlot, slot))
+		return nil, errors.New("proposal not for requested slot")
+	}
+
+	// Only check the RANDAO reveal and graffiti if we are not connected to DVT middleware,
+	// as the returned values will be decided by the middleware.
+	if !s.connectedToDVTMiddleware {
+		proposalRandaoReveal, err := proposal.RandaoReveal()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(proposalRandaoReveal[:], randaoReveal[:]) {
+			return nil, fmt.Errorf("proposal has RANDAO reveal %#x; expected %#x", proposalRandaoReveal[:], randaoReveal[:])
+		}
+
+		proposalGraffiti, err := proposal.Graffiti()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(proposalGraffiti[:], fixedGraffiti[:]) {
+			return nil, fmt.Errorf("proposal has graffiti %#x; expected %#x", proposalGraffiti[:], fixedGraffiti[:])
+		}
+	}
+
+	return proposal, nil
+}
+
+// proposalFromResponse decodes a proposal from the response.  The version, blinded
+// indicator and values are taken from the response headers if supplied, otherwise
+// from the body for JSON responses.
+func (s *Service) proposalFromResponse(stream *httpStreamResponse, body []byte) (*api.VersionedProposal, error) {
+	proposal := &api.VersionedProposal{
+		Version: stream.consensusVersion,
+	}
+
+	blindedKnown := false
+	if blinded, err := strconv.ParseBool(stream.resp.Header.Get("Eth-Execution-Payload-Blinded")); err == nil {
+		proposal.Blinded = blinded
+		blindedKnown = true
+	}
+	if value, ok := new(big.Int).SetString(stream.resp.Header.Get("Eth-Execution-Payload-Value"), 10); ok {
+		proposal.ExecutionValue = value
+	}
+	if value, ok := new(big.Int).SetString(stream.resp.Header.Get("Eth-Consensus-Block-Value"), 10); ok {
+		proposal.ConsensusValue = value
+	}
+
+	if stream.contentType == ContentTypeJSON {
+		var metadata proposalMetadataJSON
+		if err := json.Unmarshal(body, &metadata); err != nil {
+			return nil, errors.Wrap(err, "failed to parse proposal")
+		}
+		if proposal.Version == spec.DataVersionUnknown && metadata.Version != nil {
+			proposal.Version = *metadata.Version
+		}
+		if !blindedKnown && metadata.ExecutionPayloadBlinded != nil {
+			proposal.Blinded = *metadata.ExecutionPayloadBlinded
+			blindedKnown = true
+		}
+		if value, ok := new(big.Int).SetString(metadata.ExecutionPayloadValue, 10); ok && proposal.ExecutionValue == nil {
+			proposal.ExecutionValue = value
+		}
+		if value, ok := new(big.Int).SetString(metadata.ConsensusBlockValue, 10); ok && proposal.ConsensusValue == nil {
+			proposal.ConsensusValue = value
+		}
+	}
+	if !blindedKnown {
+		return nil, errors.New("proposal does not state if it is blinded")
+	}
+
+	res := &httpResponse{
+		statusCode:       stream.statusCode,
+		contentType:      stream.contentType,
+		consensusVersion: proposal.Version,
+		body:             body,
+	}
+
+	if proposal.Blinded {
+		var block *api.VersionedBlindedBeaconBlock
+		var err error
+		switch res.contentType {
+		case ContentTypeSSZ:
+			block, err = s.blindedBeaconBlockProposalFromSSZ(res)
+		case ContentTypeJSON:
+			block, err = s.blindedBeaconBlockProposalFromJSON(res)
+		default:
+			return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		proposal.BellatrixBlinded = block.Bellatrix
+		proposal.CapellaBlinded = block.Capella
+		proposal.DenebBlinded = block.Deneb
+		proposal.ElectraBlinded = block.Electra
+
+		return proposal, nil
+	}
+
+	var block *spec.VersionedBeaconBlock
+	var err error
+	switch res.contentType {
+	case ContentTypeSSZ:
+		block, err = s.beaconBlockProposalFromSSZ(res)
+	case ContentTypeJSON:
+		block, err = s.beaconBlockProposalFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	proposal.Phase0 = block.Phase0
+	proposal.Altair = block.Altair
+	proposal.Bellatrix = block.Bellatrix
+	proposal.Capella = block.Capella
+	proposal.Deneb = block.Deneb
+	proposal.Electra = block.Electra
+
+	return proposal, nil
+}
@@ -0,0 +1,198 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slot := phase0.Slot(100)
+	randaoReveal := phase0.BLSSignature{0x01}
+	graffiti := []byte("test")
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	block, err := mockClient.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	require.NoError(t, err)
+	blindedBlock, err := mockClient.BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	require.NoError(t, err)
+
+	blockJSON, err := json.Marshal(block.Phase0)
+	require.NoError(t, err)
+	blockSSZ, err := block.Phase0.MarshalSSZ()
+	require.NoError(t, err)
+	blindedBlockJSON, err := json.Marshal(blindedBlock.Bellatrix)
+	require.NoError(t, err)
+	blindedBlockSSZ, err := blindedBlock.Bellatrix.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		version         string
+		blinded         string
+		contentType     string
+		data            []byte
+		body            string
+		err             string
+		expectedBlinded bool
+	}{
+		{
+			name:        "JSONUnblinded",
+			contentType: "application/json",
+			body:        fmt.Sprintf(`{"version":"phase0","execution_payload_blinded":false,"execution_payload_value":"12345","consensus_block_value":"678","data":%s}`, string(blockJSON)),
+		},
+		{
+			name:            "JSONBlinded",
+			contentType:     "application/json",
+			body:            fmt.Sprintf(`{"version":"bellatrix","execution_payload_blinded":true,"execution_payload_value":"12345","consensus_block_value":"678","data":%s}`, string(blindedBlockJSON)),
+			expectedBlinded: true,
+		},
+		{
+			name:        "JSONBlindedMissing",
+			contentType: "application/json",
+			body:        fmt.Sprintf(`{"version":"phase0","execution_payload_value":"12345","consensus_block_value":"678","data":%s}`, string(blockJSON)),
+			err:         "proposal does not state if it is blinded",
+		},
+		{
+			name:        "SSZUnblinded",
+			version:     "phase0",
+			blinded:     "false",
+			contentType: "application/octet-stream",
+			data:        blockSSZ,
+		},
+		{
+			name:            "SSZBlinded",
+			version:         "bellatrix",
+			blinded:         "true",
+			contentType:     "application/octet-stream",
+			data:            blindedBlockSSZ,
+			expectedBlinded: true,
+		},
+		{
+			name:        "SSZBlindedMissing",
+			version:     "phase0",
+			contentType: "application/octet-stream",
+			data:        blockSSZ,
+			err:         "proposal does not state if it is blinded",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v3/validator/blocks/100" || r.URL.Query().Get("builder_boost_factor") != "50" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				if test.version != "" {
+					w.Header().Set("Eth-Consensus-Version", test.version)
+				}
+				if test.blinded != "" {
+					w.Header().Set("Eth-Execution-Payload-Blinded", test.blinded)
+				}
+				if test.data != nil {
+					w.Header().Set("Eth-Execution-Payload-Value", "12345")
+					w.Header().Set("Eth-Consensus-Block-Value", "678")
+					_, _ = w.Write(test.data)
+				} else {
+					_, _ = w.Write([]byte(test.body))
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			proposal, err := service.(client.ProposalProvider).Proposal(ctx, slot, randaoReveal, graffiti, 50)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedBlinded, proposal.Blinded)
+			require.Equal(t, big.NewInt(12345), proposal.ExecutionValue)
+			require.Equal(t, big.NewInt(678), proposal.ConsensusValue)
+			proposalSlot, err := proposal.Slot()
+			require.NoError(t, err)
+			require.Equal(t, slot, proposalSlot)
+			if test.expectedBlinded {
+				require.Equal(t, spec.DataVersionBellatrix, proposal.Version)
+				_, err := proposal.BlindedBeaconBlock()
+				require.NoError(t, err)
+				_, err = proposal.BeaconBlock()
+				require.EqualError(t, err, "proposal is blinded")
+			} else {
+				require.Equal(t, spec.DataVersionPhase0, proposal.Version)
+				_, err := proposal.BeaconBlock()
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProposalElectra(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slot := phase0.Slot(100)
+	randaoReveal := phase0.BLSSignature{0x01}
+	graffiti := []byte("test")
+
+	blockSSZ, err := testElectraBeaconBlock(slot, randaoReveal, graffiti).MarshalSSZ()
+	require.NoError(t, err)
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v3/validator/blocks/100" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Eth-Consensus-Version", "electra")
+		w.Header().Set("Eth-Execution-Payload-Blinded", "false")
+		_, _ = w.Write(blockSSZ)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	proposal, err := service.(client.ProposalProvider).Proposal(ctx, slot, randaoReveal, graffiti, 100)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionElectra, proposal.Version)
+	require.NotNil(t, proposal.Electra)
+	block, err := proposal.BeaconBlock()
+	require.NoError(t, err)
+	require.Equal(t, proposal.Electra, block.Electra)
+}
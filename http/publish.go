@@ -0,0 +1,174 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// publishEndpoints are the endpoints for publishing a type of block.
+type publishEndpoints struct {
+	v1 string
+	v2 string
+}
+
+var (
+	blockPublishEndpoints = publishEndpoints{
+		v1: "/eth/v1/beacon/blocks",
+		v2: "/eth/v2/beacon/blocks",
+	}
+	blindedBlockPublishEndpoints = publishEndpoints{
+		v1: "/eth/v1/beacon/blinded_blocks",
+		v2: "/eth/v2/beacon/blinded_blocks",
+	}
+)
+
+// sszMarshaler is a type that can be encoded as SSZ.
+type sszMarshaler interface {
+	MarshalSSZTo(buf []byte) ([]byte, error)
+	SizeSSZ() int
+}
+
+// publishBlock publishes a block.  The block is encoded as SSZ if SSZ submissions are
+// enabled and the node supports them, otherwise as JSON.  If the node rejects the SSZ
+// encoding the block is resubmitted as JSON, and the service remembers this for future
+// submissions.  Both encodings use pooled buffers.
+func (s *Service) publishBlock(ctx context.Context,
+	endpoints publishEndpoints,
+	version spec.DataVersion,
+	block sszMarshaler,
+) error {
+	if s.sszSubmissionsEnabled() {
+		data, release, err := codecs.MarshalSSZPooled(block)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal SSZ")
+		}
+		body := newRequestBody(data, release)
+		err = s.publish(ctx, endpoints, version, body, ContentTypeSSZ)
+		body.done()
+		var apiErr Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnsupportedMediaType {
+			return err
+		}
+		s.log.Debug().Msg("Node does not support SSZ submissions; falling back to JSON")
+		s.sszSubmissionsMutex.Lock()
+		s.sszSubmissionsUnsupported = true
+		s.sszSubmissionsMutex.Unlock()
+	}
+
+	data, release, err := codecs.MarshalJSONPooled(block)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	body := newRequestBody(data, release)
+	defer body.done()
+
+	return s.publish(ctx, endpoints, version, body, ContentTypeJSON)
+}
+
+// sszSubmissionsEnabled returns true if submissions should be encoded as SSZ.
+func (s *Service) sszSubmissionsEnabled() bool {
+	if !s.sszSubmissions {
+		return false
+	}
+	s.sszSubmissionsMutex.RLock()
+	defer s.sszSubmissionsMutex.RUnlock()
+
+	return !s.sszSubmissionsUnsupported
+}
+
+// publish publishes a block, selecting the endpoint according to the capabilities of the node.
+// The v2 endpoint is used if the node supports it, allowing the broadcast validation level to
+// be specified, otherwise the v1 endpoint is used.  If support for the v2 endpoint cannot be
+// established it is attempted, and the v1 endpoint used if the node does not have it.
+// If the node broadcasts the block but it fails validation an error matching
+// api.ErrBroadcastValidationFailed is returned.
+func (s *Service) publish(ctx context.Context,
+	endpoints publishEndpoints,
+	version spec.DataVersion,
+	body *requestBody,
+	contentType ContentType,
+) error {
+	headers := make(map[string]string)
+	// The consensus version is mandatory for the v2 endpoints and for SSZ submissions,
+	// as the server cannot otherwise know how to decode the body.
+	headers["Eth-Consensus-Version"] = version.String()
+
+	v2Supported, probeErr := s.postEndpointSupported(ctx, endpoints.v2)
+	if probeErr != nil {
+		// Publication is time-critical, so rather than fail the submission the v2
+		// endpoint is attempted, and its response used to establish support.
+		s.log.Debug().Err(probeErr).Msg("Failed to establish support for v2 publish endpoint; attempting it")
+		v2Supported = true
+	}
+	if v2Supported {
+		endpoint := fmt.Sprintf("%s?broadcast_validation=%s", endpoints.v2, s.broadcastValidation.String())
+		res, err := s.sendBody(ctx, http.MethodPost, endpoint, body, contentType, headers)
+		switch {
+		case err == nil:
+			if probeErr != nil {
+				s.setEndpointSupported(endpoints.v2, true)
+			}
+
+			return s.checkPublishResponse(endpoint, res)
+		case probeErr == nil || !endpointMissing(err):
+			return err
+		}
+		s.log.Debug().Msg("Node does not support v2 publish endpoint; falling back to v1")
+		s.setEndpointSupported(endpoints.v2, false)
+	}
+
+	if contentType == ContentTypeJSON {
+		// The v1 endpoints do not require the consensus version for JSON submissions.
+		delete(headers, "Eth-Consensus-Version")
+	}
+	res, err := s.sendBody(ctx, http.MethodPost, endpoints.v1, body, contentType, headers)
+	if err != nil {
+		return err
+	}
+
+	return s.checkPublishResponse(endpoints.v1, res)
+}
+
+// checkPublishResponse checks the response to a successful publish request.  A
+// 202 response means that the block was broadcast but failed validation, which
+// is returned as an error so that the caller can distinguish it from success.
+func (s *Service) checkPublishResponse(endpoint string, res *httpResponse) error {
+	if res.statusCode != http.StatusAccepted {
+		return nil
+	}
+
+	return newError(http.MethodPost, endpoint, res.statusCode, res.body, res.requestID, s.redactor)
+}
+
+// endpointMissing returns true if the error is a response stating that the
+// endpoint is not present on the node.
+func endpointMissing(err error) bool {
+	var apiErr Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	default:
+		return false
+	}
+}
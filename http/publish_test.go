@@ -0,0 +1,297 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishEndpointSelection(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	probeTimeout := 200 * time.Millisecond
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	proposal, err := mockClient.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: proposal.Phase0,
+		},
+	}
+
+	tests := []struct {
+		name                string
+		v2Supported         bool
+		probeFails          bool
+		broadcastValidation api.BroadcastValidation
+		expected            []string
+	}{
+		{
+			name:                "V2",
+			v2Supported:         true,
+			broadcastValidation: api.BroadcastValidationConsensus,
+			expected: []string{
+				"/eth/v2/beacon/blocks?broadcast_validation=consensus phase0",
+				"/eth/v2/beacon/blocks?broadcast_validation=consensus phase0",
+			},
+		},
+		{
+			name:                "V1",
+			broadcastValidation: api.BroadcastValidationGossip,
+			expected: []string{
+				"/eth/v1/beacon/blocks ",
+				"/eth/v1/beacon/blocks ",
+			},
+		},
+		{
+			name:                "ProbeFailsV2",
+			v2Supported:         true,
+			probeFails:          true,
+			broadcastValidation: api.BroadcastValidationConsensus,
+			expected: []string{
+				"/eth/v2/beacon/blocks?broadcast_validation=consensus phase0",
+				"/eth/v2/beacon/blocks?broadcast_validation=consensus phase0",
+			},
+		},
+		{
+			name:                "ProbeFailsV1",
+			probeFails:          true,
+			broadcastValidation: api.BroadcastValidationGossip,
+			expected: []string{
+				"/eth/v2/beacon/blocks?broadcast_validation=gossip phase0",
+				"/eth/v1/beacon/blocks ",
+				"/eth/v1/beacon/blocks ",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requestsMu sync.Mutex
+			requests := make([]string, 0)
+			probes := 0
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				_, _ = io.ReadAll(r.Body)
+				requestsMu.Lock()
+				if r.Method == nethttp.MethodGet {
+					probes++
+				} else {
+					requests = append(requests, r.URL.RequestURI()+" "+r.Header.Get("Eth-Consensus-Version"))
+				}
+				requestsMu.Unlock()
+				if test.probeFails && r.Method == nethttp.MethodGet {
+					// Respond after the probe has timed out.
+					time.Sleep(2 * probeTimeout)
+				}
+				if r.URL.Path == "/eth/v2/beacon/blocks" {
+					publishV2Handler(w, r, test.v2Supported)
+					return
+				}
+				w.WriteHeader(nethttp.StatusOK)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(probeTimeout),
+				http.WithBroadcastValidation(test.broadcastValidation),
+			)
+			require.NoError(t, err)
+
+			// Submit twice to ensure that the support for the v2 endpoint is probed once.
+			require.NoError(t, service.(*http.Service).SubmitBeaconBlock(ctx, block))
+			require.NoError(t, service.(*http.Service).SubmitBeaconBlock(ctx, block))
+
+			requestsMu.Lock()
+			defer requestsMu.Unlock()
+			require.Equal(t, test.expected, requests)
+			require.Equal(t, 1, probes)
+		})
+	}
+}
+
+func TestPublishBroadcastValidationFailed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	proposal, err := mockClient.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: proposal.Phase0,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		v2Supported bool
+		endpoint    string
+	}{
+		{
+			name:        "V2",
+			v2Supported: true,
+			endpoint:    "/eth/v2/beacon/blocks?broadcast_validation=consensus_and_equivocation",
+		},
+		{
+			name:     "V1",
+			endpoint: "/eth/v1/beacon/blocks",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				_, _ = io.ReadAll(r.Body)
+				if r.URL.Path == "/eth/v2/beacon/blocks" && (r.Method == nethttp.MethodGet || !test.v2Supported) {
+					publishV2Handler(w, r, test.v2Supported)
+					return
+				}
+				w.WriteHeader(nethttp.StatusAccepted)
+				_, _ = w.Write([]byte(`{"code":202,"message":"block failed validation"}`))
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithBroadcastValidation(api.BroadcastValidationConsensusAndEquivocation),
+				http.WithRequestIDHeader("X-Request-ID", func(context.Context) string { return "abc123" }),
+			)
+			require.NoError(t, err)
+
+			err = service.(*http.Service).SubmitBeaconBlock(ctx, block)
+			require.ErrorIs(t, err, api.ErrBroadcastValidationFailed)
+			require.False(t, api.IsRetryable(err))
+			var httpErr http.Error
+			require.True(t, errors.As(err, &httpErr))
+			require.Equal(t, test.endpoint, httpErr.Endpoint)
+			require.Equal(t, "block failed validation", httpErr.Message)
+			require.Equal(t, "abc123", httpErr.RequestID)
+		})
+	}
+}
+
+func TestPublishSSZ(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	proposal, err := mockClient.BeaconBlockProposal(ctx, 1, phase0.BLSSignature{}, nil)
+	require.NoError(t, err)
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: proposal.Phase0,
+		},
+	}
+	expectedSSZ, err := block.Phase0.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		sszSubmissions bool
+		sszSupported   bool
+		expected       []string
+	}{
+		{
+			name:     "Disabled",
+			expected: []string{"application/json", "application/json"},
+		},
+		{
+			name:           "Enabled",
+			sszSubmissions: true,
+			sszSupported:   true,
+			expected:       []string{"application/octet-stream", "application/octet-stream"},
+		},
+		{
+			name:           "Fallback",
+			sszSubmissions: true,
+			expected:       []string{"application/octet-stream", "application/json", "application/json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requestsMu sync.Mutex
+			requests := make([]string, 0)
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.Method == nethttp.MethodGet {
+					// Probe for the v2 endpoint.
+					publishV2Handler(w, r, true)
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				contentType := r.Header.Get("Content-Type")
+				requestsMu.Lock()
+				requests = append(requests, contentType)
+				requestsMu.Unlock()
+				if contentType == "application/octet-stream" {
+					if !test.sszSupported {
+						w.WriteHeader(nethttp.StatusUnsupportedMediaType)
+						return
+					}
+					if string(body) != string(expectedSSZ) {
+						w.WriteHeader(nethttp.StatusBadRequest)
+						return
+					}
+				}
+				w.WriteHeader(nethttp.StatusOK)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithSSZSubmissions(test.sszSubmissions),
+			)
+			require.NoError(t, err)
+
+			// Submit twice to ensure that the fallback is remembered.
+			require.NoError(t, service.(*http.Service).SubmitBeaconBlock(ctx, block))
+			require.NoError(t, service.(*http.Service).SubmitBeaconBlock(ctx, block))
+
+			requestsMu.Lock()
+			defer requestsMu.Unlock()
+			require.Equal(t, test.expected, requests)
+		})
+	}
+}
+
+// publishV2Handler handles requests to the v2 publish endpoint for a node that does
+// or does not support it.  A node that supports the endpoint refuses GET requests.
+func publishV2Handler(w nethttp.ResponseWriter, r *nethttp.Request, supported bool) {
+	switch {
+	case !supported:
+		w.WriteHeader(nethttp.StatusNotFound)
+	case r.Method == nethttp.MethodGet:
+		w.WriteHeader(nethttp.StatusMethodNotAllowed)
+	default:
+		w.WriteHeader(nethttp.StatusOK)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// RawCall calls the given endpoint on the node, returning the response regardless
+// of its status code.  An error is returned only if the call could not be made.
+func (s *Service) RawCall(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	opts *api.RawCallOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	if method == "" {
+		return nil, errors.New("no method specified")
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		endpoint = fmt.Sprintf("/%s", endpoint)
+	}
+	if opts == nil {
+		opts = &api.RawCallOpts{}
+	}
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Str("method", method).Str("endpoint", endpoint).Logger()
+	requestID := s.requestID(ctx)
+	if requestID != "" {
+		log = log.With().Str("request_id", requestID).Logger()
+	}
+	if e := log.Trace(); e.Enabled() {
+		e.Str("body", string(body)).Msg("Raw request")
+	}
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	opCtx, cancel := s.opContext(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	s.addExtraHeaders(req)
+	s.setRequestID(req, requestID)
+	if err := s.setAuthorization(req); err != nil {
+		return nil, err
+	}
+	if body != nil {
+		contentType := opts.ContentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	accept := opts.Accept
+	if accept == "" {
+		accept = "application/json"
+	}
+	req.Header.Set("Accept", accept)
+	for k, v := range opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(s.redactor.Error(err), "failed to call endpoint")
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(s.limitResponseBody(resp.Body, endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Str("response", string(data)).Msg("Raw response")
+
+	res := &api.RawResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Body:       data,
+	}
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		res.ContentType = mediaType
+	}
+	// The consensus version is informational for raw calls, so failure to obtain it is not an error.
+	versionRes := &httpResponse{
+		body: data,
+	}
+	if res.ContentType == ContentTypeJSON.MediaType() {
+		versionRes.contentType = ContentTypeJSON
+	}
+	if err := populateConsensusVersion(versionRes, resp); err == nil {
+		res.ConsensusVersion = versionRes.consensusVersion
+	}
+
+	return res, nil
+}
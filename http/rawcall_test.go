@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	v1 "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawCall(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name       string
+		method     string
+		endpoint   string
+		body       []byte
+		opts       *api.RawCallOpts
+		statusCode int
+		err        string
+	}{
+		{
+			name:     "MethodMissing",
+			endpoint: "/eth/v1/node/version",
+			err:      "no method specified",
+		},
+		{
+			name:       "Good",
+			method:     http.MethodGet,
+			endpoint:   "/eth/v1/node/version",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "NoLeadingSlash",
+			method:     http.MethodGet,
+			endpoint:   "eth/v1/node/version",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "NotFound",
+			method:     http.MethodGet,
+			endpoint:   "/eth/v1/does/not/exist",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:     "Post",
+			method:   http.MethodPost,
+			endpoint: "/eth/v1/beacon/states/head/validators",
+			body:     []byte(`{"ids":["0"]}`),
+			opts: &api.RawCallOpts{
+				Headers: map[string]string{"X-Test": "test"},
+			},
+			statusCode: http.StatusOK,
+		},
+	}
+
+	service, err := v1.New(ctx,
+		v1.WithTimeout(timeout),
+		v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.(client.RawCallProvider).RawCall(ctx, test.method, test.endpoint, test.body, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.statusCode, res.StatusCode)
+			if res.StatusCode == http.StatusOK {
+				require.True(t, json.Valid(res.Body))
+			}
+		})
+	}
+}
+
+func TestRawCallResponse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var receivedBody []byte
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v9/new/endpoint":
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write([]byte(`{"version":"deneb","data":{"value":"1"}}`))
+		case "/eth/v9/new/submission":
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.Header().Set("Eth-Consensus-Version", "capella")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	service, err := v1.New(ctx,
+		v1.WithTimeout(timeout),
+		v1.WithAddress(srv.URL),
+	)
+	require.NoError(t, err)
+	provider := service.(client.RawCallProvider)
+
+	res, err := provider.RawCall(ctx, http.MethodGet, "/eth/v9/new/endpoint", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "application/json", res.ContentType)
+	require.Equal(t, spec.DataVersionDeneb, res.ConsensusVersion)
+	require.Equal(t, `{"version":"deneb","data":{"value":"1"}}`, string(res.Body))
+
+	res, err = provider.RawCall(ctx, http.MethodPost, "eth/v9/new/submission", []byte(`{"a":"b"}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	require.Equal(t, spec.DataVersionCapella, res.ConsensusVersion)
+	require.Equal(t, `{"a":"b"}`, string(receivedBody))
+
+	res, err = provider.RawCall(ctx, http.MethodGet, "/eth/v9/missing", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+	require.Equal(t, spec.DataVersionUnknown, res.ConsensusVersion)
+}
@@ -0,0 +1,253 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// redacted is the string that replaces sensitive information.
+const redacted = "xxxxx"
+
+// urlRegexp matches URLs within text.
+var urlRegexp = regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s"'<>]+`)
+
+// credentialHeaderFragments are the fragments of header names that mark them as carrying credentials.
+var credentialHeaderFragments = []string{"auth", "token", "key", "secret", "password", "cookie", "session"}
+
+// redactedError is an error with sensitive information removed from its message.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the underlying error.
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactor removes sensitive values from logs and errors.
+//
+// Userinfo and the values of the query parameters of the address are redacted from URLs
+// by key, and the values of credential-bearing headers and bearer tokens are redacted
+// wherever they appear.  A nil redactor leaves its input untouched.
+type redactor struct {
+	extraHeaders map[string]string
+	bearerToken  string
+
+	mu sync.RWMutex
+	// queryKeys are the keys of the query parameters of the address.
+	queryKeys map[string]struct{}
+	// userinfo are the username and password of the address.
+	userinfo []string
+	// token is the most recent token obtained from the token provider.
+	token string
+	// values are the credentials to redact wherever they appear, longest first.
+	values []string
+}
+
+// newRedactor creates a redactor for the credentials in the address, extra headers and
+// bearer token that should not be exposed in logs or errors.
+func newRedactor(address string, extraHeaders map[string]string, bearerToken string) *redactor {
+	r := &redactor{
+		extraHeaders: extraHeaders,
+		bearerToken:  bearerToken,
+	}
+	r.setAddress(address)
+
+	return r
+}
+
+// setAddress updates the redactor for a new address.
+func (r *redactor) setAddress(address string) {
+	if r == nil {
+		return
+	}
+
+	queryKeys := make(map[string]struct{})
+	userinfo := make([]string, 0)
+	if base, err := url.Parse(address); err == nil {
+		if base.User != nil {
+			if password, isSet := base.User.Password(); isSet && password != "" {
+				userinfo = append(userinfo, password)
+			}
+		}
+		for key := range base.Query() {
+			queryKeys[key] = struct{}{}
+		}
+	}
+
+	r.mu.Lock()
+	r.queryKeys = queryKeys
+	r.userinfo = userinfo
+	r.rebuild()
+	r.mu.Unlock()
+}
+
+// setToken updates the redactor for a token obtained from the token provider.
+func (r *redactor) setToken(token string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	unchanged := token == r.token
+	r.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	r.mu.Lock()
+	r.token = token
+	r.rebuild()
+	r.mu.Unlock()
+}
+
+// rebuild rebuilds the values to redact.
+// This must be called with the lock held.
+func (r *redactor) rebuild() {
+	values := append([]string{}, r.userinfo...)
+	for k, v := range r.extraHeaders {
+		if v == "" || !isCredentialHeader(k) {
+			continue
+		}
+		values = append(values, v)
+		// Also redact the credentials portion of authorization-style headers.
+		if parts := strings.SplitN(v, " ", 2); len(parts) == 2 && parts[1] != "" {
+			values = append(values, parts[1])
+		}
+	}
+	if r.bearerToken != "" {
+		values = append(values, r.bearerToken)
+	}
+	if r.token != "" {
+		values = append(values, r.token)
+	}
+
+	// Replace longer values first, in case one value contains another.
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+	r.values = values
+}
+
+// isCredentialHeader returns true if the header with the given name carries credentials.
+func isCredentialHeader(name string) bool {
+	name = strings.ToLower(name)
+	for _, fragment := range credentialHeaderFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String removes sensitive information from a string.
+func (r *redactor) String(input string) string {
+	if r == nil {
+		return input
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	input = urlRegexp.ReplaceAllStringFunc(input, r.redactURL)
+	for _, value := range r.values {
+		input = strings.ReplaceAll(input, value, redacted)
+		// Sensitive values may also appear escaped, for example in URLs.
+		if escaped := url.QueryEscape(value); escaped != value {
+			input = strings.ReplaceAll(input, escaped, redacted)
+		}
+	}
+
+	return input
+}
+
+// redactURL removes the userinfo and the values of sensitive query parameters from a URL.
+// This must be called with the lock held.
+func (r *redactor) redactURL(input string) string {
+	// Punctuation following a URL in text is not part of it.
+	trimmed := strings.TrimRight(input, ".,:;)]")
+	suffix := input[len(trimmed):]
+
+	u, err := url.Parse(trimmed)
+	if err != nil {
+		return input
+	}
+
+	changed := false
+	if u.User != nil {
+		if _, isSet := u.User.Password(); isSet {
+			u.User = url.UserPassword(redacted, redacted)
+		} else {
+			u.User = url.User(redacted)
+		}
+		changed = true
+	}
+	if u.RawQuery != "" && len(r.queryKeys) > 0 {
+		query := u.Query()
+		for key, values := range query {
+			if _, exists := r.queryKeys[key]; !exists {
+				continue
+			}
+			for i := range values {
+				values[i] = redacted
+			}
+			changed = true
+		}
+		if changed {
+			u.RawQuery = query.Encode()
+		}
+	}
+	if !changed {
+		return input
+	}
+
+	return u.String() + suffix
+}
+
+// Bytes removes sensitive information from a byte slice.
+func (r *redactor) Bytes(input []byte) []byte {
+	if r == nil {
+		return input
+	}
+
+	return []byte(r.String(string(input)))
+}
+
+// Error removes sensitive information from an error.
+func (r *redactor) Error(err error) error {
+	if err == nil || r == nil {
+		return err
+	}
+	msg := err.Error()
+	redactedMsg := r.String(msg)
+	if redactedMsg == msg {
+		return err
+	}
+
+	return &redactedError{
+		msg: redactedMsg,
+		err: err,
+	}
+}
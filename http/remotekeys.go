@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type remoteKeysJSON struct {
+	Data []*apiv1.RemoteKey `json:"data"`
+}
+
+type importRemoteKeyJSON struct {
+	Pubkey string `json:"pubkey"`
+	URL    string `json:"url,omitempty"`
+}
+
+type importRemoteKeysRequestJSON struct {
+	RemoteKeys []*importRemoteKeyJSON `json:"remote_keys"`
+}
+
+// RemoteKeys lists the remote signer keys of the validator client.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) RemoteKeys(ctx context.Context) ([]*apiv1.RemoteKey, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/remotekeys")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request remote keys")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain remote keys")
+	}
+
+	var resp remoteKeysJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse remote keys")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("remote keys not returned")
+	}
+
+	return resp.Data, nil
+}
+
+// ImportRemoteKeys imports keys held by remote signers in to the validator client.
+func (s *Service) ImportRemoteKeys(ctx context.Context, keys []*apiv1.RemoteKey) ([]*apiv1.KeyOperationResult, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no remote keys supplied")
+	}
+
+	req := &importRemoteKeysRequestJSON{
+		RemoteKeys: make([]*importRemoteKeyJSON, len(keys)),
+	}
+	for i := range keys {
+		if keys[i] == nil {
+			return nil, fmt.Errorf("remote key %d is nil", i)
+		}
+		req.RemoteKeys[i] = &importRemoteKeyJSON{
+			Pubkey: fmt.Sprintf("%#x", keys[i].Pubkey),
+			URL:    keys[i].URL,
+		}
+	}
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	res, err := s.post2(ctx, "/eth/v1/remotekeys", reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import remote keys")
+	}
+
+	return keyOperationResults(res, len(keys))
+}
+
+// DeleteRemoteKeys deletes the remote signer keys with the given public keys from the
+// validator client.
+func (s *Service) DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*apiv1.KeyOperationResult, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys supplied")
+	}
+
+	reqBody, err := deleteKeysRequest(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.delete2(ctx, "/eth/v1/remotekeys", reqBody, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete remote keys")
+	}
+
+	return keyOperationResults(res, len(pubKeys))
+}
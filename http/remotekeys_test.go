@@ -0,0 +1,172 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/remotekeys" || r.Method != nethttp.MethodGet {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"pubkey":"0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a","url":"https://remote.signer","readonly":false}]}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	keys, err := service.(client.RemoteKeysManager).RemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, "https://remote.signer", keys[0].URL)
+}
+
+func TestImportRemoteKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/remotekeys" || r.Method != nethttp.MethodPost {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := make(map[string][]map[string]string)
+		require.NoError(t, json.Unmarshal(body, &req))
+		results := make([]*apiv1.KeyOperationResult, len(req["remote_keys"]))
+		for i, key := range req["remote_keys"] {
+			require.Equal(t, "https://remote.signer", key["url"])
+			results[i] = &apiv1.KeyOperationResult{Status: apiv1.KeyOperationStatusImported}
+		}
+		data, err := json.Marshal(map[string]interface{}{"data": results})
+		require.NoError(t, err)
+		_, _ = w.Write(data)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		keys []*apiv1.RemoteKey
+		err  string
+	}{
+		{
+			name: "Empty",
+			err:  "no remote keys supplied",
+		},
+		{
+			name: "NilKey",
+			keys: []*apiv1.RemoteKey{nil},
+			err:  "remote key 0 is nil",
+		},
+		{
+			name: "Good",
+			keys: []*apiv1.RemoteKey{
+				{Pubkey: phase0.BLSPubKey{0x01}, URL: "https://remote.signer"},
+				{Pubkey: phase0.BLSPubKey{0x02}, URL: "https://remote.signer"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := service.(client.RemoteKeysManager).ImportRemoteKeys(ctx, test.keys)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, results, len(test.keys))
+				require.Equal(t, apiv1.KeyOperationStatusImported, results[0].Status)
+			}
+		})
+	}
+}
+
+func TestDeleteRemoteKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/remotekeys" || r.Method != nethttp.MethodDelete {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"status":"not_found"}]}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pubKeys []phase0.BLSPubKey
+		err     string
+	}{
+		{
+			name: "Empty",
+			err:  "no public keys supplied",
+		},
+		{
+			name:    "ResultsMismatch",
+			pubKeys: []phase0.BLSPubKey{{0x01}, {0x02}},
+			err:     "1 results returned for 2 keys",
+		},
+		{
+			name:    "Good",
+			pubKeys: []phase0.BLSPubKey{{0x01}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := service.(client.RemoteKeysManager).DeleteRemoteKeys(ctx, test.pubKeys)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, apiv1.KeyOperationStatusNotFound, results[0].Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// requestBody is the body of a request, which may be sent more than once.
+//
+// If the body is backed by a pooled buffer it has a release function, which is
+// called once the body is no longer in use.  The transport can continue to read
+// a request body after the request has returned, so the body is only released
+// once the caller and every reader handed to the transport have finished with it.
+type requestBody struct {
+	data    []byte
+	refs    atomic.Int32
+	release func()
+}
+
+// newRequestBody creates a new request body.  release may be nil.
+func newRequestBody(data []byte, release func()) *requestBody {
+	body := &requestBody{
+		data:    data,
+		release: release,
+	}
+	body.refs.Store(1)
+
+	return body
+}
+
+// reader returns a reader for the body, which holds on to the body until closed.
+func (b *requestBody) reader() io.ReadCloser {
+	b.refs.Add(1)
+
+	return &requestBodyReader{
+		Reader: bytes.NewReader(b.data),
+		body:   b,
+	}
+}
+
+// done is called by the creator of the body when it has finished sending requests.
+func (b *requestBody) done() {
+	if b.refs.Add(-1) == 0 && b.release != nil {
+		b.release()
+	}
+}
+
+// newBodyRequest creates a new HTTP request with the given body.
+func newBodyRequest(ctx context.Context, method string, url string, body *requestBody) (*http.Request, error) {
+	if len(body.data) == 0 {
+		return http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	}
+
+	reader := body.reader()
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		_ = reader.Close()
+
+		return nil, err
+	}
+	// The request cannot obtain these itself for a custom reader.
+	req.ContentLength = int64(len(body.data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return body.reader(), nil
+	}
+
+	return req, nil
+}
+
+// requestBodyReader reads a request body.
+type requestBodyReader struct {
+	*bytes.Reader
+	body *requestBody
+	once sync.Once
+}
+
+// Close closes the reader.
+func (r *requestBodyReader) Close() error {
+	r.once.Do(r.body.done)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestBodyRelease(t *testing.T) {
+	released := 0
+	body := newRequestBody([]byte{0x01, 0x02, 0x03}, func() { released++ })
+
+	reader1 := body.reader()
+	reader2 := body.reader()
+	data, err := io.ReadAll(reader1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02, 0x03}, data)
+
+	// The body must not be released whilst readers remain open.
+	body.done()
+	require.NoError(t, reader1.Close())
+	// Closing more than once must not drop additional references.
+	require.NoError(t, reader1.Close())
+	require.Equal(t, 0, released)
+
+	require.NoError(t, reader2.Close())
+	require.Equal(t, 1, released)
+}
+
+func TestNewBodyRequest(t *testing.T) {
+	ctx := context.Background()
+
+	released := 0
+	body := newRequestBody([]byte{0x01, 0x02, 0x03}, func() { released++ })
+	req, err := newBodyRequest(ctx, http.MethodPost, "http://localhost/", body)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), req.ContentLength)
+
+	// Obtain a second copy of the body, as the client would on a redirect.
+	reqBody, err := req.GetBody()
+	require.NoError(t, err)
+	data, err := io.ReadAll(reqBody)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02, 0x03}, data)
+
+	body.done()
+	require.NoError(t, req.Body.Close())
+	require.Equal(t, 0, released)
+	require.NoError(t, reqBody.Close())
+	require.Equal(t, 1, released)
+
+	// Empty bodies are sent without a body.
+	emptyBody := newRequestBody(nil, nil)
+	req, err = newBodyRequest(ctx, http.MethodPost, "http://localhost/", emptyBody)
+	require.NoError(t, err)
+	require.Equal(t, http.NoBody, req.Body)
+	require.Equal(t, int64(0), req.ContentLength)
+
+	// Bad requests release their reader.
+	body = newRequestBody([]byte{0x01}, func() { released++ })
+	_, err = newBodyRequest(ctx, "bad method", "http://localhost/", body)
+	require.Error(t, err)
+	body.done()
+	require.Equal(t, 2, released)
+}
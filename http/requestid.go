@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+)
+
+// requestID returns the correlation ID for a request made with the given context,
+// or an empty string if there is none.
+func (s *Service) requestID(ctx context.Context) string {
+	if s.requestIDFunc == nil {
+		return ""
+	}
+
+	return s.requestIDFunc(ctx)
+}
+
+// setRequestID sets the correlation ID header for the request, if there is an ID.
+func (s *Service) setRequestID(req *http.Request, requestID string) {
+	if requestID == "" {
+		return
+	}
+	req.Header.Set(s.requestIDHeader, requestID)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+type requestIDKey struct{}
+
+func TestRequestID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var received string
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		received = r.Header.Get("X-Request-ID")
+		w.WriteHeader(nethttp.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+	})
+
+	_, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithRequestIDHeader("", func(_ context.Context) string { return "" }),
+	)
+	require.EqualError(t, err, "problem with parameters: no request ID header specified")
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithRequestIDHeader("X-Request-ID", func(ctx context.Context) string {
+			requestID, _ := ctx.Value(requestIDKey{}).(string)
+			return requestID
+		}),
+	)
+	require.NoError(t, err)
+
+	// No ID in the context.
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.Error(t, err)
+	require.Empty(t, received)
+	var httpErr http.Error
+	require.True(t, errors.As(err, &httpErr))
+	require.Empty(t, httpErr.RequestID)
+
+	// ID in the context.
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(context.WithValue(ctx, requestIDKey{}, "abc123"))
+	require.Error(t, err)
+	require.Equal(t, "abc123", received)
+	require.True(t, errors.As(err, &httpErr))
+	require.Equal(t, "abc123", httpErr.RequestID)
+	require.Contains(t, err.Error(), "(request ID abc123)")
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HookData is the information about a request passed to a response hook.
+type HookData struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint of the request, relative to the service's address.
+	Endpoint string
+	// StatusCode is the status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time from sending the request to finishing reading the response.
+	Duration time.Duration
+	// BodySize is the size of the response body as received, before any decompression.
+	BodySize int64
+	// Err is the error if no response was received.
+	Err error
+}
+
+// ResponseHookFunc is called on completion of each request.
+type ResponseHookFunc func(ctx context.Context, data *HookData)
+
+// hookTransport is a transport that calls a response hook on completion of each request.
+type hookTransport struct {
+	next http.RoundTripper
+	hook ResponseHookFunc
+	// basePath provides the path of the service's address, to obtain endpoints from requests.
+	basePath func() string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *hookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept") == "text/event-stream" {
+		// The events stream is long-lived, so is not reported.
+		return t.next.RoundTrip(req)
+	}
+
+	data := &HookData{
+		Method:   req.Method,
+		Endpoint: t.endpoint(req),
+	}
+	started := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		data.Duration = time.Since(started)
+		data.Err = err
+		t.hook(req.Context(), data)
+
+		return resp, err
+	}
+
+	data.StatusCode = resp.StatusCode
+	// The request is complete once the body has been read and closed.
+	resp.Body = &hookBody{
+		ReadCloser: resp.Body,
+		onClose: func(bodySize int64) {
+			data.Duration = time.Since(started)
+			data.BodySize = bodySize
+			t.hook(req.Context(), data)
+		},
+	}
+
+	return resp, nil
+}
+
+// endpoint returns the endpoint of the request relative to the service's address.
+func (t *hookTransport) endpoint(req *http.Request) string {
+	endpoint := req.URL.Path
+	if t.basePath != nil {
+		endpoint = "/" + strings.TrimPrefix(strings.TrimPrefix(endpoint, strings.TrimSuffix(t.basePath(), "/")), "/")
+	}
+	if req.URL.RawQuery != "" {
+		endpoint += "?" + req.URL.RawQuery
+	}
+
+	return endpoint
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *hookTransport) CloseIdleConnections() {
+	if closer, isCloser := t.next.(interface{ CloseIdleConnections() }); isCloser {
+		closer.CloseIdleConnections()
+	}
+}
+
+// hookBody counts the bytes read from a response body, and reports them when closed.
+type hookBody struct {
+	io.ReadCloser
+	onClose func(bodySize int64)
+
+	size      int64
+	closeOnce sync.Once
+}
+
+// Read implements io.Reader.
+func (b *hookBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *hookBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.closeOnce.Do(func() {
+		b.onClose(b.size)
+	})
+
+	return err
+}
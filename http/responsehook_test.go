@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseHook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failing := false
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if failing {
+			w.WriteHeader(nethttp.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+			return
+		}
+		syncingHandler("1")(w, r)
+	})
+
+	var mu sync.Mutex
+	hooks := make([]*http.HookData, 0)
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL+"/"),
+		http.WithCompression(false),
+		http.WithResponseHook(func(_ context.Context, data *http.HookData) {
+			mu.Lock()
+			hooks = append(hooks, data)
+			mu.Unlock()
+		}),
+	)
+	require.NoError(t, err)
+
+	// Static values are fetched on startup.
+	mu.Lock()
+	require.NotEmpty(t, hooks)
+	hooks = hooks[:0]
+	mu.Unlock()
+
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	failing = true
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.Error(t, err)
+	srv.Close()
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.Error(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, hooks, 3)
+
+	require.Equal(t, nethttp.MethodGet, hooks[0].Method)
+	require.Equal(t, "/eth/v1/node/syncing", hooks[0].Endpoint)
+	require.Equal(t, nethttp.StatusOK, hooks[0].StatusCode)
+	require.Equal(t, int64(len(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`)), hooks[0].BodySize)
+	require.Positive(t, hooks[0].Duration)
+	require.NoError(t, hooks[0].Err)
+
+	require.Equal(t, nethttp.StatusInternalServerError, hooks[1].StatusCode)
+
+	require.Equal(t, 0, hooks[2].StatusCode)
+	require.Error(t, hooks[2].Err)
+	require.Equal(t, "/eth/v1/node/syncing", hooks[2].Endpoint)
+}
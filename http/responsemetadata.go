@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// recordResponseMetadata populates the response metadata requested by the context,
+// if any, from the headers of the response.
+func recordResponseMetadata(ctx context.Context, resp *http.Response) {
+	metadata := api.ResponseMetadataFromContext(ctx)
+	if metadata == nil {
+		return
+	}
+
+	*metadata = api.ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+	}
+	if version := resp.Header.Get("Eth-Consensus-Version"); version != "" {
+		// An unparseable version is left as unknown.
+		_ = metadata.ConsensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", version)))
+	}
+	if blinded, err := strconv.ParseBool(resp.Header.Get("Eth-Execution-Payload-Blinded")); err == nil {
+		metadata.ExecutionPayloadBlinded = &blinded
+	}
+	if value, ok := new(big.Int).SetString(resp.Header.Get("Eth-Execution-Payload-Value"), 10); ok {
+		metadata.ExecutionPayloadValue = value
+	}
+	if value, ok := new(big.Int).SetString(resp.Header.Get("Eth-Consensus-Block-Value"), 10); ok {
+		metadata.ConsensusBlockValue = value
+	}
+}
+
+// errBodyMetadataComplete stops decoding of a response body once its metadata has been read.
+var errBodyMetadataComplete = errors.New("body metadata complete")
+
+// recordResponseBodyMetadata populates the response metadata requested by the context,
+// if any, from the top-level fields of a JSON response body.
+//
+// Nodes supply these fields ahead of the data, so decoding stops once they have been
+// read rather than decoding the data, which is left to the provider.
+func recordResponseBodyMetadata(ctx context.Context, body []byte) {
+	metadata := api.ResponseMetadataFromContext(ctx)
+	if metadata == nil || len(body) == 0 {
+		return
+	}
+
+	var version *spec.DataVersion
+	var executionOptimistic *bool
+	var finalized *bool
+	remaining := 3
+	err := decodeJSONObject(json.NewDecoder(bytes.NewReader(body)), func(key string, decoder *json.Decoder) error {
+		var err error
+		switch key {
+		case "version":
+			err = decoder.Decode(&version)
+		case "execution_optimistic":
+			err = decoder.Decode(&executionOptimistic)
+		case "finalized":
+			err = decoder.Decode(&finalized)
+		default:
+			return skipJSONValue(decoder)
+		}
+		if err != nil {
+			return err
+		}
+		remaining--
+		if remaining == 0 {
+			return errBodyMetadataComplete
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBodyMetadataComplete) {
+		// Not a JSON object; nothing to record.
+		return
+	}
+
+	if metadata.ConsensusVersion == spec.DataVersionUnknown && version != nil {
+		metadata.ConsensusVersion = *version
+	}
+	metadata.ExecutionOptimistic = executionOptimistic
+	metadata.Finalized = finalized
+}
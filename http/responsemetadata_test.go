@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"math/big"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseMetadata(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Eth-Consensus-Version", "deneb")
+		w.Header().Set("Eth-Execution-Payload-Blinded", "false")
+		w.Header().Set("Eth-Execution-Payload-Value", "123456789012345678901234567890")
+		w.Header().Set("Eth-Consensus-Block-Value", "1000")
+		_, _ = w.Write([]byte(`{"execution_optimistic":true,"finalized":false,"data":{"root":"0x0101010101010101010101010101010101010101010101010101010101010101"}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	// Calls without metadata requested should be unaffected.
+	_, err = service.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+
+	metadata := &api.ResponseMetadata{}
+	_, err = service.(client.BeaconBlockRootProvider).BeaconBlockRoot(api.WithResponseMetadata(ctx, metadata), "head")
+	require.NoError(t, err)
+
+	require.Equal(t, nethttp.StatusOK, metadata.StatusCode)
+	require.Equal(t, spec.DataVersionDeneb, metadata.ConsensusVersion)
+	require.NotNil(t, metadata.ExecutionPayloadBlinded)
+	require.False(t, *metadata.ExecutionPayloadBlinded)
+	expectedValue, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.Equal(t, expectedValue, metadata.ExecutionPayloadValue)
+	require.Equal(t, big.NewInt(1000), metadata.ConsensusBlockValue)
+	require.NotNil(t, metadata.ExecutionOptimistic)
+	require.True(t, *metadata.ExecutionOptimistic)
+	require.NotNil(t, metadata.Finalized)
+	require.False(t, *metadata.Finalized)
+	require.Equal(t, "1000", metadata.Headers.Get("Eth-Consensus-Block-Value"))
+}
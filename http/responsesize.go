@@ -0,0 +1,76 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+	"io"
+)
+
+// ResponseTooLargeError is returned when the body of a response exceeds the
+// maximum response size configured with WithMaxResponseSize().
+type ResponseTooLargeError struct {
+	Endpoint string
+	Limit    int64
+}
+
+func (e ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response from %s exceeded maximum size of %d bytes", e.Endpoint, e.Limit)
+}
+
+// Retryable returns false, as the response will exceed the limit if retried.
+func (ResponseTooLargeError) Retryable() bool {
+	return false
+}
+
+// sizeLimitedBody is a response body that errors once more than its limit has been read.
+type sizeLimitedBody struct {
+	io.ReadCloser
+	endpoint  string
+	limit     int64
+	remaining int64
+}
+
+func (b *sizeLimitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ResponseTooLargeError{Endpoint: b.endpoint, Limit: b.limit}
+	}
+	// Allow one byte beyond the limit, to distinguish a body of exactly the limit
+	// from one that exceeds it.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n, ResponseTooLargeError{Endpoint: b.endpoint, Limit: b.limit}
+	}
+
+	return n, err
+}
+
+// limitResponseBody limits the amount of data that can be read from the body of a
+// response to the endpoint, according to the service's configuration.
+func (s *Service) limitResponseBody(body io.ReadCloser, endpoint string) io.ReadCloser {
+	if s.maxResponseSize == 0 {
+		return body
+	}
+
+	return &sizeLimitedBody{
+		ReadCloser: body,
+		endpoint:   endpoint,
+		limit:      s.maxResponseSize,
+		remaining:  s.maxResponseSize,
+	}
+}
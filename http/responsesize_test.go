@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	syncing := func(padding int) []byte {
+		return []byte(fmt.Sprintf(`{"data":{"head_slot":"12345","sync_distance":"0","is_syncing":false,"is_optimistic":false},"padding":"%s"}`, strings.Repeat("x", padding)))
+	}
+
+	tests := []struct {
+		name    string
+		body    []byte
+		gzipped bool
+		err     bool
+	}{
+		{
+			name: "WithinLimit",
+			body: syncing(100),
+		},
+		{
+			name: "ExceedsLimit",
+			body: syncing(4096),
+			err:  true,
+		},
+		{
+			name:    "CompressedWithinLimit",
+			body:    syncing(100),
+			gzipped: true,
+		},
+		{
+			name:    "DecompressedExceedsLimit",
+			body:    syncing(1024 * 1024),
+			gzipped: true,
+			err:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if test.gzipped {
+					var buf bytes.Buffer
+					writer := gzip.NewWriter(&buf)
+					_, _ = writer.Write(test.body)
+					_ = writer.Close()
+					w.Header().Set("Content-Encoding", "gzip")
+					_, _ = w.Write(buf.Bytes())
+					return
+				}
+				_, _ = w.Write(test.body)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithMaxResponseSize(1024),
+			)
+			require.NoError(t, err)
+
+			_, err = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+			if test.err {
+				var tooLarge http.ResponseTooLargeError
+				require.True(t, errors.As(err, &tooLarge))
+				require.Equal(t, "/eth/v1/node/syncing", tooLarge.Endpoint)
+				require.Equal(t, int64(1024), tooLarge.Limit)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,8 +15,8 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
+	apiclient "github.com/attestantio/go-eth2-client/api"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -36,10 +37,21 @@ type Service struct {
 	// log is a service-wide logger.
 	log zerolog.Logger
 
-	base    *url.URL
-	address string
-	client  *http.Client
-	timeout time.Duration
+	// endpointMu protects base and address, which can change if an address
+	// provider is supplied.
+	endpointMu sync.RWMutex
+	base       *url.URL
+	address    string
+	client     *http.Client
+	timeout    time.Duration
+	// customClient is true if the HTTP client was supplied by the user.
+	customClient bool
+	// socketPath is the path of the Unix domain socket to connect to, if any.
+	socketPath string
+	// tlsConfig is the TLS configuration for connections, if not the default.
+	tlsConfig *tls.Config
+	// proxy is the proxy through which to connect, if any.
+	proxy *url.URL
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
@@ -53,14 +65,93 @@ type Service struct {
 	forkScheduleMutex    sync.RWMutex
 	nodeVersion          string
 	nodeVersionMutex     sync.RWMutex
+	endpointSupport      map[string]bool
+	endpointSupportMutex sync.RWMutex
+	etags                map[string]*etagEntry
+	etagsMutex           sync.RWMutex
+
+	// flights collapses identical concurrent requests; nil if disabled.
+	flights *flightGroup
+
+	// nilOnNotFound is set if providers return nil rather than an error when the
+	// requested item does not exist.
+	nilOnNotFound bool
+
+	// allowUnknownVersions is set if data of versions unknown to the client is passed
+	// on to the caller rather than failing.
+	allowUnknownVersions bool
 
 	// User-specified chunk sizes.
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
 	extraHeaders        map[string]string
 
+	// userValidatorsPostThreshold is the user-specified number of validators above which POST is used.
+	userValidatorsPostThreshold int
+
+	// Authorization.
+	bearerToken   string
+	tokenProvider TokenProviderFunc
+
+	// Request correlation.
+	requestIDHeader string
+	requestIDFunc   RequestIDFunc
+
+	// addressProvider provides updated addresses for the endpoint, if supplied.
+	addressProvider AddressProviderFunc
+	redactSensitive bool
+
+	// redactor removes sensitive values from logs and errors.
+	redactor *redactor
+
+	// Response compression.
+	compression     bool
+	zstdCompression bool
+
+	// maxResponseSize is the maximum size of a response body; 0 for no limit.
+	maxResponseSize int64
+
+	// circuitBreakers are the per-endpoint circuit breakers; nil if disabled.
+	circuitBreakers *circuitBreakers
+
 	// Endpoint support.
 	connectedToDVTMiddleware bool
+	broadcastValidation      apiclient.BroadcastValidation
+
+	// interceptors are applied to all requests.
+	interceptors []Interceptor
+
+	// Events stream reconnection.
+	eventsReconnectDelay    time.Duration
+	eventsReconnectMaxDelay time.Duration
+	eventsReconnectHandler  EventsReconnectHandlerFunc
+
+	// Events buffering.
+	eventsBufferSize     int
+	eventsOverflowPolicy EventsOverflowPolicy
+
+	// Events gap fill.
+	eventsGapFill bool
+
+	// Events streams, torn down when the service is closed.
+	eventsWG sync.WaitGroup
+	closeCh  chan struct{}
+	closed   bool
+	closeMu  sync.RWMutex
+
+	// Response content negotiation.
+	contentNegotiation ContentNegotiation
+	endpointAccept     map[string]string
+
+	// sszSubmissions is set if submissions should be encoded as SSZ.
+	sszSubmissions bool
+	// sszSubmissionsUnsupported is set if the node does not accept SSZ submissions.
+	sszSubmissionsUnsupported bool
+	sszSubmissionsMutex       sync.RWMutex
+
+	// validatorsPostUnsupported is set if the node does not support POST for the validators endpoint.
+	validatorsPostUnsupported      bool
+	validatorsPostUnsupportedMutex sync.RWMutex
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -76,55 +167,167 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
-	client := &http.Client{
-		Timeout: parameters.timeout,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   parameters.timeout,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxConnsPerHost:     64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     600 * time.Second,
-		},
+	if parameters.address == "" {
+		// Obtain the initial address from the address provider.
+		parameters.address, err = parameters.addressProvider(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain address")
+		}
+		if parameters.address == "" {
+			return nil, errors.New("address provider returned empty address")
+		}
+	}
+
+	socketPath, isUnixSocket := unixSocketPath(parameters.address)
+	if isUnixSocket && parameters.addressProvider != nil {
+		return nil, errors.New("cannot use an address provider with a Unix socket address")
+	}
+	if isUnixSocket && socketPath == "" {
+		return nil, errors.New("no Unix socket path specified")
+	}
+
+	tlsConfig, err := newTLSConfig(parameters)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid TLS configuration")
+	}
+
+	proxy, err := parseProxyURL(parameters.proxyURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proxy URL")
+	}
+	if proxy != nil && isUnixSocket {
+		return nil, errors.New("cannot use a proxy with a Unix socket address")
+	}
+
+	client := parameters.httpClient
+	if client == nil {
+		// Note that the client does not have its own timeout, as the timeout for each
+		// request is governed by its context.
+		client = &http.Client{
+			Transport: newTransport(parameters, socketPath, tlsConfig, proxy),
+		}
+	}
+	if len(parameters.interceptors) > 0 {
+		// Copy the client rather than altering the one that was supplied.
+		intercepted := *client
+		intercepted.Transport = newInterceptedTransport(client.Transport, parameters.interceptors)
+		client = &intercepted
+	}
+	var hooked *hookTransport
+	if parameters.responseHook != nil {
+		// Copy the client rather than altering the one that was supplied.
+		hooked = &hookTransport{
+			next: client.Transport,
+			hook: parameters.responseHook,
+		}
+		if hooked.next == nil {
+			hooked.next = http.DefaultTransport
+		}
+		hookedClient := *client
+		hookedClient.Transport = hooked
+		client = &hookedClient
+	}
+	var refreshCh chan struct{}
+	if parameters.connectionRefreshFailures > 0 {
+		refreshCh = make(chan struct{}, 1)
+		// Copy the client rather than altering the one that was supplied.
+		monitored := *client
+		monitored.Transport = &monitoredTransport{
+			next:      client.Transport,
+			threshold: parameters.connectionRefreshFailures,
+			refreshCh: refreshCh,
+		}
+		client = &monitored
 	}
 
 	address := parameters.address
-	if !strings.HasPrefix(address, "http") {
-		address = fmt.Sprintf("http://%s", address)
+	if isUnixSocket {
+		address = unixSocketBase
 	}
-	if !strings.HasSuffix(address, "/") {
-		address = fmt.Sprintf("%s/", address)
+	var redactor *redactor
+	if parameters.redactSensitive {
+		redactor = newRedactor(normaliseAddress(address), parameters.extraHeaders, parameters.bearerToken)
 	}
-	base, err := url.Parse(address)
+	base, err := url.Parse(normaliseAddress(address))
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid URL")
+		return nil, errors.Wrap(redactor.Error(err), "invalid URL")
 	}
 
 	s := &Service{
-		log:                 log,
-		base:                base,
-		address:             parameters.address,
-		client:              client,
-		timeout:             parameters.timeout,
-		userIndexChunkSize:  parameters.indexChunkSize,
-		userPubKeyChunkSize: parameters.pubKeyChunkSize,
-		extraHeaders:        parameters.extraHeaders,
+		log:                         log,
+		base:                        base,
+		address:                     redactor.String(parameters.address),
+		redactor:                    redactor,
+		client:                      client,
+		timeout:                     parameters.timeout,
+		customClient:                parameters.httpClient != nil,
+		socketPath:                  socketPath,
+		tlsConfig:                   tlsConfig,
+		proxy:                       proxy,
+		userIndexChunkSize:          parameters.indexChunkSize,
+		userPubKeyChunkSize:         parameters.pubKeyChunkSize,
+		userValidatorsPostThreshold: parameters.validatorsPostThreshold,
+		extraHeaders:                parameters.extraHeaders,
+		bearerToken:                 parameters.bearerToken,
+		tokenProvider:               parameters.tokenProvider,
+		requestIDHeader:             parameters.requestIDHeader,
+		requestIDFunc:               parameters.requestIDFunc,
+		broadcastValidation:         parameters.broadcastValidation,
+		compression:                 parameters.compression,
+		zstdCompression:             parameters.zstdCompression,
+		maxResponseSize:             parameters.maxResponseSize,
+		addressProvider:             parameters.addressProvider,
+		redactSensitive:             parameters.redactSensitive,
+		sszSubmissions:              parameters.sszSubmissions,
+		contentNegotiation:          parameters.contentNegotiation,
+		endpointAccept:              parameters.endpointAccept,
+		interceptors:                parameters.interceptors,
+		nilOnNotFound:               parameters.nilOnNotFound,
+		allowUnknownVersions:        parameters.allowUnknownVersions,
+		eventsReconnectDelay:        parameters.eventsReconnectDelay,
+		eventsReconnectMaxDelay:     parameters.eventsReconnectMaxDelay,
+		eventsReconnectHandler:      parameters.eventsReconnectHandler,
+		eventsBufferSize:            parameters.eventsBufferSize,
+		eventsOverflowPolicy:        parameters.eventsOverflowPolicy,
+		eventsGapFill:               parameters.eventsGapFill,
+		etags:                       make(map[string]*etagEntry),
+		closeCh:                     make(chan struct{}),
 	}
 
-	// Fetch static values to confirm the connection is good.
-	if err := s.fetchStaticValues(ctx); err != nil {
-		return nil, errors.Wrap(err, "failed to confirm node connection")
+	if hooked != nil {
+		hooked.basePath = func() string {
+			return s.baseURL().Path
+		}
 	}
 
-	// Periodially refetch static values in case of client update.
-	s.periodicClearStaticValues(ctx)
+	if parameters.singleflight {
+		s.flights = newFlightGroup()
+	}
+
+	if parameters.circuitBreakerThreshold > 0 {
+		s.circuitBreakers = newCircuitBreakers(parameters.circuitBreakerThreshold, parameters.circuitBreakerCooldown)
+	}
+
+	if parameters.confirmConnection {
+		// Fetch static values to confirm the connection is good.
+		if err := s.fetchStaticValues(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to confirm node connection")
+		}
+
+		// Periodially refetch static values in case of client update.
+		s.periodicClearStaticValues(ctx)
+	}
+
+	// Refresh connections to pick up changes to the endpoint's address.
+	if parameters.connectionRefreshInterval > 0 || refreshCh != nil {
+		go s.refreshConnections(ctx, parameters.connectionRefreshInterval, refreshCh)
+	}
 
 	// Handle connection to DVT middleware.
-	if err := s.checkDVT(ctx); err != nil {
-		return nil, errors.Wrap(err, "failed to check DVT connection")
+	if parameters.confirmConnection {
+		if err := s.checkDVT(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to check DVT connection")
+		}
 	}
 
 	// Close the service on context done.
@@ -169,21 +372,7 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) {
 		for {
 			select {
 			case <-refreshTicker.C:
-				s.genesisMutex.Lock()
-				s.genesis = nil
-				s.genesisMutex.Unlock()
-				s.specMutex.Lock()
-				s.spec = nil
-				s.specMutex.Unlock()
-				s.depositContractMutex.Lock()
-				s.depositContract = nil
-				s.depositContractMutex.Unlock()
-				s.forkScheduleMutex.Lock()
-				s.forkSchedule = nil
-				s.forkScheduleMutex.Unlock()
-				s.nodeVersionMutex.Lock()
-				s.nodeVersion = ""
-				s.nodeVersionMutex.Unlock()
+				s.clearStaticValues()
 			case <-ctx.Done():
 				return
 			}
@@ -191,6 +380,28 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) {
 	}(s, ctx)
 }
 
+// clearStaticValues sets static values to nil so they are refetched.
+func (s *Service) clearStaticValues() {
+	s.genesisMutex.Lock()
+	s.genesis = nil
+	s.genesisMutex.Unlock()
+	s.specMutex.Lock()
+	s.spec = nil
+	s.specMutex.Unlock()
+	s.depositContractMutex.Lock()
+	s.depositContract = nil
+	s.depositContractMutex.Unlock()
+	s.forkScheduleMutex.Lock()
+	s.forkSchedule = nil
+	s.forkScheduleMutex.Unlock()
+	s.nodeVersionMutex.Lock()
+	s.nodeVersion = ""
+	s.nodeVersionMutex.Unlock()
+	s.endpointSupportMutex.Lock()
+	s.endpointSupport = nil
+	s.endpointSupportMutex.Unlock()
+}
+
 // checkDVT checks if connected to DVT middleware and sets
 // internal flags appropriately.
 func (s *Service) checkDVT(ctx context.Context) error {
@@ -213,9 +424,47 @@ func (s *Service) Name() string {
 
 // Address provides the address for the connection.
 func (s *Service) Address() string {
+	s.endpointMu.RLock()
+	defer s.endpointMu.RUnlock()
+
 	return s.address
 }
 
+// baseURL provides the base URL for requests.
+func (s *Service) baseURL() *url.URL {
+	s.endpointMu.RLock()
+	defer s.endpointMu.RUnlock()
+
+	return s.base
+}
+
+// normaliseAddress returns the address with a scheme and trailing slash.
+func normaliseAddress(address string) string {
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	if !strings.HasSuffix(address, "/") {
+		address = fmt.Sprintf("%s/", address)
+	}
+
+	return address
+}
+
+// Close closes the service, tearing down any active events streams.
+// It returns once all events streams have finished.
+func (s *Service) Close() {
+	s.close()
+	s.eventsWG.Wait()
+}
+
 // close closes the service, freeing up resources.
 func (s *Service) close() {
+	s.closeMu.Lock()
+	defer s.closeMu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.closeCh)
 }
@@ -15,6 +15,7 @@ package http_test
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -80,6 +81,51 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no public key chunk size specified",
 		},
+		{
+			name: "MaxIdleConnsNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxIdleConns(-1),
+			},
+			err: "problem with parameters: max idle connections cannot be negative",
+		},
+		{
+			name: "MaxResponseSizeNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxResponseSize(-1),
+			},
+			err: "problem with parameters: max response size cannot be negative",
+		},
+		{
+			name: "MaxConnsPerHostNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxConnsPerHost(-1),
+			},
+			err: "problem with parameters: max connections per host cannot be negative",
+		},
+		{
+			name: "EventsBufferSizeNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithEventsBuffer(-1, v1.EventsOverflowBlock),
+			},
+			err: "problem with parameters: events buffer size cannot be negative",
+		},
+		{
+			name: "EventsOverflowPolicyInvalid",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithEventsBuffer(16, v1.EventsOverflowPolicy(-1)),
+			},
+			err: "problem with parameters: invalid events overflow policy",
+		},
 		{
 			name: "Good",
 			parameters: []v1.Parameter{
@@ -87,6 +133,26 @@ func TestService(t *testing.T) {
 				v1.WithTimeout(5 * time.Second),
 			},
 		},
+		{
+			name: "TransportTuning",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxIdleConns(256),
+				v1.WithMaxConnsPerHost(0),
+				v1.WithIdleConnTimeout(time.Minute),
+				v1.WithTLSHandshakeTimeout(5 * time.Second),
+				v1.WithHTTP2(true),
+			},
+		},
+		{
+			name: "CustomHTTPClient",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithHTTPClient(&http.Client{}),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -113,28 +179,60 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingSubmitter)(nil), s)
 	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateRandaoAtEpochProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRootProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.BlockAttestationsProvider)(nil), s)
+	assert.Implements(t, (*client.BuilderBidProvider)(nil), s)
+	assert.Implements(t, (*client.BuilderBlindedBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BuilderStatusProvider)(nil), s)
+	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.EventsSubscriber)(nil), s)
+	assert.Implements(t, (*client.FilteredEventsProvider)(nil), s)
+	assert.Implements(t, (*client.TypedEventsProvider)(nil), s)
+	assert.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingConsolidationsProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.KeystoresManager)(nil), s)
+	assert.Implements(t, (*client.RemoteKeysManager)(nil), s)
+	assert.Implements(t, (*client.FeeRecipientManager)(nil), s)
+	assert.Implements(t, (*client.GasLimitManager)(nil), s)
+	assert.Implements(t, (*client.GraffitiManager)(nil), s)
+	assert.Implements(t, (*client.VoluntaryExitSigner)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
+	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.ParsedNodeVersionProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ProposalProvider)(nil), s)
+	assert.Implements(t, (*client.SignedBlindedBeaconBlockProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -143,11 +241,18 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAggregateAttestationProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
 	// Non-standard extensions.
+	assert.Implements(t, (*client.CapabilitiesProvider)(nil), s)
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.ForkDigestProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*client.RawCallProvider)(nil), s)
 }
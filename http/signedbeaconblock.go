@@ -25,6 +25,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -49,8 +50,12 @@ type denebSignedBeaconBlockJSON struct {
 	Data *deneb.SignedBeaconBlock `json:"data"`
 }
 
+type electraSignedBeaconBlockJSON struct {
+	Data *electra.SignedBeaconBlock `json:"data"`
+}
+
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
-// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+// N.B if a signed beacon block for the block ID is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
 	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
 	if err != nil {
@@ -101,8 +106,13 @@ func (s *Service) signedBeaconBlockFromSSZ(res *httpResponse) (*spec.VersionedSi
 		if err := block.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb signed beacon block")
 		}
+	case spec.DataVersionElectra:
+		block.Electra = &electra.SignedBeaconBlock{}
+		if err := block.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra signed beacon block")
+		}
 	default:
-		return nil, fmt.Errorf("unhandled block version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unhandled block version %s", res.consensusVersion)
 	}
 
 	return block, nil
@@ -145,8 +155,14 @@ func (s *Service) signedBeaconBlockFromJSON(res *httpResponse) (*spec.VersionedS
 			return nil, errors.Wrap(err, "failed to parse deneb signed beacon block")
 		}
 		block.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraSignedBeaconBlockJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra signed beacon block")
+		}
+		block.Electra = resp.Data
 	default:
-		return nil, fmt.Errorf("unhandled block version %s", res.consensusVersion)
+		return nil, newUnsupportedVersionError("unhandled block version %s", res.consensusVersion)
 	}
 
 	return block, nil
@@ -15,11 +15,20 @@ package http_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
 	"os"
 	"testing"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +64,84 @@ func TestSignedBeaconBlock(t *testing.T) {
 		})
 	}
 }
+
+// testElectraBeaconBlock returns an Electra beacon block for the given slot.
+func testElectraBeaconBlock(slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) *electra.BeaconBlock {
+	block := &electra.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: 1,
+		Body: &electra.BeaconBlockBody{
+			RANDAOReveal: randaoReveal,
+			ETH1Data:     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: make([]byte, 64),
+			},
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(7),
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+		},
+	}
+	copy(block.Body.Graffiti[:], graffiti)
+
+	return block
+}
+
+func TestSignedBeaconBlockElectra(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	block := &electra.SignedBeaconBlock{
+		Message: testElectraBeaconBlock(100, phase0.BLSSignature{0x01}, nil),
+	}
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+	}{
+		{
+			name:        "SSZ",
+			contentType: "application/octet-stream",
+			data:        blockSSZ,
+		},
+		{
+			name:        "JSON",
+			contentType: "application/json",
+			data:        []byte(fmt.Sprintf(`{"version":"electra","data":%s}`, string(blockJSON))),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v2/beacon/blocks/head" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				w.Header().Set("Eth-Consensus-Version", "electra")
+				_, _ = w.Write(test.data)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+			)
+			require.NoError(t, err)
+
+			res, err := service.(client.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+			require.NoError(t, err)
+			require.Equal(t, spec.DataVersionElectra, res.Version)
+			root, err := res.Electra.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, blockRoot, root)
+		})
+	}
+}
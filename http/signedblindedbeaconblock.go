@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1bellatrix.SignedBlindedBeaconBlock `json:"data"`
+}
+
+type capellaSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1capella.SignedBlindedBeaconBlock `json:"data"`
+}
+
+type denebSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1deneb.SignedBlindedBeaconBlock `json:"data"`
+}
+
+type electraSignedBlindedBeaconBlockJSON struct {
+	Data *apiv1electra.SignedBlindedBeaconBlock `json:"data"`
+}
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+// Blocks prior to bellatrix have no execution payload to blind, so are not supported.
+// N.B if a signed blinded beacon block for the block ID is not available this will return an error that matches api.ErrNotFound.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v1/beacon/blinded_blocks/%s", blockID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request signed blinded beacon block")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	switch res.contentType {
+	case ContentTypeSSZ:
+		return s.signedBlindedBeaconBlockFromSSZ(res)
+	case ContentTypeJSON:
+		return s.signedBlindedBeaconBlockFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
+
+func (s *Service) signedBlindedBeaconBlockFromSSZ(res *httpResponse) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	block := &api.VersionedSignedBlindedBeaconBlock{
+		Version: res.consensusVersion,
+	}
+
+	switch res.consensusVersion {
+	case spec.DataVersionBellatrix:
+		block.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{}
+		if err := block.Bellatrix.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix signed blinded beacon block")
+		}
+	case spec.DataVersionCapella:
+		block.Capella = &apiv1capella.SignedBlindedBeaconBlock{}
+		if err := block.Capella.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode capella signed blinded beacon block")
+		}
+	case spec.DataVersionDeneb:
+		block.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{}
+		if err := block.Deneb.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb signed blinded beacon block")
+		}
+	case spec.DataVersionElectra:
+		block.Electra = &apiv1electra.SignedBlindedBeaconBlock{}
+		if err := block.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra signed blinded beacon block")
+		}
+	default:
+		return nil, newUnsupportedVersionError("unhandled blinded block version %s", res.consensusVersion)
+	}
+
+	return block, nil
+}
+
+func (s *Service) signedBlindedBeaconBlockFromJSON(res *httpResponse) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	block := &api.VersionedSignedBlindedBeaconBlock{
+		Version: res.consensusVersion,
+	}
+
+	reader := bytes.NewBuffer(res.body)
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		var resp bellatrixSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix signed blinded beacon block")
+		}
+		block.Bellatrix = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella signed blinded beacon block")
+		}
+		block.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb signed blinded beacon block")
+		}
+		block.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraSignedBlindedBeaconBlockJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra signed blinded beacon block")
+		}
+		block.Electra = resp.Data
+	default:
+		return nil, newUnsupportedVersionError("unhandled blinded block version %s", res.consensusVersion)
+	}
+
+	return block, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type signedVoluntaryExitJSON struct {
+	Data *phase0.SignedVoluntaryExit `json:"data"`
+}
+
+// SignVoluntaryExit obtains a voluntary exit for the validator with the given public key,
+// signed for the current epoch.
+// This is served by validator clients rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) SignVoluntaryExit(ctx context.Context, pubKey phase0.BLSPubKey) (*phase0.SignedVoluntaryExit, error) {
+	return s.signVoluntaryExit(ctx, fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit", pubKey))
+}
+
+// SignVoluntaryExitAtEpoch obtains a voluntary exit for the validator with the given public key,
+// signed for the given epoch.
+func (s *Service) SignVoluntaryExitAtEpoch(ctx context.Context,
+	pubKey phase0.BLSPubKey,
+	epoch phase0.Epoch,
+) (
+	*phase0.SignedVoluntaryExit,
+	error,
+) {
+	return s.signVoluntaryExit(ctx, fmt.Sprintf("/eth/v1/validator/%#x/voluntary_exit?epoch=%d", pubKey, epoch))
+}
+
+func (s *Service) signVoluntaryExit(ctx context.Context, endpoint string) (*phase0.SignedVoluntaryExit, error) {
+	res, err := s.post2(ctx, endpoint, nil, ContentTypeJSON, map[string]string{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request signed voluntary exit")
+	}
+
+	var resp signedVoluntaryExitJSON
+	if err := json.NewDecoder(bytes.NewReader(res.body)).Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse signed voluntary exit")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("signed voluntary exit not returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVoluntaryExit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pubKey := phase0.BLSPubKey{0x93, 0x24}
+	path := "/eth/v1/validator/0x9324" + strings.Repeat("00", 46) + "/voluntary_exit"
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != path || r.Method != nethttp.MethodPost {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		epoch := r.URL.Query().Get("epoch")
+		if epoch == "" {
+			epoch = "1"
+		}
+		_, _ = w.Write([]byte(`{"data":{"message":{"epoch":"` + epoch + `","validator_index":"2"},"signature":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+	signer := service.(client.VoluntaryExitSigner)
+
+	exit, err := signer.SignVoluntaryExit(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(1), exit.Message.Epoch)
+	require.Equal(t, phase0.ValidatorIndex(2), exit.Message.ValidatorIndex)
+
+	exit, err = signer.SignVoluntaryExitAtEpoch(ctx, pubKey, 5)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(5), exit.Message.Epoch)
+
+	_, err = signer.SignVoluntaryExit(ctx, phase0.BLSPubKey{})
+	require.ErrorContains(t, err, "failed to request signed voluntary exit")
+}
@@ -0,0 +1,194 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// flight is an in-flight call whose result is shared by all callers.
+type flight struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// flightResult is the result of an in-flight call, along with the metadata of its response.
+type flightResult struct {
+	val      interface{}
+	metadata *api.ResponseMetadata
+}
+
+// share returns the value of the result, copying its response metadata to that requested
+// by the context, if any.
+func (r *flightResult) share(ctx context.Context) interface{} {
+	if r == nil {
+		return nil
+	}
+	if metadata := api.ResponseMetadataFromContext(ctx); metadata != nil {
+		*metadata = *r.metadata
+	}
+
+	return r.val
+}
+
+// flightGroup collapses concurrent calls with the same key into a single call.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{
+		flights: make(map[string]*flight),
+	}
+}
+
+// do carries out the call, unless a call with the same key is already in flight
+// in which case it waits for and returns the result of that call.
+//
+// The call is not tied to any individual caller, so continues if the caller that
+// started it goes away; each caller stops waiting when its own context is done.
+func (g *flightGroup) do(ctx context.Context, key string, call func() (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	f, exists := g.flights[key]
+	if !exists {
+		f = &flight{
+			done: make(chan struct{}),
+		}
+		g.flights[key] = f
+		go func() {
+			f.val, f.err = call()
+			g.mu.Lock()
+			delete(g.flights, key)
+			g.mu.Unlock()
+			close(f.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// detachedContext is a context that carries the values of its parent, but not its
+// deadline or cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+// shareable returns true if a call made with the context can share its result with
+// identical concurrent calls.  Calls that carry their own deadline, timeout or request ID
+// cannot be shared, as these would otherwise apply to, or be lost from, the shared call.
+func (s *Service) shareable(ctx context.Context) bool {
+	if s.flights == nil || s.requestID(ctx) != "" {
+		return false
+	}
+	opts := api.CallOptsFromContext(ctx)
+
+	return opts == nil || (opts.Deadline.IsZero() && opts.Timeout <= 0)
+}
+
+// get sends an HTTP get request and returns the body, sharing the result with
+// identical concurrent requests if singleflight is enabled.
+// If the response from the server is a 404 this will return an error that matches api.ErrNotFound,
+// or nil for both the reader and the error if configured with WithNilOnNotFound().
+func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
+	if !s.shareable(ctx) {
+		return s.doGet(ctx, endpoint)
+	}
+
+	flightCtx := detachedContext{parent: ctx}
+	res, err := s.flights.do(ctx, "get "+endpoint, func() (interface{}, error) {
+		// The response is shared, so its metadata is recorded for all callers.
+		res := &flightResult{metadata: &api.ResponseMetadata{}}
+		reader, err := s.doGet(api.WithResponseMetadata(flightCtx, res.metadata), endpoint)
+		if err != nil || reader == nil {
+			return res, err
+		}
+		if res.val, err = io.ReadAll(reader); err != nil {
+			return res, err
+		}
+
+		return res, nil
+	})
+	if res == nil {
+		return nil, err
+	}
+	body := res.(*flightResult).share(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	return bytes.NewReader(body.([]byte)), nil
+}
+
+// get2 sends an HTTP get request and returns the response, sharing the result with
+// identical concurrent requests if singleflight is enabled.
+func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, error) {
+	if !s.shareable(ctx) {
+		return s.doGet2(ctx, endpoint)
+	}
+
+	flightCtx := detachedContext{parent: ctx}
+	res, err := s.flights.do(ctx, "get2 "+endpoint, func() (interface{}, error) {
+		// The response is shared, so its metadata is recorded for all callers.
+		res := &flightResult{metadata: &api.ResponseMetadata{}}
+		var err error
+		res.val, err = s.doGet2(api.WithResponseMetadata(flightCtx, res.metadata), endpoint)
+
+		return res, err
+	})
+	if res == nil {
+		return nil, err
+	}
+	resp := res.(*flightResult).share(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers receive their own copy of the response; the body is shared, so must not be modified.
+	resCopy := *(resp.(*httpResponse))
+
+	return &resCopy, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleflight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name         string
+		singleflight bool
+		requests     int
+	}{
+		{
+			name:     "Disabled",
+			requests: 10,
+		},
+		{
+			name:         "Enabled",
+			singleflight: true,
+			requests:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				mu.Lock()
+				requests++
+				mu.Unlock()
+				// Hold the request open so that concurrent calls overlap.
+				time.Sleep(250 * time.Millisecond)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"finalized":false,"data":{"head_slot":"12345","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`))
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithSingleflight(test.singleflight),
+			)
+			require.NoError(t, err)
+
+			var wg sync.WaitGroup
+			syncStates := make([]*apiv1.SyncState, 10)
+			metadata := make([]*api.ResponseMetadata, 10)
+			errs := make([]error, 10)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					metadata[i] = &api.ResponseMetadata{}
+					syncStates[i], errs[i] = service.(client.NodeSyncingProvider).NodeSyncing(api.WithResponseMetadata(ctx, metadata[i]))
+				}(i)
+			}
+			wg.Wait()
+
+			for i := range errs {
+				require.NoError(t, errs[i])
+				require.Equal(t, phase0.Slot(12345), syncStates[i].HeadSlot)
+				// Callers sharing a response should all receive its metadata.
+				require.Equal(t, nethttp.StatusOK, metadata[i].StatusCode)
+				require.NotNil(t, metadata[i].Finalized)
+			}
+			mu.Lock()
+			require.Equal(t, test.requests, requests)
+			mu.Unlock()
+		})
+	}
+}
+
+func TestSingleflightCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	requests := 0
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		time.Sleep(250 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"head_slot":"12345","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`))
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithSingleflight(true),
+	)
+	require.NoError(t, err)
+
+	// The first caller starts the shared request, then goes away.
+	firstCtx, firstCancel := context.WithCancel(ctx)
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := service.(client.NodeSyncingProvider).NodeSyncing(firstCtx)
+		firstErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	firstCancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	wg.Wait()
+	for i := range errs {
+		require.NoError(t, errs[i])
+	}
+
+	// A call with its own options is not shared.
+	_, err = service.(client.NodeSyncingProvider).NodeSyncing(api.WithCallTimeout(ctx, time.Second))
+	require.NoError(t, err)
+
+	mu.Lock()
+	require.Equal(t, 2, requests)
+	mu.Unlock()
+}
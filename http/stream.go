@@ -0,0 +1,308 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// httpStreamResponse is a response whose body has yet to be read.
+// It must be closed once the caller has finished with it.
+type httpStreamResponse struct {
+	statusCode  int
+	contentType ContentType
+	// consensusVersion is the consensus version supplied in the response header;
+	// it is unknown if the header was not supplied.
+	consensusVersion spec.DataVersion
+	// body is the decompressed body of the response; nil if there was no content.
+	body io.ReadCloser
+
+	resp   *http.Response
+	cancel context.CancelFunc
+	span   trace.Span
+}
+
+// Close closes the response.
+func (r *httpStreamResponse) Close() {
+	if r.body != nil {
+		_ = r.body.Close()
+	}
+	_ = r.resp.Body.Close()
+	r.cancel()
+	r.span.End()
+}
+
+// readAll reads the entire body of the response.
+func (r *httpStreamResponse) readAll() ([]byte, error) {
+	if r.body == nil {
+		return nil, nil
+	}
+
+	// Size the buffer up front where possible, to avoid repeated reallocation
+	// when reading large bodies.
+	if r.resp.ContentLength > 0 && r.resp.Header.Get("Content-Encoding") == "" {
+		buf := bytes.NewBuffer(make([]byte, 0, r.resp.ContentLength+bytes.MinRead))
+		if _, err := buf.ReadFrom(r.body); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return io.ReadAll(r.body)
+}
+
+// getStream sends an HTTP get request and returns the response without reading its body,
+// allowing large bodies to be decoded as they are received.
+// If accept is empty the content type is negotiated according to the service's configuration.
+// If the response from the server is a 204, or a 404 when configured with WithNilOnNotFound(),
+// the response will have a nil body.
+func (s *Service) getStream(ctx context.Context, endpoint string, accept string) (*httpStreamResponse, error) {
+	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get2")
+
+	// #nosec G404
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.Address()).Str("endpoint", endpoint).Logger()
+	requestID := s.requestID(ctx)
+	if requestID != "" {
+		log = log.With().Str("request_id", requestID).Logger()
+	}
+	log.Trace().Msg("GET request")
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.baseURL().String(), "/"), endpoint))
+	if err != nil {
+		span.End()
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	if err := s.circuitBreakers.allow(endpoint); err != nil {
+		span.End()
+		return nil, err
+	}
+
+	opCtx, cancel := s.opContext(ctx)
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		s.circuitBreakers.release(endpoint)
+		cancel()
+		span.End()
+		return nil, errors.Wrap(err, "failed to create GET request")
+	}
+	s.addExtraHeaders(req)
+	s.setRequestID(req, requestID)
+	if err := s.setAuthorization(req); err != nil {
+		s.circuitBreakers.release(endpoint)
+		cancel()
+		span.End()
+		return nil, err
+	}
+	acceptOverridden := true
+	if accept == "" {
+		accept, acceptOverridden = s.acceptHeader(endpoint)
+	}
+	req.Header.Set("Accept", accept)
+	s.setAcceptEncoding(req)
+	span.AddEvent("Sending request")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.circuitBreakers.recordError(ctx, endpoint)
+		span.RecordError(errors.New("Request failed"))
+		cancel()
+		span.End()
+		return nil, errors.Wrap(s.redactor.Error(err), "failed to call GET endpoint")
+	}
+	s.circuitBreakers.recordResponse(endpoint, resp.StatusCode)
+	recordResponseMetadata(ctx, resp)
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	res := &httpStreamResponse{
+		statusCode: resp.StatusCode,
+		resp:       resp,
+		cancel:     cancel,
+		span:       span,
+	}
+
+	if resp.StatusCode == http.StatusNotFound && s.nilOnNotFound {
+		// Nothing found, which has been configured to not be an error.
+		span.RecordError(errors.New("endpoint not found"))
+		log.Debug().Msg("Endpoint not found")
+		return res, nil
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		// Nothing returned.  Note that this is not considered an error.
+		span.AddEvent("Received empty response")
+		log.Trace().Msg("Endpoint returned no content")
+		return res, nil
+	}
+
+	statusFamily := resp.StatusCode / 100
+	if statusFamily != 2 {
+		defer res.Close()
+		data, err := s.readResponseBody(resp, endpoint)
+		if err != nil {
+			span.RecordError(err)
+			log.Warn().Err(err).Msg("Failed to read body")
+			return nil, errors.Wrap(err, "failed to read body")
+		}
+		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
+		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(data, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
+		log.Debug().Int("status_code", resp.StatusCode).RawJSON("response", s.redactor.Bytes(trimmedResponse)).Msg("GET failed")
+		return nil, newError(http.MethodGet, endpoint, resp.StatusCode, data, requestID, s.redactor)
+	}
+
+	res.body, err = s.responseBody(resp, endpoint)
+	if err != nil {
+		res.Close()
+		span.RecordError(err)
+		log.Warn().Err(err).Msg("Failed to read body")
+		return nil, errors.Wrap(err, "failed to read body")
+	}
+
+	contentType := &httpResponse{}
+	if err := populateContentType(contentType, resp); err != nil {
+		// For now, assume that unknown type is JSON.
+		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
+		contentType.contentType = ContentTypeJSON
+	}
+	res.contentType = contentType.contentType
+	span.SetAttributes(attribute.String("content-type", res.contentType.String()))
+	if s.contentNegotiation == ContentNegotiationSSZOnly && !acceptOverridden && res.contentType != ContentTypeSSZ {
+		res.Close()
+		return nil, fmt.Errorf("SSZ response required but received %s", res.contentType)
+	}
+
+	res.consensusVersion = spec.DataVersionUnknown
+	if consensusVersion := resp.Header.Get("Eth-Consensus-Version"); consensusVersion != "" {
+		if err := res.consensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", consensusVersion))); err != nil {
+			if s.allowUnknownVersions {
+				return nil, s.unknownVersionError(res, consensusVersion)
+			}
+			res.Close()
+			return nil, errors.Wrap(err, "failed to parse consensus version")
+		}
+	}
+
+	return res, nil
+}
+
+// decodeJSONObject decodes a JSON object as it is read, passing each field to the
+// handler.  The handler must consume the value of each field it is passed.
+func decodeJSONObject(decoder *json.Decoder, handler func(key string, decoder *json.Decoder) error) error {
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, isKey := token.(string)
+		if !isKey {
+			return fmt.Errorf("unexpected JSON token %v", token)
+		}
+		if err := handler(key, decoder); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(decoder, '}')
+}
+
+// skipJSONValue consumes the next JSON value.
+func skipJSONValue(decoder *json.Decoder) error {
+	var value json.RawMessage
+
+	return decoder.Decode(&value)
+}
+
+// expectJSONDelim consumes the next JSON token, which must be the given delimiter.
+func expectJSONDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected JSON %v but received %v", delim, token)
+	}
+
+	return nil
+}
+
+// decodeVersionedJSON decodes a JSON response of the form {"version":...,"data":...} as it
+// is read, passing the data to decodeData along with its version.  The version is taken
+// from the response header if supplied, otherwise from the body; if the body supplies the
+// data before the version the data is buffered until the version is known.
+func decodeVersionedJSON(body io.Reader,
+	version spec.DataVersion,
+	decodeData func(version spec.DataVersion, decoder *json.Decoder) error,
+) (
+	spec.DataVersion,
+	error,
+) {
+	var buffered json.RawMessage
+	decoded := false
+	err := decodeJSONObject(json.NewDecoder(body), func(key string, decoder *json.Decoder) error {
+		switch key {
+		case "version":
+			var bodyVersion spec.DataVersion
+			if err := decoder.Decode(&bodyVersion); err != nil {
+				return errors.Wrap(err, "failed to parse version")
+			}
+			if version == spec.DataVersionUnknown {
+				version = bodyVersion
+			}
+		case "data":
+			if version == spec.DataVersionUnknown {
+				return decoder.Decode(&buffered)
+			}
+			decoded = true
+			return decodeData(version, decoder)
+		default:
+			return skipJSONValue(decoder)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return version, err
+	}
+	if decoded {
+		return version, nil
+	}
+
+	if buffered == nil {
+		return version, errors.New("no data in response")
+	}
+	if version == spec.DataVersionUnknown {
+		return version, errors.New("no version in response")
+	}
+
+	return version, decodeData(version, json.NewDecoder(bytes.NewReader(buffered)))
+}
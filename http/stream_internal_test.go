@@ -0,0 +1,87 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeVersionedJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		headerVersion spec.DataVersion
+		version       spec.DataVersion
+		data          string
+		err           string
+	}{
+		{
+			name:    "VersionFirst",
+			body:    `{"version":"capella","data":{"value":"1"}}`,
+			version: spec.DataVersionCapella,
+			data:    "1",
+		},
+		{
+			name:    "VersionLast",
+			body:    `{"execution_optimistic":false,"data":{"value":"2"},"version":"deneb"}`,
+			version: spec.DataVersionDeneb,
+			data:    "2",
+		},
+		{
+			name:          "HeaderVersion",
+			body:          `{"data":{"value":"3"},"finalized":true}`,
+			headerVersion: spec.DataVersionBellatrix,
+			version:       spec.DataVersionBellatrix,
+			data:          "3",
+		},
+		{
+			name: "NoData",
+			body: `{"version":"capella"}`,
+			err:  "no data in response",
+		},
+		{
+			name: "NoVersion",
+			body: `{"data":{"value":"4"}}`,
+			err:  "no version in response",
+		},
+		{
+			name: "NotObject",
+			body: `[]`,
+			err:  "expected JSON {",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var data struct {
+				Value string `json:"value"`
+			}
+			version, err := decodeVersionedJSON(strings.NewReader(test.body), test.headerVersion, func(_ spec.DataVersion, decoder *json.Decoder) error {
+				return decoder.Decode(&data)
+			})
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.version, version)
+				require.Equal(t, test.data, data.Value)
+			}
+		})
+	}
+}
@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -24,12 +22,7 @@ import (
 
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
-	specJSON, err := json.Marshal(aggregateAndProofs)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-
-	_, err = s.post(ctx, "/eth/v1/validator/aggregate_and_proofs", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/validator/aggregate_and_proofs", aggregateAndProofs, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit aggregate and proofs")
 	}
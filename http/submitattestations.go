@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -24,12 +22,7 @@ import (
 
 // SubmitAttestations submits attestations.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
-	specJSON, err := json.Marshal(attestations)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-
-	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/beacon/pool/attestations", attestations, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit beacon attestations")
 	}
@@ -15,6 +15,9 @@ package http_test
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
 	"os"
 	"strings"
 	"testing"
@@ -83,3 +86,50 @@ func TestSubmitAttestations(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitAttestationsBody(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var bodies [][]byte
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodPost || r.URL.Path != "/eth/v1/beacon/pool/attestations" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, body)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	aggregationBits := bitfield.NewBitlist(128)
+	aggregationBits.SetBitAt(1, true)
+	for i := 0; i < 3; i++ {
+		attestations := []*phase0.Attestation{
+			{
+				AggregationBits: aggregationBits,
+				Data: &phase0.AttestationData{
+					Slot:            phase0.Slot(i),
+					BeaconBlockRoot: phase0.Root{byte(i)},
+					Source:          &phase0.Checkpoint{},
+					Target:          &phase0.Checkpoint{Epoch: 1},
+				},
+			},
+		}
+		expected, err := json.Marshal(attestations)
+		require.NoError(t, err)
+
+		// Each submission reuses the pooled buffer of the last, so confirm that it is
+		// sent intact.
+		require.NoError(t, service.(client.AttestationsSubmitter).SubmitAttestations(ctx, attestations))
+		require.Len(t, bodies, i+1)
+		require.Equal(t, expected, bodies[i])
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAttesterSlashing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slashing := &phase0.AttesterSlashing{
+		Attestation1: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2},
+			Data: &phase0.AttestationData{
+				Slot:   1,
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{Epoch: 1},
+			},
+		},
+		Attestation2: &phase0.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2},
+			Data: &phase0.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: phase0.Root{0x01},
+				Source:          &phase0.Checkpoint{},
+				Target:          &phase0.Checkpoint{Epoch: 1},
+			},
+		},
+	}
+
+	var received *phase0.AttesterSlashing
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodPost || r.URL.Path != "/eth/v1/beacon/pool/attester_slashings" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		received = &phase0.AttesterSlashing{}
+		if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+			w.WriteHeader(nethttp.StatusBadRequest)
+			return
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	require.EqualError(t, service.(client.AttesterSlashingSubmitter).SubmitAttesterSlashing(ctx, nil), "no attester slashing supplied")
+
+	require.NoError(t, service.(client.AttesterSlashingSubmitter).SubmitAttesterSlashing(ctx, slashing))
+	require.Equal(t, slashing, received)
+}
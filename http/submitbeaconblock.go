@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
@@ -24,33 +22,44 @@ import (
 
 // SubmitBeaconBlock submits a beacon block.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.New("no block supplied")
 	}
 
+	var data sszMarshaler
 	switch block.Version {
 	case spec.DataVersionPhase0:
-		specJSON, err = json.Marshal(block.Phase0)
+		if block.Phase0 != nil {
+			data = block.Phase0
+		}
 	case spec.DataVersionAltair:
-		specJSON, err = json.Marshal(block.Altair)
+		if block.Altair != nil {
+			data = block.Altair
+		}
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
+		if block.Bellatrix != nil {
+			data = block.Bellatrix
+		}
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
+		if block.Capella != nil {
+			data = block.Capella
+		}
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
+		if block.Deneb != nil {
+			data = block.Deneb
+		}
+	case spec.DataVersionElectra:
+		if block.Electra != nil {
+			data = block.Electra
+		}
 	default:
-		err = errors.New("unknown block version")
+		return errors.New("unknown block version")
 	}
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
+	if data == nil {
+		return errors.New("no block data supplied")
 	}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/blocks", bytes.NewBuffer(specJSON))
-	if err != nil {
+	if err := s.publishBlock(ctx, blockPublishEndpoints, block.Version, data); err != nil {
 		return errors.Wrap(err, "failed to submit beacon block")
 	}
 
@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -25,33 +23,40 @@ import (
 
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
 func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
-	var specJSON []byte
-	var err error
-
 	if block == nil {
 		return errors.New("no blinded block supplied")
 	}
 
+	var data sszMarshaler
 	switch block.Version {
 	case spec.DataVersionPhase0:
-		err = errors.New("blinded phase0 blocks not supported")
+		return errors.New("blinded phase0 blocks not supported")
 	case spec.DataVersionAltair:
-		err = errors.New("blinded altair blocks not supported")
+		return errors.New("blinded altair blocks not supported")
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
+		if block.Bellatrix != nil {
+			data = block.Bellatrix
+		}
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
+		if block.Capella != nil {
+			data = block.Capella
+		}
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
+		if block.Deneb != nil {
+			data = block.Deneb
+		}
+	case spec.DataVersionElectra:
+		if block.Electra != nil {
+			data = block.Electra
+		}
 	default:
-		err = errors.New("unknown block version")
+		return errors.New("unknown block version")
 	}
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
+	if data == nil {
+		return errors.New("no blinded block data supplied")
 	}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/blinded_blocks", bytes.NewBuffer(specJSON))
-	if err != nil {
+	if err := s.publishBlock(ctx, blindedBlockPublishEndpoints, block.Version, data); err != nil {
 		return errors.Wrap(err, "failed to submit blinded beacon block")
 	}
 
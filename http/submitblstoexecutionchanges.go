@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/pkg/errors"
@@ -24,12 +22,7 @@ import (
 
 // SubmitBLSToExecutionChanges submits BLS to execution address change operations.
 func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
-	specJSON, err := json.Marshal(blsToExecutionChanges)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-
-	_, err = s.post(ctx, "/eth/v1/beacon/pool/bls_to_execution_changes", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/beacon/pool/bls_to_execution_changes", blsToExecutionChanges, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit BLS to execution change")
 	}
@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+type bellatrixSubmitBlindedBlockResponseJSON struct {
+	Data *bellatrix.ExecutionPayload `json:"data"`
+}
+
+type capellaSubmitBlindedBlockResponseJSON struct {
+	Data *capella.ExecutionPayload `json:"data"`
+}
+
+type denebSubmitBlindedBlockResponseJSON struct {
+	Data *apiv1deneb.ExecutionPayloadAndBlobsBundle `json:"data"`
+}
+
+// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder,
+// returning the execution payload (and blobs bundle post-Deneb) that unblinds it.
+// This is served by builders and relays rather than beacon nodes, so the service should
+// be created with WithConfirmConnection(false) when connecting to them.
+func (s *Service) SubmitBuilderBlindedBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no blinded block supplied")
+	}
+
+	var data json.Marshaler
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix != nil {
+			data = block.Bellatrix
+		}
+	case spec.DataVersionCapella:
+		if block.Capella != nil {
+			data = block.Capella
+		}
+	case spec.DataVersionDeneb:
+		if block.Deneb != nil {
+			data = block.Deneb
+		}
+	default:
+		return nil, newUnsupportedVersionError("unhandled blinded block version %s", block.Version)
+	}
+	if data == nil {
+		return nil, errors.New("no blinded block data supplied")
+	}
+
+	reqBody, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	headers := make(map[string]string)
+	headers["Eth-Consensus-Version"] = block.Version.String()
+	res, err := s.post2(ctx, "/eth/v1/builder/blinded_blocks", reqBody, ContentTypeJSON, headers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded block to builder")
+	}
+
+	response := &api.VersionedSubmitBlindedBlockResponse{
+		Version: block.Version,
+	}
+	reader := bytes.NewBuffer(res.body)
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		var resp bellatrixSubmitBlindedBlockResponseJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse bellatrix execution payload")
+		}
+		response.Bellatrix = resp.Data
+	case spec.DataVersionCapella:
+		var resp capellaSubmitBlindedBlockResponseJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse capella execution payload")
+		}
+		response.Capella = resp.Data
+	case spec.DataVersionDeneb:
+		var resp denebSubmitBlindedBlockResponseJSON
+		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse deneb execution payload and blobs bundle")
+		}
+		response.Deneb = resp.Data
+	}
+	if response.IsEmpty() {
+		return nil, errors.New("no execution payload returned")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	expectedBlockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		return nil, err
+	}
+	blockHash, err := response.BlockHash()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(blockHash[:], expectedBlockHash[:]) {
+		return nil, fmt.Errorf("execution payload has block hash %#x; expected %#x", blockHash[:], expectedBlockHash[:])
+	}
+
+	return response, nil
+}
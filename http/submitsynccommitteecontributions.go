@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/pkg/errors"
@@ -24,12 +22,7 @@ import (
 
 // SubmitSyncCommitteeContributions submits sync committee contributions.
 func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
-	specJSON, err := json.Marshal(contributionAndProofs)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-
-	_, err = s.post(ctx, "/eth/v1/validator/contribution_and_proofs", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/validator/contribution_and_proofs", contributionAndProofs, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit contribution and proofs")
 	}
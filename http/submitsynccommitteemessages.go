@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/pkg/errors"
@@ -24,12 +22,7 @@ import (
 
 // SubmitSyncCommitteeMessages submits sync committee messages.
 func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
-	specJSON, err := json.Marshal(messages)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-
-	_, err = s.post(ctx, "/eth/v1/beacon/pool/sync_committees", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/beacon/pool/sync_committees", messages, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit sync committee messages")
 	}
@@ -14,9 +14,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -54,11 +52,7 @@ func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registration
 		}
 	}
 
-	specJSON, err := json.Marshal(unversionedRegistrations)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal JSON")
-	}
-	_, err = s.post(ctx, "/eth/v1/validator/register_validator", bytes.NewBuffer(specJSON))
+	_, err := s.postJSON(ctx, "/eth/v1/validator/register_validator", unversionedRegistrations, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed to submit validator registration")
 	}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitVersionedAttestations submits versioned attestations.
+// Attestations prior to Electra are submitted to the v1 endpoint; Electra attestations
+// are submitted to the v2 endpoint as single attestations along with their consensus version,
+// so must each have a single aggregation bit set and their validator index supplied.
+func (s *Service) SubmitVersionedAttestations(ctx context.Context, attestations []*spec.VersionedAttestation) error {
+	if len(attestations) == 0 {
+		return errors.New("no attestations supplied")
+	}
+
+	version := attestations[0].Version
+	for i := range attestations {
+		if attestations[i] == nil {
+			return fmt.Errorf("attestation %d is nil", i)
+		}
+		if attestations[i].Version != version {
+			return errors.New("attestations must all be of the same version")
+		}
+	}
+
+	switch version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair, spec.DataVersionBellatrix, spec.DataVersionCapella, spec.DataVersionDeneb:
+		return s.submitPhase0Attestations(ctx, attestations)
+	case spec.DataVersionElectra:
+		return s.submitElectraAttestations(ctx, attestations)
+	default:
+		return fmt.Errorf("unsupported attestation version %s", version)
+	}
+}
+
+func (s *Service) submitPhase0Attestations(ctx context.Context, attestations []*spec.VersionedAttestation) error {
+	phase0Attestations := make([]*phase0.Attestation, len(attestations))
+	for i := range attestations {
+		var attestation *phase0.Attestation
+		switch attestations[i].Version {
+		case spec.DataVersionPhase0:
+			attestation = attestations[i].Phase0
+		case spec.DataVersionAltair:
+			attestation = attestations[i].Altair
+		case spec.DataVersionBellatrix:
+			attestation = attestations[i].Bellatrix
+		case spec.DataVersionCapella:
+			attestation = attestations[i].Capella
+		case spec.DataVersionDeneb:
+			attestation = attestations[i].Deneb
+		}
+		if attestation == nil {
+			return fmt.Errorf("attestation %d has no %s data", i, attestations[i].Version)
+		}
+		phase0Attestations[i] = attestation
+	}
+
+	_, err := s.postJSON(ctx, "/eth/v1/beacon/pool/attestations", phase0Attestations, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit beacon attestations")
+	}
+
+	return nil
+}
+
+func (s *Service) submitElectraAttestations(ctx context.Context, attestations []*spec.VersionedAttestation) error {
+	singleAttestations := make([]*electra.SingleAttestation, len(attestations))
+	for i := range attestations {
+		singleAttestation, err := electraSingleAttestation(attestations[i])
+		if err != nil {
+			return errors.Wrapf(err, "attestation %d", i)
+		}
+		singleAttestations[i] = singleAttestation
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": spec.DataVersionElectra.String(),
+	}
+	_, err := s.postJSON(ctx, "/eth/v2/beacon/pool/attestations", singleAttestations, headers)
+	if err != nil {
+		return errors.Wrap(err, "failed to submit beacon attestations")
+	}
+
+	return nil
+}
+
+// electraSingleAttestation converts an unaggregated Electra attestation to the single
+// attestation required by the v2 pool endpoint.
+func electraSingleAttestation(attestation *spec.VersionedAttestation) (*electra.SingleAttestation, error) {
+	if attestation.Electra == nil {
+		return nil, errors.New("no electra data")
+	}
+	if attestation.ValidatorIndex == nil {
+		return nil, errors.New("no validator index")
+	}
+	if attestation.Electra.AggregationBits.Count() != 1 {
+		return nil, errors.New("not a single attestation")
+	}
+	committeeIndex, err := attestation.CommitteeIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	return &electra.SingleAttestation{
+		CommitteeIndex: committeeIndex,
+		AttesterIndex:  *attestation.ValidatorIndex,
+		Data:           attestation.Electra.Data,
+		Signature:      attestation.Electra.Signature,
+	}, nil
+}
@@ -0,0 +1,148 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitVersionedAttestations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var path string
+	var consensusVersion string
+	var body []byte
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		path = r.URL.Path
+		consensusVersion = r.Header.Get("Eth-Consensus-Version")
+		body, _ = io.ReadAll(r.Body)
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	denebAttestation := &phase0.Attestation{}
+	require.NoError(t, json.Unmarshal([]byte(testPhase0AttestationJSON(1, 2)), denebAttestation))
+	electraAttestation := &electra.Attestation{}
+	require.NoError(t, json.Unmarshal([]byte(testElectraAttestationJSON(3200, 2)), electraAttestation))
+	electraAggregate := &electra.Attestation{}
+	require.NoError(t, json.Unmarshal([]byte(testElectraAttestationJSON(3200, 2)), electraAggregate))
+	electraAggregate.AggregationBits = bitfield.Bitlist{0x07}
+	validatorIndex := phase0.ValidatorIndex(123)
+
+	tests := []struct {
+		name                     string
+		attestations             []*spec.VersionedAttestation
+		expectedPath             string
+		expectedConsensusVersion string
+		err                      string
+	}{
+		{
+			name: "Empty",
+			err:  "no attestations supplied",
+		},
+		{
+			name: "Deneb",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionDeneb, Deneb: denebAttestation},
+			},
+			expectedPath: "/eth/v1/beacon/pool/attestations",
+		},
+		{
+			name: "Electra",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, ValidatorIndex: &validatorIndex, Electra: electraAttestation},
+			},
+			expectedPath:             "/eth/v2/beacon/pool/attestations",
+			expectedConsensusVersion: "electra",
+		},
+		{
+			name: "MixedVersions",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionDeneb, Deneb: denebAttestation},
+				{Version: spec.DataVersionElectra, ValidatorIndex: &validatorIndex, Electra: electraAttestation},
+			},
+			err: "attestations must all be of the same version",
+		},
+		{
+			name: "MissingData",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, ValidatorIndex: &validatorIndex},
+			},
+			err: "attestation 0: no electra data",
+		},
+		{
+			name: "MissingValidatorIndex",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, Electra: electraAttestation},
+			},
+			err: "attestation 0: no validator index",
+		},
+		{
+			name: "Aggregate",
+			attestations: []*spec.VersionedAttestation{
+				{Version: spec.DataVersionElectra, ValidatorIndex: &validatorIndex, Electra: electraAggregate},
+			},
+			err: "attestation 0: not a single attestation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := service.(client.VersionedAttestationsSubmitter).SubmitVersionedAttestations(ctx, test.attestations)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, test.expectedPath, path)
+			require.Equal(t, test.expectedConsensusVersion, consensusVersion)
+			var submitted []map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &submitted))
+			require.Len(t, submitted, len(test.attestations))
+			if test.expectedConsensusVersion == "electra" {
+				// Electra attestations are submitted as single attestations.
+				require.Equal(t, "2", submitted[0]["committee_index"])
+				require.Equal(t, "123", submitted[0]["attester_index"])
+				require.NotContains(t, submitted[0], "aggregation_bits")
+			} else {
+				require.Contains(t, submitted[0], "aggregation_bits")
+			}
+		})
+	}
+}
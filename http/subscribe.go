@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// eventSubscription is a handle to an active events stream.
+type eventSubscription struct {
+	cancel    context.CancelFunc
+	done      <-chan struct{}
+	once      sync.Once
+	overflows atomic.Uint64
+}
+
+// Close stops the subscription, returning once its events stream has been torn down.
+func (e *eventSubscription) Close() {
+	e.once.Do(e.cancel)
+	<-e.done
+}
+
+// Done returns a channel that is closed once the events stream has been torn down.
+func (e *eventSubscription) Done() <-chan struct{} {
+	return e.done
+}
+
+// Overflows returns the number of events dropped by the subscription because its
+// buffer was full.
+func (e *eventSubscription) Overflows() uint64 {
+	return e.overflows.Load()
+}
+
+// Subscribe feeds requested events with the given topics to the supplied handler
+// until either the context is done or the returned subscription is closed.
+func (s *Service) Subscribe(ctx context.Context,
+	topics []string,
+	handler client.EventHandlerFunc,
+) (
+	client.EventSubscription,
+	error,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	subscription := &eventSubscription{
+		cancel: cancel,
+	}
+	done, err := s.events(ctx, topics, handler, &subscription.overflows)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+	subscription.done = done
+
+	return subscription, nil
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+// newStreamingServer returns a server that sends a single head event on each
+// connection and then holds the stream open until the client goes away.
+func newStreamingServer(t *testing.T, streams *sync.WaitGroup) string {
+	t.Helper()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		streams.Add(1)
+		defer streams.Done()
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: head\ndata: {\"slot\":\"4095943\",\"block\":\"0x1c3981b7439cd2dc53dca1a99122e1cacb36a13796d426d4c8a03ba745cb0c8b\",\"state\":\"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28\",\"epoch_transition\":false,\"current_duty_dependent_root\":\"0x907a3462a2905e3df2624869aa7f9a8635eb35bdcf9ce68a26fab691f9dada61\",\"previous_duty_dependent_root\":\"0x935569bdc1aaad65dbeb532a125390d039058924ea81799238ed53e4e4639a11\",\"execution_optimistic\":false}\n\n"))
+		w.(nethttp.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	return srv.URL
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var streams sync.WaitGroup
+	address := newStreamingServer(t, &streams)
+
+	service, err := http.New(ctx,
+		http.WithAddress(address),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(10*time.Millisecond, 40*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	_, err = service.(client.EventsSubscriber).Subscribe(ctx, []string{}, func(*api.Event) {})
+	require.EqualError(t, err, "no topics supplied")
+
+	received := make(chan struct{}, 1)
+	subscription, err := service.(client.EventsSubscriber).Subscribe(ctx, []string{"head"}, func(*api.Event) {
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no event received")
+	}
+
+	subscription.Close()
+	// Closing again is a no-op.
+	subscription.Close()
+
+	// The stream has been torn down.
+	streams.Wait()
+}
+
+func TestServiceClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var streams sync.WaitGroup
+	address := newStreamingServer(t, &streams)
+
+	service, err := http.New(ctx,
+		http.WithAddress(address),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+		http.WithEventsReconnectDelay(10*time.Millisecond, 40*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	events := 0
+	handler := func(*api.Event) {
+		mu.Lock()
+		events++
+		mu.Unlock()
+	}
+	require.NoError(t, service.(client.EventsProvider).Events(ctx, []string{"head"}, handler))
+	subscription, err := service.(client.EventsSubscriber).Subscribe(ctx, []string{"head"}, handler)
+	require.NoError(t, err)
+	heads, err := service.(client.TypedEventsProvider).SubscribeHead(ctx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return events >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	service.(*http.Service).Close()
+	streams.Wait()
+
+	// Closing the service tears down subscriptions and typed event streams.
+	<-subscription.Done()
+	require.Eventually(t, func() bool {
+		select {
+		case _, open := <-heads:
+			return !open
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.EqualError(t, service.(client.EventsProvider).Events(ctx, []string{"head"}, handler), "service closed")
+	_, err = service.(client.EventsSubscriber).Subscribe(ctx, []string{"head"}, handler)
+	require.EqualError(t, err, "service closed")
+}
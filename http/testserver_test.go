@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+// staticResponses are the responses to the requests made by the service when it starts.
+var staticResponses = map[string]string{
+	"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+	"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","ALTAIR_FORK_EPOCH":"0","BELLATRIX_FORK_EPOCH":"0","CAPELLA_FORK_EPOCH":"0","DENEB_FORK_EPOCH":"0","ELECTRA_FORK_EPOCH":"100"}}`,
+	"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+	"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+	"/eth/v1/node/version":            `{"data":{"version":"test/v1.0.0"}}`,
+}
+
+// newTestServer creates a test server that responds to the requests made by the service
+// when it starts, passing all other requests to the supplied handler.
+func newTestServer(t *testing.T, handler nethttp.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(testHandler(handler))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// newUnixTestServer creates a test server as per newTestServer, listening on a
+// Unix domain socket.  It returns the path of the socket.
+func newUnixTestServer(t *testing.T, handler nethttp.HandlerFunc) string {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "bn.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("failed to listen on Unix socket: %v", err)
+	}
+	srv := httptest.NewUnstartedServer(testHandler(handler))
+	srv.Listener = listener
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	return socketPath
+}
+
+// testHandler responds to the requests made by the service when it starts, passing
+// all other requests to the supplied handler.
+func testHandler(handler nethttp.HandlerFunc) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if response, exists := staticResponses[r.URL.Path]; exists && r.Method == nethttp.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		if handler == nil {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	})
+}
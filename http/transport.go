@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// unixScheme is the scheme for addresses that refer to a Unix domain socket.
+const unixScheme = "unix://"
+
+// unixSocketBase is the base URL used for requests sent over a Unix domain socket.
+// The host is ignored when dialling, but is required to construct valid requests.
+const unixSocketBase = "http://localhost/"
+
+// unixSocketPath returns the path of the Unix domain socket referred to by the
+// address, if any.
+func unixSocketPath(address string) (string, bool) {
+	if !strings.HasPrefix(address, unixScheme) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(address, unixScheme), true
+}
+
+// dialContext returns a dial function that connects to the Unix domain socket
+// at the given path if supplied, or else to the requested network address.
+func dialContext(timeout time.Duration, keepAlive time.Duration, socketPath string) func(ctx context.Context, network string, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: keepAlive,
+		DualStack: true,
+	}
+	if socketPath == "" {
+		return dialer.DialContext
+	}
+
+	return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, "unix", socketPath)
+	}
+}
+
+// newTLSConfig creates the TLS configuration for connections to the endpoint.
+// It returns nil if the default configuration should be used.
+func newTLSConfig(parameters *parameters) (*tls.Config, error) {
+	if len(parameters.clientCert) == 0 && len(parameters.caCerts) == 0 {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if len(parameters.clientCert) > 0 {
+		cert, err := tls.X509KeyPair(parameters.clientCert, parameters.clientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if len(parameters.caCerts) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(parameters.caCerts) {
+			return nil, errors.New("no valid CA certificates supplied")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// parseProxyURL parses the URL of the proxy through which to connect to the endpoint.
+// It returns nil if no proxy is configured.
+func parseProxyURL(proxyURL string) (*url.URL, error) {
+	if proxyURL == "" {
+		return nil, nil
+	}
+
+	proxy, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	switch proxy.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q", proxy.Scheme)
+	}
+	if proxy.Host == "" {
+		return nil, errors.New("no proxy host specified")
+	}
+
+	return proxy, nil
+}
+
+// proxyFunc returns the proxy function for transports, or nil if no proxy is configured.
+func proxyFunc(proxy *url.URL) func(*http.Request) (*url.URL, error) {
+	if proxy == nil {
+		return nil
+	}
+
+	return http.ProxyURL(proxy)
+}
+
+// newTransport creates the transport used by the default HTTP client.
+func newTransport(parameters *parameters, socketPath string, tlsConfig *tls.Config, proxy *url.URL) *http.Transport {
+	return &http.Transport{
+		Proxy:               proxyFunc(proxy),
+		TLSClientConfig:     tlsConfig,
+		DialContext:         dialContext(parameters.timeout, 30*time.Second, socketPath),
+		MaxIdleConns:        parameters.maxIdleConns,
+		MaxConnsPerHost:     parameters.maxConnsPerHost,
+		MaxIdleConnsPerHost: parameters.maxIdleConns,
+		IdleConnTimeout:     parameters.idleConnTimeout,
+		TLSHandshakeTimeout: parameters.tlsHandshakeTimeout,
+		// HTTP/2 is not attempted by default for transports with a custom dialer,
+		// so it must be requested explicitly.
+		ForceAttemptHTTP2: parameters.http2,
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnixSocket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	socketPath := newUnixTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/node/syncing" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"head_slot":"12345","sync_distance":"0","is_syncing":false,"is_optimistic":false}}`))
+	})
+
+	_, err := http.New(ctx, http.WithAddress("unix://"))
+	require.EqualError(t, err, "no Unix socket path specified")
+
+	service, err := http.New(ctx, http.WithAddress("unix://"+socketPath))
+	require.NoError(t, err)
+	require.Equal(t, "unix://"+socketPath, service.Address())
+
+	syncState, err := service.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12345), uint64(syncState.HeadSlot))
+}
+
+// generateClientCertificate generates a self-signed client certificate, returning
+// the PEM-encoded certificate and key.
+func generateClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestMutualTLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientCert, clientKey := generateClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCert))
+
+	srv := httptest.NewUnstartedServer(testHandler(nil))
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+	caCerts := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	tests := []struct {
+		name   string
+		params []http.Parameter
+		err    string
+	}{
+		{
+			name: "KeyMissing",
+			params: []http.Parameter{
+				http.WithClientCertificate(clientCert, nil),
+				http.WithCACertificates(caCerts),
+			},
+			err: "problem with parameters: client certificate and key must be supplied together",
+		},
+		{
+			name: "CertificateInvalid",
+			params: []http.Parameter{
+				http.WithClientCertificate([]byte("bad"), clientKey),
+				http.WithCACertificates(caCerts),
+			},
+			err: "invalid TLS configuration: invalid client certificate: tls: failed to find any PEM data in certificate input",
+		},
+		{
+			name: "CACertificatesInvalid",
+			params: []http.Parameter{
+				http.WithClientCertificate(clientCert, clientKey),
+				http.WithCACertificates([]byte("bad")),
+			},
+			err: "invalid TLS configuration: no valid CA certificates supplied",
+		},
+		{
+			name: "ClientCertificateMissing",
+			params: []http.Parameter{
+				http.WithCACertificates(caCerts),
+			},
+			err: "failed to confirm node connection",
+		},
+		{
+			name: "Good",
+			params: []http.Parameter{
+				http.WithClientCertificate(clientCert, clientKey),
+				http.WithCACertificates(caCerts),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := http.New(ctx, append(test.params, http.WithAddress(srv.URL))...)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var proxyAuthorization string
+	var proxiedHost string
+	proxy := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		proxyAuthorization = r.Header.Get("Proxy-Authorization")
+		proxiedHost = r.URL.Host
+		testHandler(nil).ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	tests := []struct {
+		name     string
+		address  string
+		proxyURL string
+		err      string
+	}{
+		{
+			name:     "SchemeUnsupported",
+			address:  "http://beacon.invalid:5052",
+			proxyURL: "ftp://proxy.invalid:21",
+			err:      `invalid proxy URL: unsupported proxy scheme "ftp"`,
+		},
+		{
+			name:     "HostMissing",
+			address:  "http://beacon.invalid:5052",
+			proxyURL: "socks5://",
+			err:      "invalid proxy URL: no proxy host specified",
+		},
+		{
+			name:     "UnixSocket",
+			address:  "unix:///tmp/bn.sock",
+			proxyURL: "socks5://proxy.invalid:1080",
+			err:      "cannot use a proxy with a Unix socket address",
+		},
+		{
+			name:     "Good",
+			address:  "http://beacon.invalid:5052",
+			proxyURL: strings.Replace(proxy.URL, "http://", "http://user:secret@", 1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := http.New(ctx,
+				http.WithAddress(test.address),
+				http.WithProxyURL(test.proxyURL),
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "beacon.invalid:5052", proxiedHost)
+				require.Equal(t, "Basic dXNlcjpzZWNyZXQ=", proxyAuthorization)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/events"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SubscribeHead provides a stream of head events.
+func (s *Service) SubscribeHead(ctx context.Context) (<-chan *apiv1.HeadEvent, error) {
+	return events.Subscribe[*apiv1.HeadEvent](ctx, s, apiv1.EventTopicHead)
+}
+
+// SubscribeBlock provides a stream of block events.
+func (s *Service) SubscribeBlock(ctx context.Context) (<-chan *apiv1.BlockEvent, error) {
+	return events.Subscribe[*apiv1.BlockEvent](ctx, s, apiv1.EventTopicBlock)
+}
+
+// SubscribeFinalizedCheckpoint provides a stream of finalized checkpoint events.
+func (s *Service) SubscribeFinalizedCheckpoint(ctx context.Context) (<-chan *apiv1.FinalizedCheckpointEvent, error) {
+	return events.Subscribe[*apiv1.FinalizedCheckpointEvent](ctx, s, apiv1.EventTopicFinalizedCheckpoint)
+}
+
+// SubscribeChainReorg provides a stream of chain reorganisation events.
+func (s *Service) SubscribeChainReorg(ctx context.Context) (<-chan *apiv1.ChainReorgEvent, error) {
+	return events.Subscribe[*apiv1.ChainReorgEvent](ctx, s, apiv1.EventTopicChainReorg)
+}
+
+// SubscribePayloadAttributes provides a stream of payload attributes events.
+func (s *Service) SubscribePayloadAttributes(ctx context.Context) (<-chan *apiv1.PayloadAttributesEvent, error) {
+	return events.Subscribe[*apiv1.PayloadAttributesEvent](ctx, s, apiv1.EventTopicPayloadAttributes)
+}
+
+// SubscribeBlobSidecar provides a stream of blob sidecar events.
+func (s *Service) SubscribeBlobSidecar(ctx context.Context) (<-chan *apiv1.BlobSidecarEvent, error) {
+	return events.Subscribe[*apiv1.BlobSidecarEvent](ctx, s, apiv1.EventTopicBlobSidecar)
+}
+
+// SubscribeProposerSlashing provides a stream of proposer slashings.
+func (s *Service) SubscribeProposerSlashing(ctx context.Context) (<-chan *phase0.ProposerSlashing, error) {
+	return events.Subscribe[*phase0.ProposerSlashing](ctx, s, apiv1.EventTopicProposerSlashing)
+}
+
+// SubscribeAttesterSlashing provides a stream of attester slashings.
+func (s *Service) SubscribeAttesterSlashing(ctx context.Context) (<-chan *phase0.AttesterSlashing, error) {
+	return events.Subscribe[*phase0.AttesterSlashing](ctx, s, apiv1.EventTopicAttesterSlashing)
+}
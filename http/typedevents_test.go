@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeHead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		require.Equal(t, []string{"head"}, r.URL.Query()["topics"])
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: head\ndata: {\"slot\":\"4095943\",\"block\":\"0x1c3981b7439cd2dc53dca1a99122e1cacb36a13796d426d4c8a03ba745cb0c8b\",\"state\":\"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28\",\"epoch_transition\":false,\"current_duty_dependent_root\":\"0x907a3462a2905e3df2624869aa7f9a8635eb35bdcf9ce68a26fab691f9dada61\",\"previous_duty_dependent_root\":\"0x935569bdc1aaad65dbeb532a125390d039058924ea81799238ed53e4e4639a11\",\"execution_optimistic\":false}\n\n"))
+		w.(nethttp.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	ch, err := service.(client.TypedEventsProvider).SubscribeHead(subCtx)
+	require.NoError(t, err)
+
+	select {
+	case event := <-ch:
+		require.Equal(t, phase0.Slot(4095943), event.Slot)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no head event received")
+	}
+
+	subCancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, open := <-ch:
+			return !open
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSubscribePayloadAttributes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		require.Equal(t, []string{"payload_attributes"}, r.URL.Query()["topics"])
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: payload_attributes\ndata: " + "{\"version\":\"deneb\",\"data\":{\"proposer_index\":\"123\",\"proposal_slot\":\"10\",\"parent_block_number\":\"9\",\"parent_block_root\":\"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2\",\"parent_block_hash\":\"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf\",\"payload_attributes\":{\"timestamp\":\"123456\",\"prev_randao\":\"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2\",\"suggested_fee_recipient\":\"0x0000000000000000000000000000000000000000\",\"withdrawals\":[{\"index\":\"5\",\"validator_index\":\"10\",\"address\":\"0x0000000000000000000000000000000000000000\",\"amount\":\"15640\"}],\"parent_beacon_block_root\":\"0xba4d784293df28bab771a14df58cdbed9d8d64afd0ddf1c52dff3e25fcdd51df\"}}}" + "\n\n"))
+		w.(nethttp.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	ch, err := service.(client.TypedEventsProvider).SubscribePayloadAttributes(ctx)
+	require.NoError(t, err)
+
+	select {
+	case event := <-ch:
+		require.Equal(t, spec.DataVersionDeneb, event.Version)
+		require.Equal(t, phase0.Slot(10), event.Data.ProposalSlot)
+		require.NotNil(t, event.Data.V3)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no payload attributes event received")
+	}
+}
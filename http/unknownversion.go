@@ -0,0 +1,36 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// unknownVersionError reads the remainder of a streamed response of an unknown
+// version, returning it in an error for the caller.
+func (*Service) unknownVersionError(res *httpStreamResponse, version string) error {
+	defer res.Close()
+
+	data, err := res.readAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to read body")
+	}
+
+	return &api.UnknownVersionError{
+		Version:     version,
+		ContentType: res.contentType.MediaType(),
+		Data:        data,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowUnknownVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	jsonBody := []byte(`{"version":"fulu","execution_optimistic":false,"finalized":false,"data":{"message":{}}}`)
+	sszBody := []byte{0x01, 0x02, 0x03, 0x04}
+
+	tests := []struct {
+		name                 string
+		allowUnknownVersions bool
+		header               string
+		contentType          string
+		body                 []byte
+		err                  string
+		expectedContentType  string
+	}{
+		{
+			name:        "HeaderDisallowed",
+			header:      "fulu",
+			contentType: "application/json",
+			body:        jsonBody,
+			err:         "failed to request signed beacon block: failed to parse consensus version: unrecognised data version \"fulu\"",
+		},
+		{
+			name:                 "HeaderJSON",
+			allowUnknownVersions: true,
+			header:               "fulu",
+			contentType:          "application/json",
+			body:                 jsonBody,
+			expectedContentType:  "application/json",
+		},
+		{
+			name:                 "HeaderSSZ",
+			allowUnknownVersions: true,
+			header:               "fulu",
+			contentType:          "application/octet-stream",
+			body:                 sszBody,
+			expectedContentType:  "application/octet-stream",
+		},
+		{
+			name:        "BodyDisallowed",
+			contentType: "application/json",
+			body:        jsonBody,
+			err:         "failed to request signed beacon block: failed to parse consensus version: failed to parse consensus version: unrecognised data version \"fulu\"",
+		},
+		{
+			name:                 "Body",
+			allowUnknownVersions: true,
+			contentType:          "application/json",
+			body:                 jsonBody,
+			expectedContentType:  "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v2/beacon/blocks/head" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				if test.header != "" {
+					w.Header().Set("Eth-Consensus-Version", test.header)
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				_, _ = w.Write(test.body)
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithConfirmConnection(false),
+				http.WithAllowUnknownVersions(test.allowUnknownVersions),
+			)
+			require.NoError(t, err)
+
+			_, err = service.(*http.Service).SignedBeaconBlock(ctx, "head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.ErrorIs(t, err, api.ErrUnsupportedVersion)
+			var unknownVersionErr *api.UnknownVersionError
+			require.True(t, errors.As(err, &unknownVersionErr))
+			require.Equal(t, "fulu", unknownVersionErr.Version)
+			require.Equal(t, test.expectedContentType, unknownVersionErr.ContentType)
+			require.Equal(t, test.body, unknownVersionErr.Data)
+		})
+	}
+}
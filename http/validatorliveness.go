@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorLivenessJSON struct {
+	Data []*api.ValidatorLiveness `json:"data"`
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Service) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*api.ValidatorLiveness, error) {
+	if len(validatorIndices) == 0 {
+		return nil, errors.New("no validator indices specified")
+	}
+
+	indices := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		indices[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+	reqBody, err := json.Marshal(indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator indices")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator liveness")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain validator liveness")
+	}
+
+	var validatorLivenessJSON validatorLivenessJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&validatorLivenessJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator liveness")
+	}
+	if validatorLivenessJSON.Data == nil {
+		return nil, errors.New("no validator liveness returned")
+	}
+
+	return validatorLivenessJSON.Data, nil
+}
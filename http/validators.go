@@ -24,10 +24,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-type validatorsJSON struct {
-	Data []*api.Validator `json:"data"`
-}
-
 // indexChunkSizes defines the per-beacon-node size of an index chunk.
 // A request should be no more than 8,000 bytes to work with all currently-supported clients.
 // An index has variable size, but assuming 7 characters, including the comma separator, is safe.
@@ -79,7 +75,22 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return s.validatorsFromState(ctx, stateID)
 	}
 
-	if len(validatorIndices) > s.indexChunkSize(ctx) {
+	indexChunkSize := s.indexChunkSize(ctx)
+	if s.useValidatorsPost(len(validatorIndices), indexChunkSize) {
+		ids := make([]string, len(validatorIndices))
+		for i := range validatorIndices {
+			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+		}
+		res, err := s.validatorsPost(ctx, stateID, ids)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+	}
+
+	if len(validatorIndices) > indexChunkSize {
 		return s.chunkedValidators(ctx, stateID, validatorIndices)
 	}
 
@@ -92,26 +103,42 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
 	}
 
-	respBodyReader, err := s.get(ctx, url)
+	// The response can be large, so validators are decoded as they are received.
+	stream, err := s.getStream(ctx, url, ContentTypeJSON.MediaType())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
-	if respBodyReader == nil {
+	defer stream.Close()
+	if stream.body == nil {
 		return nil, errors.New("failed to obtain validators")
 	}
 
-	var validatorsJSON validatorsJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsJSON); err != nil {
+	var res map[phase0.ValidatorIndex]*api.Validator
+	err = decodeJSONObject(json.NewDecoder(stream.body), func(key string, decoder *json.Decoder) error {
+		if key != "data" {
+			return skipJSONValue(decoder)
+		}
+		if err := expectJSONDelim(decoder, '['); err != nil {
+			return err
+		}
+		res = make(map[phase0.ValidatorIndex]*api.Validator)
+		for decoder.More() {
+			validator := &api.Validator{}
+			if err := decoder.Decode(validator); err != nil {
+				return err
+			}
+			res[validator.Index] = validator
+		}
+
+		return expectJSONDelim(decoder, ']')
+	})
+	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
 	}
-	if validatorsJSON.Data == nil {
+	if res == nil {
 		return nil, errors.New("no validators returned")
 	}
 
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	for _, validator := range validatorsJSON.Data {
-		res[validator.Index] = validator
-	}
 	return res, nil
 }
 
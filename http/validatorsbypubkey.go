@@ -76,7 +76,22 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 		return nil, errors.New("no state ID specified")
 	}
 
-	if len(validatorPubKeys) > s.pubKeyChunkSize(ctx) {
+	pubKeyChunkSize := s.pubKeyChunkSize(ctx)
+	if s.useValidatorsPost(len(validatorPubKeys), pubKeyChunkSize) {
+		ids := make([]string, len(validatorPubKeys))
+		for i := range validatorPubKeys {
+			ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
+		}
+		res, err := s.validatorsPost(ctx, stateID, ids)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+	}
+
+	if len(validatorPubKeys) > pubKeyChunkSize {
 		return s.chunkedValidatorsByPubKey(ctx, stateID, validatorPubKeys)
 	}
 
@@ -0,0 +1,113 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorsPostRequestJSON struct {
+	IDs []string `json:"ids"`
+}
+
+type validatorsPostJSON struct {
+	Data []*api.Validator `json:"data"`
+}
+
+// useValidatorsPost returns true if a request for the given number of validators should use POST.
+func (s *Service) useValidatorsPost(count int, chunkSize int) bool {
+	threshold := s.userValidatorsPostThreshold
+	if threshold <= 0 {
+		threshold = chunkSize
+	}
+	if count <= threshold {
+		return false
+	}
+
+	s.validatorsPostUnsupportedMutex.RLock()
+	defer s.validatorsPostUnsupportedMutex.RUnlock()
+
+	return !s.validatorsPostUnsupported
+}
+
+// validatorsPost fetches validators using the POST variant of the validators endpoint,
+// which allows large numbers of IDs to be supplied without hitting URL length limits.
+// If the node does not support the POST variant, either by rejecting the method or by
+// returning a 404 that does not refer to the state, this returns nil, and the service
+// remembers this for future requests.
+func (s *Service) validatorsPost(ctx context.Context, stateID string, ids []string) (map[phase0.ValidatorIndex]*api.Validator, error) {
+	reqData, err := json.Marshal(&validatorsPostRequestJSON{IDs: ids})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request data")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID), bytes.NewReader(reqData))
+	if err != nil {
+		var apiErr Error
+		if errors.As(err, &apiErr) && validatorsPostNotRouted(apiErr) {
+			s.log.Debug().Msg("Node does not support POST for validators; falling back to GET")
+			s.validatorsPostUnsupportedMutex.Lock()
+			s.validatorsPostUnsupported = true
+			s.validatorsPostUnsupportedMutex.Unlock()
+
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to request validators")
+	}
+
+	var validatorsPostJSON validatorsPostJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&validatorsPostJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validators")
+	}
+	if validatorsPostJSON.Data == nil {
+		return nil, errors.New("no validators returned")
+	}
+
+	res := make(map[phase0.ValidatorIndex]*api.Validator, len(validatorsPostJSON.Data))
+	for _, validator := range validatorsPostJSON.Data {
+		res[validator.Index] = validator
+	}
+
+	return res, nil
+}
+
+// validatorsPostNotRouted returns true if the error shows that the node does not route
+// POST requests for validators.  Some nodes answer an unrouted POST with a 404, so a 404
+// counts unless its message refers to the state, in which case it is a genuine
+// state-not-found response.
+func validatorsPostNotRouted(apiErr Error) bool {
+	switch apiErr.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	case http.StatusNotFound:
+		message := apiErr.Message
+		if message == "" {
+			message = string(apiErr.Data)
+		}
+
+		return !strings.Contains(strings.ToLower(message), "state")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsPost(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	validators := make(map[string]*apiv1.Validator)
+	for i := 0; i < 3; i++ {
+		validators[fmt.Sprintf("%d", i)] = &apiv1.Validator{
+			Index:   phase0.ValidatorIndex(i),
+			Balance: 32000000000,
+			Status:  apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:                  phase0.BLSPubKey{byte(i)},
+				WithdrawalCredentials:      make([]byte, 32),
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 0,
+				ActivationEpoch:            0,
+				ExitEpoch:                  0xffffffffffffffff,
+				WithdrawableEpoch:          0xffffffffffffffff,
+			},
+		}
+	}
+
+	respond := func(w nethttp.ResponseWriter, ids []string) {
+		data := make([]*apiv1.Validator, 0, len(ids))
+		for _, id := range ids {
+			data = append(data, validators[id])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&struct {
+			Data []*apiv1.Validator `json:"data"`
+		}{
+			Data: data,
+		})
+	}
+
+	tests := []struct {
+		name          string
+		postSupported bool
+		postStatus    int
+		postBody      string
+		posts         int32
+		gets          int32
+		err           error
+	}{
+		{
+			name:          "PostSupported",
+			postSupported: true,
+			posts:         2,
+		},
+		{
+			name:       "PostUnsupported",
+			postStatus: nethttp.StatusMethodNotAllowed,
+			posts:      1,
+			gets:       4,
+		},
+		{
+			name:       "PostNotRouted",
+			postStatus: nethttp.StatusNotFound,
+			postBody:   "404 page not found",
+			posts:      1,
+			gets:       4,
+		},
+		{
+			name:       "StateNotFound",
+			postStatus: nethttp.StatusNotFound,
+			postBody:   `{"code":404,"message":"State not found"}`,
+			posts:      2,
+			err:        api.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var posts, gets atomic.Int32
+			srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if r.URL.Path != "/eth/v1/beacon/states/head/validators" {
+					w.WriteHeader(nethttp.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case nethttp.MethodPost:
+					posts.Add(1)
+					if !test.postSupported {
+						w.WriteHeader(test.postStatus)
+						_, _ = w.Write([]byte(test.postBody))
+						return
+					}
+					var req struct {
+						IDs []string `json:"ids"`
+					}
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						w.WriteHeader(nethttp.StatusBadRequest)
+						return
+					}
+					respond(w, req.IDs)
+				case nethttp.MethodGet:
+					gets.Add(1)
+					respond(w, strings.Split(r.URL.Query().Get("id"), ","))
+				}
+			})
+
+			service, err := http.New(ctx,
+				http.WithAddress(srv.URL),
+				http.WithTimeout(timeout),
+				http.WithIndexChunkSize(2),
+			)
+			require.NoError(t, err)
+
+			indices := []phase0.ValidatorIndex{0, 1, 2}
+			// Request twice, to confirm that lack of support for POST is remembered.
+			for i := 0; i < 2; i++ {
+				res, err := service.(client.ValidatorsProvider).Validators(ctx, "head", indices)
+				if test.err != nil {
+					require.ErrorIs(t, err, test.err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Len(t, res, 3)
+				for _, index := range indices {
+					require.Equal(t, validators[fmt.Sprintf("%d", index)], res[index])
+				}
+			}
+			require.Equal(t, test.posts, posts.Load())
+			require.Equal(t, test.gets, gets.Load())
+		})
+	}
+}
+
+func TestValidatorsPostThreshold(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var posts atomic.Int32
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method == nethttp.MethodPost {
+			posts.Add(1)
+		}
+		w.WriteHeader(nethttp.StatusMethodNotAllowed)
+	})
+
+	_, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithValidatorsPostThreshold(0),
+	)
+	require.EqualError(t, err, "problem with parameters: no validators POST threshold specified")
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithValidatorsPostThreshold(1),
+	)
+	require.NoError(t, err)
+
+	// A single validator is below the threshold, so should not be requested with POST.
+	_, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0})
+	require.Error(t, err)
+	require.Equal(t, int32(0), posts.Load())
+
+	// Two validators is above the threshold, so should be requested with POST.
+	_, err = service.(client.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{0, 1})
+	require.Error(t, err)
+	require.Equal(t, int32(1), posts.Load())
+}
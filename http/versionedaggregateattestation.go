@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VersionedAggregateAttestation fetches the aggregate attestation for the given slot, attestation data root
+// and committee index.
+func (s *Service) VersionedAggregateAttestation(ctx context.Context,
+	slot phase0.Slot,
+	attestationDataRoot phase0.Root,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	version, err := s.dataVersionAtSlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain data version")
+	}
+
+	var aggregate *spec.VersionedAttestation
+	if version >= spec.DataVersionElectra {
+		aggregate, err = s.electraAggregateAttestation(ctx, slot, attestationDataRoot, committeeIndex)
+	} else {
+		aggregate, err = s.phase0AggregateAttestation(ctx, version, slot, attestationDataRoot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if aggregate == nil {
+		return nil, nil
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	data, err := aggregate.Data()
+	if err != nil {
+		return nil, errors.Wrap(err, "aggregate attestation invalid")
+	}
+	if data.Slot != slot {
+		return nil, errors.New("aggregate attestation not for requested slot")
+	}
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain hash tree root of aggregate attestation data")
+	}
+	if !bytes.Equal(dataRoot[:], attestationDataRoot[:]) {
+		return nil, errors.New("aggregate attestation not for requested data root")
+	}
+	aggregateCommitteeIndex, err := aggregate.CommitteeIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "aggregate attestation invalid")
+	}
+	if aggregateCommitteeIndex != committeeIndex {
+		return nil, errors.New("aggregate attestation not for requested committee")
+	}
+
+	return aggregate, nil
+}
+
+func (s *Service) phase0AggregateAttestation(ctx context.Context,
+	version spec.DataVersion,
+	slot phase0.Slot,
+	attestationDataRoot phase0.Root,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request aggregate attestation")
+	}
+	if respBodyReader == nil {
+		return nil, nil
+	}
+
+	var aggregateAttestationDataJSON aggregateAttestationDataJSON
+	if err := json.NewDecoder(respBodyReader).Decode(&aggregateAttestationDataJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse aggregate attestation")
+	}
+	if aggregateAttestationDataJSON.Data == nil {
+		return s.noAggregateAttestation()
+	}
+
+	return newVersionedPhase0Attestation(version, aggregateAttestationDataJSON.Data)
+}
+
+func (s *Service) electraAggregateAttestation(ctx context.Context,
+	slot phase0.Slot,
+	attestationDataRoot phase0.Root,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x&committee_index=%d", slot, attestationDataRoot, committeeIndex))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request aggregate attestation")
+	}
+	if res.body == nil {
+		return nil, nil
+	}
+
+	var aggregateAttestationJSON versionedAttestationDataJSON
+	if err := json.Unmarshal(res.body, &aggregateAttestationJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse aggregate attestation")
+	}
+	if aggregateAttestationJSON.Data == nil || bytes.Equal(aggregateAttestationJSON.Data, []byte("null")) {
+		return s.noAggregateAttestation()
+	}
+
+	return decodeVersionedAttestation(res.consensusVersion, aggregateAttestationJSON.Data)
+}
+
+// noAggregateAttestation handles an empty response, which is returned by some nodes if there is no
+// matching aggregate.
+func (s *Service) noAggregateAttestation() (*spec.VersionedAttestation, error) {
+	if s.nilOnNotFound {
+		return nil, nil
+	}
+
+	return nil, errors.Wrap(api.ErrNotFound, "no aggregate attestation returned")
+}
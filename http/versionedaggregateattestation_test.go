@@ -0,0 +1,122 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttestationDataRoot returns the root of the attestation data for the given slot and committee index.
+func testAttestationDataRoot(t *testing.T, slot phase0.Slot, index phase0.CommitteeIndex) phase0.Root {
+	t.Helper()
+
+	var data phase0.AttestationData
+	require.NoError(t, json.Unmarshal([]byte(testAttestationDataJSON(slot, index)), &data))
+	root, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+func TestVersionedAggregateAttestation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/validator/aggregate_attestation":
+			_, _ = fmt.Fprintf(w, `{"data":%s}`, testPhase0AttestationJSON(1, 2))
+		case "/eth/v2/validator/aggregate_attestation":
+			require.Equal(t, "2", r.URL.Query().Get("committee_index"))
+			w.Header().Set("Eth-Consensus-Version", "electra")
+			_, _ = fmt.Fprintf(w, `{"version":"electra","data":%s}`, testElectraAttestationJSON(3200, 2))
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		slot                phase0.Slot
+		attestationDataRoot phase0.Root
+		committeeIndex      phase0.CommitteeIndex
+		expectedVersion     spec.DataVersion
+		err                 string
+	}{
+		{
+			name:                "Deneb",
+			slot:                1,
+			attestationDataRoot: testAttestationDataRoot(t, 1, 2),
+			committeeIndex:      2,
+			expectedVersion:     spec.DataVersionDeneb,
+		},
+		{
+			name:                "Electra",
+			slot:                3200,
+			attestationDataRoot: testAttestationDataRoot(t, 3200, 0),
+			committeeIndex:      2,
+			expectedVersion:     spec.DataVersionElectra,
+		},
+		{
+			name:                "WrongRoot",
+			slot:                3200,
+			attestationDataRoot: phase0.Root{0x01},
+			committeeIndex:      2,
+			err:                 "aggregate attestation not for requested data root",
+		},
+		{
+			name:                "WrongCommittee",
+			slot:                1,
+			attestationDataRoot: testAttestationDataRoot(t, 1, 2),
+			committeeIndex:      3,
+			err:                 "aggregate attestation not for requested committee",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregate, err := service.(client.VersionedAggregateAttestationProvider).VersionedAggregateAttestation(ctx,
+				test.slot,
+				test.attestationDataRoot,
+				test.committeeIndex,
+			)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedVersion, aggregate.Version)
+			committeeIndex, err := aggregate.CommitteeIndex()
+			require.NoError(t, err)
+			require.Equal(t, test.committeeIndex, committeeIndex)
+		})
+	}
+}
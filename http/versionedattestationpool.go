@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Service) VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error) {
+	attestations, err := s.versionedAttestationPool(ctx, slot, fmt.Sprintf("slot=%d", slot))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for i := range attestations {
+		data, err := attestations[i].Data()
+		if err != nil {
+			return nil, errors.Wrap(err, "attestation pool entry invalid")
+		}
+		if data.Slot != slot {
+			return nil, errors.New("attestation pool entry not for requested slot")
+		}
+	}
+
+	return attestations, nil
+}
+
+// VersionedAttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Service) VersionedAttestationPoolForCommittee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	attestations, err := s.versionedAttestationPool(ctx, slot, fmt.Sprintf("slot=%d&committee_index=%d", slot, committeeIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for i := range attestations {
+		data, err := attestations[i].Data()
+		if err != nil {
+			return nil, errors.Wrap(err, "attestation pool entry invalid")
+		}
+		if data.Slot != slot {
+			return nil, errors.New("attestation pool entry not for requested slot")
+		}
+		attestationCommitteeIndex, err := attestations[i].CommitteeIndex()
+		if err != nil {
+			return nil, errors.Wrap(err, "attestation pool entry invalid")
+		}
+		if attestationCommitteeIndex != committeeIndex {
+			return nil, errors.New("attestation pool entry not for requested committee")
+		}
+	}
+
+	return attestations, nil
+}
+
+// versionedAttestationPool fetches the attestation pool with the given query, using the
+// v2 endpoint if the slot is at or after Electra and the v1 endpoint otherwise.
+func (s *Service) versionedAttestationPool(ctx context.Context,
+	slot phase0.Slot,
+	query string,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	version, err := s.dataVersionAtSlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain data version")
+	}
+
+	var body []byte
+	if version >= spec.DataVersionElectra {
+		res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/beacon/pool/attestations?%s", query))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to request attestation pool")
+		}
+		if res.body == nil {
+			return nil, errors.New("failed to obtain attestation pool")
+		}
+		version = res.consensusVersion
+		body = res.body
+	} else {
+		respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?%s", query))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to request attestation pool")
+		}
+		if respBodyReader == nil {
+			return nil, errors.New("failed to obtain attestation pool")
+		}
+		buf := new(bytes.Buffer)
+		if _, err := buf.ReadFrom(respBodyReader); err != nil {
+			return nil, errors.Wrap(err, "failed to read attestation pool")
+		}
+		body = buf.Bytes()
+	}
+
+	var attestationPoolJSON versionedAttestationDataJSON
+	if err := json.Unmarshal(body, &attestationPoolJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attestation pool")
+	}
+	if attestationPoolJSON.Data == nil || bytes.Equal(attestationPoolJSON.Data, []byte("null")) {
+		return nil, errors.New("attestation pool not returned")
+	}
+
+	return decodeVersionedAttestations(version, attestationPoolJSON.Data)
+}
@@ -0,0 +1,150 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// testAttestationDataJSON returns attestation data for the given slot and committee index.
+func testAttestationDataJSON(slot phase0.Slot, index phase0.CommitteeIndex) string {
+	return fmt.Sprintf(`{"slot":"%d","index":"%d","beacon_block_root":"0x%064x","source":{"epoch":"0","root":"0x%064x"},"target":{"epoch":"1","root":"0x%064x"}}`,
+		slot, index, 1, 2, 3)
+}
+
+// testPhase0AttestationJSON returns a phase0 attestation for the given slot and committee index.
+func testPhase0AttestationJSON(slot phase0.Slot, index phase0.CommitteeIndex) string {
+	return fmt.Sprintf(`{"aggregation_bits":"0x03","data":%s,"signature":"0x%0192x"}`, testAttestationDataJSON(slot, index), 0)
+}
+
+// testElectraAttestationJSON returns an electra attestation for the given slot and committee index.
+func testElectraAttestationJSON(slot phase0.Slot, index phase0.CommitteeIndex) string {
+	committeeBits := make([]byte, 8)
+	committeeBits[index/8] = 1 << (index % 8)
+
+	return fmt.Sprintf(`{"aggregation_bits":"0x03","data":%s,"signature":"0x%0192x","committee_bits":"%#x"}`,
+		testAttestationDataJSON(slot, 0), 0, committeeBits)
+}
+
+func TestVersionedAttestationPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/beacon/pool/attestations":
+			switch r.URL.Query().Get("slot") {
+			case "1":
+				_, _ = fmt.Fprintf(w, `{"data":[%s,%s]}`, testPhase0AttestationJSON(1, 2), testPhase0AttestationJSON(1, 3))
+			default:
+				_, _ = fmt.Fprintf(w, `{"data":[%s]}`, testPhase0AttestationJSON(5, 2))
+			}
+		case "/eth/v2/beacon/pool/attestations":
+			w.Header().Set("Eth-Consensus-Version", "electra")
+			_, _ = fmt.Fprintf(w, `{"version":"electra","data":[%s,%s]}`, testElectraAttestationJSON(3200, 2), testElectraAttestationJSON(3200, 3))
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		slot            phase0.Slot
+		expectedVersion spec.DataVersion
+		expectedCount   int
+		err             string
+	}{
+		{
+			name:            "Deneb",
+			slot:            1,
+			expectedVersion: spec.DataVersionDeneb,
+			expectedCount:   2,
+		},
+		{
+			name:            "Electra",
+			slot:            3200,
+			expectedVersion: spec.DataVersionElectra,
+			expectedCount:   2,
+		},
+		{
+			name: "WrongSlot",
+			slot: 2,
+			err:  "attestation pool entry not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attestations, err := service.(client.VersionedAttestationPoolProvider).VersionedAttestationPool(ctx, test.slot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, attestations, test.expectedCount)
+			for _, attestation := range attestations {
+				require.Equal(t, test.expectedVersion, attestation.Version)
+				data, err := attestation.Data()
+				require.NoError(t, err)
+				require.Equal(t, test.slot, data.Slot)
+			}
+		})
+	}
+}
+
+func TestVersionedAttestationPoolForCommittee(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestServer(t, func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		switch r.URL.Path {
+		case "/eth/v2/beacon/pool/attestations":
+			require.Equal(t, "2", r.URL.Query().Get("committee_index"))
+			w.Header().Set("Eth-Consensus-Version", "electra")
+			_, _ = fmt.Fprintf(w, `{"version":"electra","data":[%s]}`, testElectraAttestationJSON(3200, 2))
+		default:
+			w.WriteHeader(nethttp.StatusNotFound)
+		}
+	})
+
+	service, err := http.New(ctx,
+		http.WithAddress(srv.URL),
+		http.WithTimeout(timeout),
+		http.WithConfirmConnection(false),
+	)
+	require.NoError(t, err)
+
+	attestations, err := service.(client.VersionedAttestationPoolProvider).VersionedAttestationPoolForCommittee(ctx, 3200, 2)
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+	committeeIndex, err := attestations[0].CommitteeIndex()
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(2), committeeIndex)
+}
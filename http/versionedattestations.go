@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// versionedAttestationDataJSON is the response from endpoints returning one or more versioned
+// attestations, with the data decoded once its version is known.
+type versionedAttestationDataJSON struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// newVersionedPhase0Attestation wraps a phase0 attestation in a versioned attestation of the given version.
+func newVersionedPhase0Attestation(version spec.DataVersion, attestation *phase0.Attestation) (*spec.VersionedAttestation, error) {
+	versioned := &spec.VersionedAttestation{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionPhase0:
+		versioned.Phase0 = attestation
+	case spec.DataVersionAltair:
+		versioned.Altair = attestation
+	case spec.DataVersionBellatrix:
+		versioned.Bellatrix = attestation
+	case spec.DataVersionCapella:
+		versioned.Capella = attestation
+	case spec.DataVersionDeneb:
+		versioned.Deneb = attestation
+	default:
+		return nil, fmt.Errorf("unsupported phase0 attestation version %s", version)
+	}
+
+	return versioned, nil
+}
+
+// decodeVersionedAttestations decodes a list of attestations of the given version.
+func decodeVersionedAttestations(version spec.DataVersion, data []byte) ([]*spec.VersionedAttestation, error) {
+	switch version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair, spec.DataVersionBellatrix, spec.DataVersionCapella, spec.DataVersionDeneb:
+		var attestations []*phase0.Attestation
+		if err := json.Unmarshal(data, &attestations); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s attestations", version)
+		}
+		res := make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			if attestations[i] == nil || attestations[i].Data == nil {
+				return nil, errors.New("attestation missing data")
+			}
+			var err error
+			res[i], err = newVersionedPhase0Attestation(version, attestations[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return res, nil
+	case spec.DataVersionElectra:
+		var attestations []*electra.Attestation
+		if err := json.Unmarshal(data, &attestations); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra attestations")
+		}
+		res := make([]*spec.VersionedAttestation, len(attestations))
+		for i := range attestations {
+			if attestations[i] == nil || attestations[i].Data == nil {
+				return nil, errors.New("attestation missing data")
+			}
+			res[i] = &spec.VersionedAttestation{
+				Version: version,
+				Electra: attestations[i],
+			}
+		}
+
+		return res, nil
+	default:
+		return nil, newUnsupportedVersionError("unhandled attestations version %s", version)
+	}
+}
+
+// decodeVersionedAttestation decodes a single attestation of the given version.
+func decodeVersionedAttestation(version spec.DataVersion, data []byte) (*spec.VersionedAttestation, error) {
+	switch version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair, spec.DataVersionBellatrix, spec.DataVersionCapella, spec.DataVersionDeneb:
+		var attestation phase0.Attestation
+		if err := json.Unmarshal(data, &attestation); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s attestation", version)
+		}
+
+		return newVersionedPhase0Attestation(version, &attestation)
+	case spec.DataVersionElectra:
+		var attestation electra.Attestation
+		if err := json.Unmarshal(data, &attestation); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra attestation")
+		}
+
+		return &spec.VersionedAttestation{
+			Version: version,
+			Electra: &attestation,
+		}, nil
+	default:
+		return nil, newUnsupportedVersionError("unhandled attestation version %s", version)
+	}
+}
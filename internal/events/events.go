@@ -0,0 +1,99 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events provides helpers for building event streams on top of an events provider.
+package events
+
+import (
+	"context"
+	"sync"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// typedEventsBuffer is the number of events buffered by each typed event stream.
+const typedEventsBuffer = 16
+
+// Subscribe subscribes to a single topic of the provider, returning a channel of the data
+// of each event of type T.  The channel is closed once the context is done or, if the
+// provider supplies subscription handles, once the underlying events stream is torn down.
+func Subscribe[T any](ctx context.Context, provider client.EventsProvider, topic string) (<-chan T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan T, typedEventsBuffer)
+
+	var mu sync.Mutex
+	finished := false
+	handler := func(event *apiv1.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if finished || event == nil {
+			return
+		}
+		if data, isData := event.Data.(T); isData {
+			select {
+			case ch <- data:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	var streamDone <-chan struct{}
+	if subscriber, isSubscriber := provider.(client.EventsSubscriber); isSubscriber {
+		subscription, err := subscriber.Subscribe(ctx, []string{topic}, handler)
+		if err != nil {
+			cancel()
+
+			return nil, err
+		}
+		streamDone = subscription.Done()
+	} else if err := provider.Events(ctx, []string{topic}, handler); err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-streamDone:
+		}
+		// Cancelling releases any handler blocked on a full channel.
+		cancel()
+		mu.Lock()
+		finished = true
+		close(ch)
+		mu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// Filtered feeds events of the provider with the given topics that pass all of the filters
+// to the supplied handler.
+func Filtered(ctx context.Context,
+	provider client.EventsProvider,
+	topics []string,
+	filters []apiv1.EventFilter,
+	handler client.EventHandlerFunc,
+) error {
+	if handler == nil || len(filters) == 0 {
+		return provider.Events(ctx, topics, handler)
+	}
+
+	return provider.Events(ctx, topics, func(event *apiv1.Event) {
+		if apiv1.MatchesEventFilters(event, filters) {
+			handler(event)
+		}
+	})
+}
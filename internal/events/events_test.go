@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/internal/events"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// provider is an events provider that hands its handler back to the test.
+type provider struct {
+	handler client.EventHandlerFunc
+}
+
+func (p *provider) Events(_ context.Context, _ []string, handler client.EventHandlerFunc) error {
+	p.handler = handler
+
+	return nil
+}
+
+// subscriber is an events provider that also supplies subscription handles.
+type subscriber struct {
+	provider
+	done chan struct{}
+}
+
+func (s *subscriber) Subscribe(_ context.Context, _ []string, handler client.EventHandlerFunc) (client.EventSubscription, error) {
+	s.handler = handler
+
+	return s, nil
+}
+
+func (s *subscriber) Close() {}
+
+func (s *subscriber) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *subscriber) Overflows() uint64 {
+	return 0
+}
+
+func requireClosed[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		select {
+		case _, open := <-ch:
+			return !open
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &provider{}
+	subCtx, subCancel := context.WithCancel(ctx)
+	ch, err := events.Subscribe[*apiv1.HeadEvent](subCtx, p, apiv1.EventTopicHead)
+	require.NoError(t, err)
+
+	// Events with data of another type are ignored.
+	p.handler(&apiv1.Event{Topic: apiv1.EventTopicBlock, Data: &apiv1.BlockEvent{}})
+	p.handler(&apiv1.Event{Topic: apiv1.EventTopicHead, Data: &apiv1.HeadEvent{Slot: 1}})
+	event := <-ch
+	require.Equal(t, phase0.Slot(1), event.Slot)
+
+	subCancel()
+	requireClosed(t, ch)
+
+	// Events after the channel is closed are dropped.
+	p.handler(&apiv1.Event{Topic: apiv1.EventTopicHead, Data: &apiv1.HeadEvent{Slot: 2}})
+}
+
+func TestSubscribeStreamDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &subscriber{done: make(chan struct{})}
+	ch, err := events.Subscribe[*apiv1.HeadEvent](ctx, s, apiv1.EventTopicHead)
+	require.NoError(t, err)
+
+	// Fill the channel so that the handler blocks.
+	go func() {
+		for i := 0; i < 32; i++ {
+			s.handler(&apiv1.Event{Topic: apiv1.EventTopicHead, Data: &apiv1.HeadEvent{Slot: phase0.Slot(i)}})
+		}
+	}()
+
+	// The channel is closed when the stream is torn down, even though the context is not done.
+	close(s.done)
+	requireClosed(t, ch)
+}
+
+func TestFiltered(t *testing.T) {
+	ctx := context.Background()
+
+	p := &provider{}
+	received := make([]*apiv1.Event, 0)
+	require.NoError(t, events.Filtered(ctx, p, []string{apiv1.EventTopicHead, apiv1.EventTopicBlock},
+		[]apiv1.EventFilter{apiv1.TopicsEventFilter(apiv1.EventTopicHead)},
+		func(event *apiv1.Event) {
+			received = append(received, event)
+		},
+	))
+
+	p.handler(&apiv1.Event{Topic: apiv1.EventTopicBlock, Data: &apiv1.BlockEvent{}})
+	p.handler(&apiv1.Event{Topic: apiv1.EventTopicHead, Data: &apiv1.HeadEvent{}})
+	require.Len(t, received, 1)
+	require.Equal(t, apiv1.EventTopicHead, received[0].Topic)
+}
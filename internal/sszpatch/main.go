@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// sszpatch applies the changes that go-eth2-client requires to the output of
+// sszgen, so that generated files never need to be edited by hand.  It is run
+// by go:generate after sszgen, from the directory of the package that holds
+// the files, and takes the names of the files to patch.
+//
+// Decoding is made strict:
+//   - the first offset of a container must equal the size of its fixed part,
+//     so that each value has a single encoding;
+//   - lists of variable-size elements are sized with codecs.DecodeDynamicLength,
+//     which bounds the allocation by the size of the input;
+//   - booleans are decoded with codecs.UnmarshalBool, which rejects values other
+//     than 0 and 1.
+//
+// sszgen also treats lists of types defined as uint64, for example
+// []phase0.CommitteeIndex, as []uint64.  These lists are created with their own
+// type, and converted to uint64 when hashed.
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: sszpatch file...")
+		os.Exit(2)
+	}
+
+	fields, err := structFields(".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to obtain struct fields: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, file := range os.Args[1:] {
+		if err := patchFile(file, fields); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to patch %s: %v\n", file, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// structFields returns the types of the fields of the structs declared in the
+// package in the given directory, keyed by struct name and field name.
+func structFields(dir string) (map[string]map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]map[string]string)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(f, func(node ast.Node) bool {
+			spec, isTypeSpec := node.(*ast.TypeSpec)
+			if !isTypeSpec {
+				return true
+			}
+			structType, isStruct := spec.Type.(*ast.StructType)
+			if !isStruct {
+				return false
+			}
+			res[spec.Name.Name] = make(map[string]string)
+			for _, field := range structType.Fields.List {
+				for _, name := range field.Names {
+					res[spec.Name.Name][name.Name] = types.ExprString(field.Type)
+				}
+			}
+
+			return false
+		})
+	}
+
+	return res, nil
+}
+
+// patchFile patches the given file in place.
+func patchFile(file string, fields map[string]map[string]string) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	res, err := patch(src, fields)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, res, info.Mode())
+}
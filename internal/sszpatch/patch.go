@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const codecsImport = `"github.com/attestantio/go-eth2-client/codecs"`
+
+var (
+	firstOffsetRE   = regexp.MustCompile(`(if o\d+) < (\d+ \{\s*return ssz\.ErrInvalidVariableOffset)`)
+	unmarshalBoolRE = regexp.MustCompile(`(?m)^([ \t]*)(\w+\.\w+) = ssz\.UnmarshalBool\(([^)]*)\)$`)
+	singleImportRE  = regexp.MustCompile(`\nimport (.+)\n`)
+	extendUint64RE  = regexp.MustCompile(`(?m)^([ \t]*)(\w+)\.(\w+) = ssz\.ExtendUint64\(\w+\.\w+, (\w+)\)$`)
+	hashUint64RE    = regexp.MustCompile(`range (\w+)\.(\w+) \{(\s*)hh\.AppendUint64\(i\)`)
+	methodRE        = regexp.MustCompile(`(?m)^func \(\w+ \*(\w+)\) `)
+)
+
+// patch patches the source of a file generated by sszgen, given the fields of
+// the structs in its package.
+func patch(src []byte, fields map[string]map[string]string) ([]byte, error) {
+	res := patchDecoding(src)
+	res = patchUint64Lists(res, fields)
+
+	if bytes.Contains(res, []byte("codecs.")) && !bytes.Contains(res, []byte(codecsImport)) {
+		var err error
+		res, err = addCodecsImport(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return format.Source(res)
+}
+
+// patchDecoding makes decoding reject encodings that are not canonical, and
+// bounds the allocations made for lists by the size of the input.
+func patchDecoding(src []byte) []byte {
+	res := firstOffsetRE.ReplaceAll(src, []byte("$1 != $2"))
+	res = bytes.ReplaceAll(res, []byte("ssz.DecodeDynamicLength("), []byte("codecs.DecodeDynamicLength("))
+
+	return unmarshalBoolRE.ReplaceAll(res, []byte("${1}if $2, err = codecs.UnmarshalBool($3); err != nil {\n${1}\treturn err\n${1}}"))
+}
+
+// patchUint64Lists creates lists of types defined as uint64 with their own type.
+func patchUint64Lists(src []byte, fields map[string]map[string]string) []byte {
+	// Work method by method, as the receiver provides the struct of the fields.
+	methods := methodRE.FindAllSubmatchIndex(src, -1)
+	if len(methods) == 0 {
+		return src
+	}
+
+	res := bytes.NewBuffer(make([]byte, 0, len(src)))
+	res.Write(src[:methods[0][0]])
+	for i, method := range methods {
+		end := len(src)
+		if i < len(methods)-1 {
+			end = methods[i+1][0]
+		}
+		structFields := fields[string(src[method[2]:method[3]])]
+		typedList := func(field []byte) (string, bool) {
+			fieldType := structFields[string(field)]
+
+			return fieldType, strings.HasPrefix(fieldType, "[]") && fieldType != "[]uint64"
+		}
+
+		body := extendUint64RE.ReplaceAllFunc(src[method[0]:end], func(match []byte) []byte {
+			groups := extendUint64RE.FindSubmatch(match)
+			fieldType, typed := typedList(groups[3])
+			if !typed {
+				return match
+			}
+
+			return []byte(fmt.Sprintf("%s%s.%s = make(%s, %s)", groups[1], groups[2], groups[3], fieldType, groups[4]))
+		})
+		body = hashUint64RE.ReplaceAllFunc(body, func(match []byte) []byte {
+			groups := hashUint64RE.FindSubmatch(match)
+			if _, typed := typedList(groups[2]); !typed {
+				return match
+			}
+
+			return []byte(fmt.Sprintf("range %s.%s {%shh.AppendUint64(uint64(i))", groups[1], groups[2], groups[3]))
+		})
+		res.Write(body)
+	}
+
+	return res.Bytes()
+}
+
+// addCodecsImport adds the codecs package to the imports of the source.
+func addCodecsImport(src []byte) ([]byte, error) {
+	if bytes.Contains(src, []byte("\nimport (\n")) {
+		return bytes.Replace(src, []byte("\nimport (\n"), []byte("\nimport (\n\t"+codecsImport+"\n"), 1), nil
+	}
+
+	match := singleImportRE.FindSubmatchIndex(src)
+	if match == nil {
+		return nil, errors.New("no imports found")
+	}
+
+	res := make([]byte, 0, len(src)+len(codecsImport)+16)
+	res = append(res, src[:match[0]]...)
+	res = append(res, "\nimport (\n\t"+codecsImport+"\n\t"...)
+	res = append(res, src[match[2]:match[3]]...)
+	res = append(res, "\n)\n"...)
+
+	return append(res, src[match[1]:]...), nil
+}
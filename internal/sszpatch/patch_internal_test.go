@@ -0,0 +1,180 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchDecoding(t *testing.T) {
+	src := `package v1
+
+import ssz "github.com/ferranbt/fastssz"
+
+func (e *Event) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 9 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Optimistic'
+	e.Optimistic = ssz.UnmarshalBool(buf[0:1])
+
+	// Offset (1) 'Items'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Items'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 16)
+		if err != nil {
+			return err
+		}
+		e.Items = make([]*Item, num)
+	}
+	return err
+}
+`
+	expected := `package v1
+
+import (
+	"github.com/attestantio/go-eth2-client/codecs"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func (e *Event) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 9 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Optimistic'
+	if e.Optimistic, err = codecs.UnmarshalBool(buf[0:1]); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Items'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Items'
+	{
+		buf = tail[o1:]
+		num, err := codecs.DecodeDynamicLength(buf, 16)
+		if err != nil {
+			return err
+		}
+		e.Items = make([]*Item, num)
+	}
+	return err
+}
+`
+
+	res, err := patch([]byte(src), nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+
+	// Patching must be idempotent.
+	res, err = patch(res, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+}
+
+func TestPatchUint64Lists(t *testing.T) {
+	fields := map[string]map[string]string{
+		"Duty": {
+			"Indices":      "[]phase0.CommitteeIndex",
+			"PlainIndices": "[]uint64",
+		},
+	}
+
+	src := `package v1
+
+func (d *Duty) UnmarshalSSZ(buf []byte) error {
+	{
+		d.Indices = ssz.ExtendUint64(d.Indices, num)
+		d.PlainIndices = ssz.ExtendUint64(d.PlainIndices, num)
+	}
+
+	return nil
+}
+
+func (d *Duty) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	{
+		for _, i := range d.Indices {
+			hh.AppendUint64(i)
+		}
+		for _, i := range d.PlainIndices {
+			hh.AppendUint64(i)
+		}
+	}
+
+	return
+}
+`
+	expected := `package v1
+
+func (d *Duty) UnmarshalSSZ(buf []byte) error {
+	{
+		d.Indices = make([]phase0.CommitteeIndex, num)
+		d.PlainIndices = ssz.ExtendUint64(d.PlainIndices, num)
+	}
+
+	return nil
+}
+
+func (d *Duty) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	{
+		for _, i := range d.Indices {
+			hh.AppendUint64(uint64(i))
+		}
+		for _, i := range d.PlainIndices {
+			hh.AppendUint64(i)
+		}
+	}
+
+	return
+}
+`
+
+	res, err := patch([]byte(src), fields)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+
+	// Patching must be idempotent.
+	res, err = patch(res, fields)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+}
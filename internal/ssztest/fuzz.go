@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ssztest provides helpers for testing SSZ encodings.
+package ssztest
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Object is an object with an SSZ encoding.
+type Object interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// FuzzUnmarshalSSZ fuzzes the SSZ decoding of a type, using the encoding of the
+// type's zero value and any supplied encodings as seeds.
+//
+// Decoding must never panic.  If the input decodes successfully then it must
+// be the canonical encoding of the decoded value, and the value must hash.
+func FuzzUnmarshalSSZ[T any, PT interface {
+	*T
+	Object
+}](f *testing.F, seeds ...[]byte) {
+	f.Helper()
+
+	f.Add([]byte{})
+	if seed, err := PT(new(T)).MarshalSSZ(); err == nil {
+		f.Add(seed)
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		obj := PT(new(T))
+		if err := obj.UnmarshalSSZ(input); err != nil {
+			return
+		}
+
+		encoded, err := obj.MarshalSSZ()
+		if err != nil {
+			t.Fatalf("failed to encode decoded value: %v", err)
+		}
+		if !bytes.Equal(input, encoded) {
+			t.Fatalf("decoded value has different encoding:\ninput:   %#x\nencoded: %#x", input, encoded)
+		}
+
+		redecoded := PT(new(T))
+		if err := redecoded.UnmarshalSSZ(encoded); err != nil {
+			t.Fatalf("failed to decode encoded value: %v", err)
+		}
+		if !reflect.DeepEqual(obj, redecoded) {
+			t.Fatalf("decoded value changed after encoding")
+		}
+
+		if _, err := obj.HashTreeRoot(); err != nil {
+			t.Fatalf("failed to hash decoded value: %v", err)
+		}
+	})
+}
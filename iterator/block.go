@@ -0,0 +1,233 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// ErrCaughtUp is returned when an iterator has reached the head of the chain.
+// Calling Next() again once the chain has progressed will continue iterating.
+var ErrCaughtUp = errors.New("iterator has caught up with the chain")
+
+// ReorgError is returned when an iterator detects a chain reorganisation.
+// Data returned by the iterator for slots after the common ancestor is no
+// longer canonical and should be discarded.  The iterator has been rewound,
+// and the next call to Next() will continue from the common ancestor.
+type ReorgError struct {
+	// Slot is the slot at which the reorganisation was detected.
+	Slot phase0.Slot
+	// CommonAncestorSlot is the slot of the latest block common to both chains.
+	CommonAncestorSlot phase0.Slot
+	// CommonAncestorRoot is the root of the latest block common to both chains.
+	CommonAncestorRoot phase0.Root
+}
+
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("chain reorganisation detected at slot %d; common ancestor at slot %d (%#x)", e.Slot, e.CommonAncestorSlot, e.CommonAncestorRoot)
+}
+
+// Block is a block returned by an iterator.
+type Block struct {
+	// Slot is the slot of the block.
+	Slot phase0.Slot
+	// Root is the root of the block.
+	Root phase0.Root
+	// Header is the header of the block.
+	Header *apiv1.BeaconBlockHeader
+	// Block is the block itself.
+	// This will be nil if the iterator was not supplied with a signed beacon block provider.
+	Block *spec.VersionedSignedBeaconBlock
+}
+
+// historyEntry is an entry in the list of blocks returned by the iterator.
+type historyEntry struct {
+	slot phase0.Slot
+	root phase0.Root
+}
+
+// BlockIterator iterates over the blocks of the canonical chain.
+// Slots without blocks are skipped.
+type BlockIterator struct {
+	log                        zerolog.Logger
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	reorgHistory               int
+
+	nextSlot phase0.Slot
+	headSlot phase0.Slot
+	history  []historyEntry
+}
+
+// NewBlockIterator creates a new block iterator.
+func NewBlockIterator(_ context.Context, params ...Parameter) (*BlockIterator, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "iterator").Str("impl", "block").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &BlockIterator{
+		log:                        log,
+		beaconBlockHeadersProvider: parameters.beaconBlockHeadersProvider,
+		signedBeaconBlockProvider:  parameters.signedBeaconBlockProvider,
+		reorgHistory:               parameters.reorgHistory,
+		nextSlot:                   parameters.startSlot,
+		history:                    make([]historyEntry, 0, parameters.reorgHistory),
+	}, nil
+}
+
+// NextSlot returns the slot from which the next call to Next() will start.
+func (i *BlockIterator) NextSlot() phase0.Slot {
+	return i.nextSlot
+}
+
+// Next returns the next block in the canonical chain.
+// If there are no further blocks it returns ErrCaughtUp.  If a chain
+// reorganisation is detected it returns a *ReorgError.
+func (i *BlockIterator) Next(ctx context.Context) (*Block, error) {
+	for {
+		if i.nextSlot > i.headSlot {
+			if err := i.updateHead(ctx); err != nil {
+				return nil, err
+			}
+			if i.nextSlot > i.headSlot {
+				return nil, ErrCaughtUp
+			}
+		}
+
+		slot := i.nextSlot
+		header, err := i.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+		if errors.Is(err, api.ErrNotFound) {
+			// Providers return not found for slots without blocks.
+			header, err = nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to obtain header for slot %d", slot))
+		}
+		if header == nil || header.Header == nil || header.Header.Message == nil || header.Header.Message.Slot != slot {
+			i.log.Trace().Uint64("slot", uint64(slot)).Msg("No block at slot")
+			i.nextSlot++
+			continue
+		}
+
+		if len(i.history) > 0 && header.Header.Message.ParentRoot != i.history[len(i.history)-1].root {
+			return nil, i.handleReorg(ctx, slot, header)
+		}
+
+		block := &Block{
+			Slot:   slot,
+			Root:   header.Root,
+			Header: header,
+		}
+		if i.signedBeaconBlockProvider != nil {
+			block.Block, err = i.signedBeaconBlockProvider.SignedBeaconBlock(ctx, fmt.Sprintf("%#x", header.Root))
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to obtain block for slot %d", slot))
+			}
+			if block.Block == nil {
+				return nil, fmt.Errorf("block for slot %d not available", slot)
+			}
+		}
+
+		i.record(slot, header.Root)
+		i.nextSlot = slot + 1
+
+		return block, nil
+	}
+}
+
+// updateHead updates the iterator's view of the head of the chain.
+func (i *BlockIterator) updateHead(ctx context.Context) error {
+	header, err := i.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, "head")
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain head header")
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return errors.New("head header not available")
+	}
+	i.headSlot = header.Header.Message.Slot
+
+	return nil
+}
+
+// record adds a returned block to the history.
+func (i *BlockIterator) record(slot phase0.Slot, root phase0.Root) {
+	if len(i.history) == i.reorgHistory {
+		copy(i.history, i.history[1:])
+		i.history = i.history[:len(i.history)-1]
+	}
+	i.history = append(i.history, historyEntry{
+		slot: slot,
+		root: root,
+	})
+}
+
+// rewind rewinds the iterator to the given slot, forgetting any history from that slot onwards.
+func (i *BlockIterator) rewind(slot phase0.Slot) {
+	for len(i.history) > 0 && i.history[len(i.history)-1].slot >= slot {
+		i.history = i.history[:len(i.history)-1]
+	}
+	i.nextSlot = slot
+}
+
+// handleReorg finds the common ancestor of the chain seen to date and the chain containing
+// the supplied header, and rewinds the iterator to just after it.
+func (i *BlockIterator) handleReorg(ctx context.Context, slot phase0.Slot, header *apiv1.BeaconBlockHeader) error {
+	known := make(map[phase0.Root]phase0.Slot, len(i.history))
+	for _, entry := range i.history {
+		known[entry.root] = entry.slot
+	}
+	earliestSlot := i.history[0].slot
+
+	parentRoot := header.Header.Message.ParentRoot
+	for {
+		if ancestorSlot, exists := known[parentRoot]; exists {
+			i.log.Debug().Uint64("slot", uint64(slot)).Uint64("ancestor_slot", uint64(ancestorSlot)).Msg("Chain reorganisation detected")
+			i.rewind(ancestorSlot + 1)
+			return &ReorgError{
+				Slot:               slot,
+				CommonAncestorSlot: ancestorSlot,
+				CommonAncestorRoot: parentRoot,
+			}
+		}
+
+		parent, err := i.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, fmt.Sprintf("%#x", parentRoot))
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain parent header")
+		}
+		if parent == nil || parent.Header == nil || parent.Header.Message == nil {
+			return fmt.Errorf("parent header %#x not available", parentRoot)
+		}
+		if parent.Header.Message.Slot < earliestSlot {
+			return fmt.Errorf("chain reorganisation at slot %d is deeper than available history", slot)
+		}
+		parentRoot = parent.Header.Message.ParentRoot
+	}
+}
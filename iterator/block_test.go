@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/iterator"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockIterator(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := iterator.NewBlockIterator(ctx, iterator.WithLogLevel(zerolog.Disabled))
+	require.EqualError(t, err, "problem with parameters: no beacon block headers provider specified")
+
+	_, err = iterator.NewBlockIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(newTestChain()),
+		iterator.WithReorgHistory(0),
+	)
+	require.EqualError(t, err, "problem with parameters: reorg history must be at least 1")
+}
+
+func TestBlockIterator(t *testing.T) {
+	ctx := context.Background()
+
+	chain := newTestChain()
+	root0 := chain.addBlock(0, 0x10, phase0.Root{})
+	root1 := chain.addBlock(1, 0x11, root0)
+	// Slot 2 is missed.
+	chain.addBlock(3, 0x13, root1)
+
+	it, err := iterator.NewBlockIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(chain),
+		iterator.WithSignedBeaconBlockProvider(chain),
+	)
+	require.NoError(t, err)
+
+	for _, expected := range []phase0.Slot{0, 1, 3} {
+		block, err := it.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, block.Slot)
+		require.NotNil(t, block.Block)
+	}
+	_, err = it.Next(ctx)
+	require.ErrorIs(t, err, iterator.ErrCaughtUp)
+
+	// Chain progresses.
+	chain.addBlock(4, 0x14, phase0.Root{0x13})
+	block, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(4), block.Slot)
+}
+
+func TestBlockIteratorReorg(t *testing.T) {
+	ctx := context.Background()
+
+	chain := newTestChain()
+	root0 := chain.addBlock(0, 0x10, phase0.Root{})
+	root1 := chain.addBlock(1, 0x11, root0)
+	chain.addBlock(2, 0x12, root1)
+
+	it, err := iterator.NewBlockIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(chain),
+	)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := it.Next(ctx)
+		require.NoError(t, err)
+	}
+
+	// Reorg out the block at slot 2 with a block at slot 3 built on slot 1.
+	delete(chain.canonical, 2)
+	chain.addBlock(3, 0x23, root1)
+
+	_, err = it.Next(ctx)
+	var reorgErr *iterator.ReorgError
+	require.True(t, errors.As(err, &reorgErr))
+	require.Equal(t, phase0.Slot(3), reorgErr.Slot)
+	require.Equal(t, phase0.Slot(1), reorgErr.CommonAncestorSlot)
+	require.Equal(t, root1, reorgErr.CommonAncestorRoot)
+	require.Equal(t, phase0.Slot(2), it.NextSlot())
+
+	block, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(3), block.Slot)
+	require.Nil(t, block.Block)
+}
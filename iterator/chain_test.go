@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// testChain is a simple chain for testing iterators.
+type testChain struct {
+	slotsPerEpoch uint64
+	// canonical maps slots to block roots on the canonical chain.
+	canonical map[phase0.Slot]phase0.Root
+	// headers contains all known headers, canonical or otherwise.
+	headers map[phase0.Root]*apiv1.BeaconBlockHeader
+	head    phase0.Slot
+}
+
+func newTestChain() *testChain {
+	return &testChain{
+		slotsPerEpoch: 4,
+		canonical:     make(map[phase0.Slot]phase0.Root),
+		headers:       make(map[phase0.Root]*apiv1.BeaconBlockHeader),
+	}
+}
+
+// addBlock adds a block to the chain, making it canonical.
+func (c *testChain) addBlock(slot phase0.Slot, id byte, parentRoot phase0.Root) phase0.Root {
+	root := phase0.Root{id}
+	c.headers[root] = &apiv1.BeaconBlockHeader{
+		Root:      root,
+		Canonical: true,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: parentRoot,
+			},
+		},
+	}
+	c.canonical[slot] = root
+	if slot > c.head {
+		c.head = slot
+	}
+
+	return root
+}
+
+func (c *testChain) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	if blockID == "head" {
+		return c.headers[c.canonical[c.head]], nil
+	}
+	if slot, err := strconv.ParseUint(blockID, 10, 64); err == nil {
+		root, exists := c.canonical[phase0.Slot(slot)]
+		if !exists {
+			return nil, api.ErrNotFound
+		}
+		return c.headers[root], nil
+	}
+	for root, header := range c.headers {
+		if fmt.Sprintf("%#x", root) == blockID {
+			return header, nil
+		}
+	}
+
+	return nil, api.ErrNotFound
+}
+
+func (c *testChain) BeaconBlockHeaders(_ context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	res := make([]*apiv1.BeaconBlockHeader, 0)
+	for _, header := range c.headers {
+		if slot != nil && header.Header.Message.Slot != *slot {
+			continue
+		}
+		if parentRoot != nil && header.Header.Message.ParentRoot != *parentRoot {
+			continue
+		}
+		res = append(res, header)
+	}
+
+	return res, nil
+}
+
+func (c *testChain) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	for root, header := range c.headers {
+		if fmt.Sprintf("%#x", root) == blockID {
+			return &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.SignedBeaconBlock{
+					Message: &phase0.BeaconBlock{
+						Slot:       header.Header.Message.Slot,
+						ParentRoot: header.Header.Message.ParentRoot,
+					},
+				},
+			}, nil
+		}
+	}
+
+	return nil, api.ErrNotFound
+}
+
+func (c *testChain) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return c.slotsPerEpoch, nil
+}
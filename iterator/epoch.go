@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Epoch is an epoch returned by an iterator.
+type Epoch struct {
+	// Epoch is the epoch.
+	Epoch phase0.Epoch
+	// Blocks are the canonical blocks in the epoch, in slot order.
+	Blocks []*Block
+}
+
+// EpochIterator iterates over the epochs of the canonical chain.
+// An epoch is returned once the head of the chain has passed its last slot.
+type EpochIterator struct {
+	blocks        *BlockIterator
+	slotsPerEpoch uint64
+
+	nextEpoch phase0.Epoch
+	// pending is a block read from the following epoch.
+	pending *Block
+	// lastSlot is the slot of the latest block returned in an epoch.
+	lastSlot phase0.Slot
+}
+
+// NewEpochIterator creates a new epoch iterator.
+func NewEpochIterator(ctx context.Context, params ...Parameter) (*EpochIterator, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+	if parameters.slotsPerEpochProvider == nil {
+		return nil, errors.New("problem with parameters: no slots per epoch provider specified")
+	}
+
+	slotsPerEpoch, err := parameters.slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "iterator").Str("impl", "epoch").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &EpochIterator{
+		blocks: &BlockIterator{
+			log:                        log,
+			beaconBlockHeadersProvider: parameters.beaconBlockHeadersProvider,
+			signedBeaconBlockProvider:  parameters.signedBeaconBlockProvider,
+			reorgHistory:               parameters.reorgHistory,
+			nextSlot:                   phase0.Slot(uint64(parameters.startEpoch) * slotsPerEpoch),
+			history:                    make([]historyEntry, 0, parameters.reorgHistory),
+		},
+		slotsPerEpoch: slotsPerEpoch,
+		nextEpoch:     parameters.startEpoch,
+	}, nil
+}
+
+// NextEpoch returns the epoch that will be returned by the next call to Next().
+func (i *EpochIterator) NextEpoch() phase0.Epoch {
+	return i.nextEpoch
+}
+
+// Next returns the next epoch in the canonical chain.
+// If the head of the chain has not yet passed the end of the next epoch it
+// returns ErrCaughtUp.  If a chain reorganisation is detected that affects
+// previously returned epochs it returns a *ReorgError.
+func (i *EpochIterator) Next(ctx context.Context) (*Epoch, error) {
+	firstSlot := phase0.Slot(uint64(i.nextEpoch) * i.slotsPerEpoch)
+	lastSlot := firstSlot + phase0.Slot(i.slotsPerEpoch) - 1
+
+	if i.blocks.headSlot < lastSlot {
+		if err := i.blocks.updateHead(ctx); err != nil {
+			return nil, err
+		}
+		if i.blocks.headSlot < lastSlot {
+			return nil, ErrCaughtUp
+		}
+	}
+
+	blocks := make([]*Block, 0, i.slotsPerEpoch)
+	if i.pending != nil {
+		blocks = append(blocks, i.pending)
+		i.pending = nil
+	}
+	for (len(blocks) == 0 || blocks[len(blocks)-1].Slot <= lastSlot) && i.blocks.nextSlot <= lastSlot {
+		block, err := i.blocks.Next(ctx)
+		if errors.Is(err, ErrCaughtUp) {
+			// Remaining slots up to the head are empty.
+			break
+		}
+		if err != nil {
+			var reorgErr *ReorgError
+			if !errors.As(err, &reorgErr) {
+				return nil, err
+			}
+			if reorgErr.CommonAncestorSlot < i.lastSlot {
+				// Reorganisation affects an epoch that has already been returned.
+				i.nextEpoch = phase0.Epoch(uint64(reorgErr.CommonAncestorSlot) / i.slotsPerEpoch)
+				i.blocks.rewind(phase0.Slot(uint64(i.nextEpoch) * i.slotsPerEpoch))
+				i.lastSlot = 0
+				if len(i.blocks.history) > 0 {
+					i.lastSlot = i.blocks.history[len(i.blocks.history)-1].slot
+				}
+				return nil, err
+			}
+			// Reorganisation only affects this epoch; drop the orphaned blocks and carry on.
+			for len(blocks) > 0 && blocks[len(blocks)-1].Slot > reorgErr.CommonAncestorSlot {
+				blocks = blocks[:len(blocks)-1]
+			}
+			continue
+		}
+		if block.Slot > lastSlot {
+			i.pending = block
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	if len(blocks) > 0 && blocks[len(blocks)-1].Slot > lastSlot {
+		i.pending = blocks[len(blocks)-1]
+		blocks = blocks[:len(blocks)-1]
+	}
+
+	if len(blocks) > 0 {
+		i.lastSlot = blocks[len(blocks)-1].Slot
+	}
+	epoch := &Epoch{
+		Epoch:  i.nextEpoch,
+		Blocks: blocks,
+	}
+	i.nextEpoch++
+
+	return epoch, nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/iterator"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEpochIterator(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := iterator.NewEpochIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(newTestChain()),
+	)
+	require.EqualError(t, err, "problem with parameters: no slots per epoch provider specified")
+}
+
+func TestEpochIterator(t *testing.T) {
+	ctx := context.Background()
+
+	chain := newTestChain()
+	root := chain.addBlock(0, 0x10, phase0.Root{})
+	root = chain.addBlock(1, 0x11, root)
+	root = chain.addBlock(3, 0x13, root)
+	// Epoch 1 is empty.
+	root = chain.addBlock(8, 0x18, root)
+	chain.addBlock(10, 0x1a, root)
+
+	it, err := iterator.NewEpochIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(chain),
+		iterator.WithSlotsPerEpochProvider(chain),
+	)
+	require.NoError(t, err)
+
+	epoch, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(0), epoch.Epoch)
+	require.Len(t, epoch.Blocks, 3)
+
+	epoch, err = it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(1), epoch.Epoch)
+	require.Len(t, epoch.Blocks, 0)
+
+	// Epoch 2 is not complete.
+	_, err = it.Next(ctx)
+	require.ErrorIs(t, err, iterator.ErrCaughtUp)
+
+	chain.addBlock(12, 0x1c, phase0.Root{0x1a})
+	epoch, err = it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(2), epoch.Epoch)
+	require.Len(t, epoch.Blocks, 2)
+}
+
+func TestEpochIteratorReorg(t *testing.T) {
+	ctx := context.Background()
+
+	chain := newTestChain()
+	root0 := chain.addBlock(0, 0x10, phase0.Root{})
+	root1 := chain.addBlock(1, 0x11, root0)
+	root3 := chain.addBlock(3, 0x13, root1)
+	chain.addBlock(4, 0x14, root3)
+
+	it, err := iterator.NewEpochIterator(ctx,
+		iterator.WithLogLevel(zerolog.Disabled),
+		iterator.WithBeaconBlockHeadersProvider(chain),
+		iterator.WithSlotsPerEpochProvider(chain),
+	)
+	require.NoError(t, err)
+
+	epoch, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Len(t, epoch.Blocks, 3)
+
+	// Reorg out the blocks at slots 3 and 4.
+	delete(chain.canonical, 3)
+	delete(chain.canonical, 4)
+	chain.addBlock(7, 0x27, root1)
+
+	_, err = it.Next(ctx)
+	var reorgErr *iterator.ReorgError
+	require.True(t, errors.As(err, &reorgErr))
+	require.Equal(t, phase0.Slot(1), reorgErr.CommonAncestorSlot)
+	require.Equal(t, phase0.Epoch(0), it.NextEpoch())
+
+	epoch, err = it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(0), epoch.Epoch)
+	require.Len(t, epoch.Blocks, 2)
+
+	epoch, err = it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(1), epoch.Epoch)
+	require.Len(t, epoch.Blocks, 1)
+	require.Equal(t, phase0.Slot(7), epoch.Blocks[0].Slot)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                   zerolog.Level
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	signedBeaconBlockProvider  consensusclient.SignedBeaconBlockProvider
+	slotsPerEpochProvider      consensusclient.SlotsPerEpochProvider
+	startSlot                  phase0.Slot
+	startEpoch                 phase0.Epoch
+	reorgHistory               int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithBeaconBlockHeadersProvider sets the beacon block headers provider.
+func WithBeaconBlockHeadersProvider(provider consensusclient.BeaconBlockHeadersProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconBlockHeadersProvider = provider
+	})
+}
+
+// WithSignedBeaconBlockProvider sets the signed beacon block provider.
+// If this is not supplied the iterators will return block headers without their blocks.
+func WithSignedBeaconBlockProvider(provider consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProvider = provider
+	})
+}
+
+// WithSlotsPerEpochProvider sets the slots per epoch provider.
+// This is required for epoch iterators.
+func WithSlotsPerEpochProvider(provider consensusclient.SlotsPerEpochProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpochProvider = provider
+	})
+}
+
+// WithStartSlot sets the first slot for block iterators.
+func WithStartSlot(slot phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.startSlot = slot
+	})
+}
+
+// WithStartEpoch sets the first epoch for epoch iterators.
+func WithStartEpoch(epoch phase0.Epoch) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.startEpoch = epoch
+	})
+}
+
+// WithReorgHistory sets the number of returned blocks that are remembered to
+// find a common ancestor when a chain reorganisation is detected.
+func WithReorgHistory(reorgHistory int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.reorgHistory = reorgHistory
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:     zerolog.GlobalLevel(),
+		reorgHistory: 64,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.beaconBlockHeadersProvider == nil {
+		return nil, errors.New("no beacon block headers provider specified")
+	}
+	if parameters.reorgHistory < 1 {
+		return nil, errors.New("reorg history must be at least 1")
+	}
+
+	return &parameters, nil
+}
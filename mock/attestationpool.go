@@ -20,11 +20,29 @@ import (
 )
 
 // AttestationPool fetches the attestation pool for the given slot.
-func (s *Service) AttestationPool(_ context.Context, _ spec.Slot) ([]*spec.Attestation, error) {
+func (s *Service) AttestationPool(_ context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
 	res := make([]*spec.Attestation, 5)
 	for i := 0; i < 5; i++ {
 		res[i] = &spec.Attestation{
 			Data: &spec.AttestationData{
+				Slot:   slot,
+				Source: &spec.Checkpoint{},
+				Target: &spec.Checkpoint{},
+			},
+		}
+	}
+
+	return res, nil
+}
+
+// AttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Service) AttestationPoolForCommittee(_ context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) ([]*spec.Attestation, error) {
+	res := make([]*spec.Attestation, 5)
+	for i := 0; i < 5; i++ {
+		res[i] = &spec.Attestation{
+			Data: &spec.AttestationData{
+				Slot:   slot,
+				Index:  committeeIndex,
 				Source: &spec.Checkpoint{},
 				Target: &spec.Checkpoint{},
 			},
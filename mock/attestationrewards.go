@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationRewards provides the attestation rewards for a given epoch.
+func (*Service) AttestationRewards(_ context.Context, _ phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	res := &api.AttestationRewards{
+		IdealRewards: []*api.IdealAttestationRewards{
+			{
+				EffectiveBalance: 32000000000,
+				Head:             3000,
+				Target:           5000,
+				Source:           3000,
+			},
+		},
+		TotalRewards: make([]*api.ValidatorAttestationRewards, 0, len(validatorIndices)),
+	}
+	for _, validatorIndex := range validatorIndices {
+		res.TotalRewards = append(res.TotalRewards, &api.ValidatorAttestationRewards{
+			ValidatorIndex: validatorIndex,
+			Head:           3000,
+			Target:         5000,
+			Source:         3000,
+		})
+	}
+
+	return res, nil
+}
+
+// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+func (*Service) AttestationRewardsByPubKey(_ context.Context, _ phase0.Epoch, _ []phase0.BLSPubKey) (*api.AttestationRewards, error) {
+	return &api.AttestationRewards{
+		IdealRewards: []*api.IdealAttestationRewards{
+			{
+				EffectiveBalance: 32000000000,
+				Head:             3000,
+				Target:           5000,
+				Source:           3000,
+			},
+		},
+		TotalRewards: []*api.ValidatorAttestationRewards{},
+	}, nil
+}
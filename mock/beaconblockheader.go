@@ -28,3 +28,22 @@ func (s *Service) BeaconBlockHeader(_ context.Context, _ string) (*api.BeaconBlo
 		},
 	}, nil
 }
+
+// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+func (s *Service) BeaconBlockHeaders(_ context.Context, slot *spec.Slot, parentRoot *spec.Root) ([]*api.BeaconBlockHeader, error) {
+	header := &spec.BeaconBlockHeader{}
+	if slot != nil {
+		header.Slot = *slot
+	}
+	if parentRoot != nil {
+		header.ParentRoot = *parentRoot
+	}
+
+	return []*api.BeaconBlockHeader{
+		{
+			Header: &spec.SignedBeaconBlockHeader{
+				Message: header,
+			},
+		},
+	}, nil
+}
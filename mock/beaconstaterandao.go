@@ -0,0 +1,30 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Service) BeaconStateRandao(_ context.Context, _ string) (*phase0.Root, error) {
+	return &phase0.Root{}, nil
+}
+
+// BeaconStateRandaoAtEpoch fetches the RANDAO mix for the given epoch given a state ID.
+func (s *Service) BeaconStateRandaoAtEpoch(_ context.Context, _ string, _ phase0.Epoch) (*phase0.Root, error) {
+	return &phase0.Root{}, nil
+}
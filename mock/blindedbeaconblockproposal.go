@@ -19,6 +19,8 @@ import (
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/prysmaticlabs/go-bitfield"
 )
@@ -101,11 +103,15 @@ func (s *Service) BlindedBeaconBlockProposal(_ context.Context, slot phase0.Slot
 			Attestations:      attestations,
 			Deposits:          []*phase0.Deposit{},
 			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
 		},
 	}
 
 	versionedBlock := &api.VersionedBlindedBeaconBlock{
-		Version:   spec.DataVersionPhase0,
+		Version:   spec.DataVersionBellatrix,
 		Bellatrix: blindedBlock,
 	}
 
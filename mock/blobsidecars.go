@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+func (*Service) BlobSidecars(_ context.Context, _ string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	if len(indices) == 0 {
+		return []*deneb.BlobSidecar{
+			{
+				Index: 0,
+			},
+		}, nil
+	}
+
+	res := make([]*deneb.BlobSidecar, len(indices))
+	for i := range indices {
+		res[i] = &deneb.BlobSidecar{
+			Index: indices[i],
+		}
+	}
+
+	return res, nil
+}
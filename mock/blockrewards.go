@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// BlockRewards fetches the rewards received by the proposer of a block given a block ID.
+func (*Service) BlockRewards(_ context.Context, _ string) (*api.BlockRewards, error) {
+	return &api.BlockRewards{
+		ProposerIndex:     1,
+		Total:             30000000,
+		Attestations:      25000000,
+		SyncAggregate:     5000000,
+		ProposerSlashings: 0,
+		AttesterSlashings: 0,
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+)
+
+// BuilderBid fetches the bid of a builder for the execution payload of the given slot.
+func (s *Service) BuilderBid(_ context.Context,
+	_ phase0.Slot,
+	parentHash phase0.Hash32,
+	_ phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	return &api.VersionedSignedBuilderBid{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBuilderBid{
+			Message: &apiv1capella.BuilderBid{
+				Header: &capella.ExecutionPayloadHeader{
+					ParentHash: parentHash,
+				},
+				Value: uint256.NewInt(1000000000000000000),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// mockProviders are the provider and submitter interfaces implemented by the mock.
+var mockProviders = []string{
+	"AggregateAttestationProvider",
+	"AggregateAttestationsSubmitter",
+	"AttestationDataProvider",
+	"AttestationPoolProvider",
+	"AttestationsSubmitter",
+	"AttesterDutiesProvider",
+	"AttesterSlashingPoolProvider",
+	"AttesterSlashingSubmitter",
+	"BLSToExecutionChangePoolProvider",
+	"BLSToExecutionChangesSubmitter",
+	"BeaconBlockHeadersProvider",
+	"BeaconBlockProposalProvider",
+	"BeaconBlockRootProvider",
+	"BeaconBlockSubmitter",
+	"BeaconCommitteeSubscriptionsSubmitter",
+	"BeaconCommitteesProvider",
+	"BeaconStateProvider",
+	"BeaconStateRandaoAtEpochProvider",
+	"BeaconStateRandaoProvider",
+	"BeaconStateRootProvider",
+	"BlindedBeaconBlockProposalProvider",
+	"BlindedBeaconBlockSubmitter",
+	"BuilderBidProvider",
+	"BuilderBlindedBlockSubmitter",
+	"BuilderStatusProvider",
+	"DepositContractProvider",
+	"DepositSnapshotProvider",
+	"EventsProvider",
+	"FinalityProvider",
+	"ForkProvider",
+	"ForkScheduleProvider",
+	"GenesisProvider",
+	"NodeSyncingProvider",
+	"NodeVersionProvider",
+	"ProposalPreparationsSubmitter",
+	"ProposalProvider",
+	"ProposerDutiesProvider",
+	"ProposerSlashingPoolProvider",
+	"ProposerSlashingSubmitter",
+	"SignedBeaconBlockProvider",
+	"SignedBlindedBeaconBlockProvider",
+	"SpecProvider",
+	"SyncCommitteeContributionProvider",
+	"SyncCommitteeContributionsSubmitter",
+	"SyncCommitteeDutiesProvider",
+	"SyncCommitteeMessagesSubmitter",
+	"SyncCommitteeSubscriptionsSubmitter",
+	"SyncCommitteesProvider",
+	"ValidatorBalancesProvider",
+	"ValidatorRegistrationsSubmitter",
+	"ValidatorsProvider",
+	"VoluntaryExitPoolProvider",
+	"VoluntaryExitSubmitter",
+}
+
+// Capabilities provides the provider and submitter interfaces that are usable
+// against the connected node.
+func (s *Service) Capabilities(_ context.Context) (*api.Capabilities, error) {
+	capabilities := &api.Capabilities{
+		Providers: make(map[string]api.CapabilitySupport, len(mockProviders)),
+	}
+	for _, provider := range mockProviders {
+		capabilities.Providers[provider] = api.CapabilitySupported
+	}
+
+	return capabilities, nil
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+)
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+func (*Service) DataColumnSidecars(_ context.Context, _ string, indices []fulu.ColumnIndex) ([]*fulu.DataColumnSidecar, error) {
+	if len(indices) == 0 {
+		return []*fulu.DataColumnSidecar{
+			{
+				Index: 0,
+			},
+		}, nil
+	}
+
+	res := make([]*fulu.DataColumnSidecar, len(indices))
+	for i := range indices {
+		res[i] = &fulu.DataColumnSidecar{
+			Index: indices[i],
+		}
+	}
+
+	return res, nil
+}
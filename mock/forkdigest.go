@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ForkDigest provides the fork digest at a given epoch.
+func (s *Service) ForkDigest(ctx context.Context, epoch phase0.Epoch) (phase0.ForkDigest, error) {
+	forkVersion, err := s.ForkVersion(ctx, epoch)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	genesis, err := s.Genesis(ctx)
+	if err != nil {
+		return phase0.ForkDigest{}, errors.Wrap(err, "failed to obtain genesis")
+	}
+
+	forkData := &phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+	}
+
+	return forkData.ForkDigest()
+}
+
+// ForkVersion provides the fork version at a given epoch.
+func (s *Service) ForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, error) {
+	fork, err := s.forkAtEpoch(ctx, epoch)
+	if err != nil {
+		return phase0.Version{}, errors.Wrap(err, "failed to obtain fork")
+	}
+
+	return fork.CurrentVersion, nil
+}
+
+// NextForkVersion provides the version and epoch of the first fork scheduled after a given epoch.
+// If no fork is scheduled this returns the fork version at the given epoch and FAR_FUTURE_EPOCH.
+func (s *Service) NextForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error) {
+	forkSchedule, err := s.ForkSchedule(ctx)
+	if err != nil {
+		return phase0.Version{}, 0, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	if len(forkSchedule) == 0 {
+		return phase0.Version{}, 0, errors.New("no fork schedule returned")
+	}
+
+	currentFork := forkSchedule[0]
+	for i := range forkSchedule {
+		if forkSchedule[i].Epoch > epoch {
+			return forkSchedule[i].CurrentVersion, forkSchedule[i].Epoch, nil
+		}
+		currentFork = forkSchedule[i]
+	}
+
+	farFutureEpoch, err := s.FarFutureEpoch(ctx)
+	if err != nil {
+		return phase0.Version{}, 0, errors.Wrap(err, "failed to obtain far future epoch")
+	}
+
+	return currentFork.CurrentVersion, farFutureEpoch, nil
+}
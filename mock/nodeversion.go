@@ -15,9 +15,16 @@ package mock
 
 import (
 	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 )
 
 // NodeVersion returns a free-text string with the node version.
 func (s *Service) NodeVersion(_ context.Context) (string, error) {
 	return s.nodeVersion, nil
 }
+
+// ParsedNodeVersion returns the node version parsed in to its component parts.
+func (s *Service) ParsedNodeVersion(_ context.Context) (*apiv1.NodeVersion, error) {
+	return apiv1.ParseNodeVersion(s.nodeVersion), nil
+}
@@ -0,0 +1,30 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+// PendingConsolidations fetches the pending consolidations given a state ID.
+func (*Service) PendingConsolidations(_ context.Context, _ string) ([]*electra.PendingConsolidation, error) {
+	return []*electra.PendingConsolidation{
+		{
+			SourceIndex: 1,
+			TargetIndex: 2,
+		},
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Proposal fetches a proposal for signing.
+// A builder boost factor of 0 returns an unblinded proposal, otherwise a blinded proposal is returned.
+func (s *Service) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+	builderBoostFactor uint64,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	if builderBoostFactor == 0 {
+		block, err := s.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+		if err != nil {
+			return nil, err
+		}
+
+		return &api.VersionedProposal{
+			Version:        block.Version,
+			ExecutionValue: big.NewInt(0),
+			ConsensusValue: big.NewInt(0),
+			Phase0:         block.Phase0,
+			Altair:         block.Altair,
+			Bellatrix:      block.Bellatrix,
+			Capella:        block.Capella,
+			Deneb:          block.Deneb,
+		}, nil
+	}
+
+	block, err := s.BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VersionedProposal{
+		Version:          block.Version,
+		Blinded:          true,
+		ExecutionValue:   big.NewInt(0),
+		ConsensusValue:   big.NewInt(0),
+		BellatrixBlinded: block.Bellatrix,
+		CapellaBlinded:   block.Capella,
+		DenebBlinded:     block.Deneb,
+	}, nil
+}
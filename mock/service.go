@@ -25,6 +25,9 @@ import (
 
 // Service is a mock Ethereum 2 client service, providing data locally.
 type Service struct {
+	// log is a service-wide logger.
+	log zerolog.Logger
+
 	name    string
 	timeout time.Duration
 
@@ -43,9 +46,6 @@ type Service struct {
 	SyncDistance phase0.Slot
 }
 
-// log is a service-wide logger.
-var log zerolog.Logger
-
 // New creates a new Ethereum 2 client service, mocking connections.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
@@ -54,12 +54,13 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "mock").Logger()
+	log := zerologger.With().Str("service", "client").Str("impl", "mock").Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
 	s := &Service{
+		log:         log,
 		name:        parameters.name,
 		genesisTime: parameters.genesisTime,
 		timeout:     parameters.timeout,
@@ -77,7 +78,7 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	// Close the service on context done.
 	go func(s *Service) {
 		<-ctx.Done()
-		log.Trace().Msg("Context done; closing connection")
+		s.log.Trace().Msg("Context done; closing connection")
 		s.close()
 	}(s)
 
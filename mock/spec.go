@@ -24,5 +24,11 @@ func (s *Service) Spec(_ context.Context) (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"SECONDS_PER_SLOT": 12 * time.Second,
 		"SLOTS_PER_EPOCH":  uint64(32),
+
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+		"ALTAIR_FORK_EPOCH":                uint64(74240),
+		"BELLATRIX_FORK_EPOCH":             uint64(144896),
+		"CAPELLA_FORK_EPOCH":               uint64(194048),
+		"DENEB_FORK_EPOCH":                 uint64(18446744073709551615),
 	}, nil
 }
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder.
+func (s *Service) SubmitBuilderBlindedBlock(_ context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no blinded block supplied")
+	}
+	blockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.VersionedSubmitBlindedBlockResponse{
+		Version: block.Version,
+	}
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		response.Bellatrix = &bellatrix.ExecutionPayload{
+			BlockHash: blockHash,
+		}
+	case spec.DataVersionCapella:
+		response.Capella = &capella.ExecutionPayload{
+			BlockHash: blockHash,
+		}
+	case spec.DataVersionDeneb:
+		response.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BlockHash: blockHash,
+			},
+			BlobsBundle: &apiv1deneb.BlobsBundle{},
+		}
+	default:
+		return nil, errors.New("unsupported version")
+	}
+
+	return response, nil
+}
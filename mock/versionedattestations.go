@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedAggregateAttestation fetches the aggregate attestation for the given slot, attestation data root
+// and committee index.
+func (s *Service) VersionedAggregateAttestation(_ context.Context,
+	slot phase0.Slot,
+	_ phase0.Root,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	*spec.VersionedAttestation,
+	error,
+) {
+	return &spec.VersionedAttestation{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.Attestation{
+			Data: &phase0.AttestationData{
+				Slot:   slot,
+				Index:  committeeIndex,
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		},
+	}, nil
+}
+
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Service) VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error) {
+	attestations, err := s.AttestationPool(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return versionedAttestations(attestations), nil
+}
+
+// VersionedAttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Service) VersionedAttestationPoolForCommittee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	attestations, err := s.AttestationPoolForCommittee(ctx, slot, committeeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return versionedAttestations(attestations), nil
+}
+
+// SubmitVersionedAttestations submits versioned attestations.
+func (s *Service) SubmitVersionedAttestations(_ context.Context, _ []*spec.VersionedAttestation) error {
+	return nil
+}
+
+// BlockAttestations fetches the attestations in a beacon block given a block ID.
+func (s *Service) BlockAttestations(ctx context.Context, _ string) ([]*spec.VersionedAttestation, error) {
+	attestations, err := s.AttestationPool(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return versionedAttestations(attestations), nil
+}
+
+func versionedAttestations(attestations []*phase0.Attestation) []*spec.VersionedAttestation {
+	res := make([]*spec.VersionedAttestation, len(attestations))
+	for i := range attestations {
+		res[i] = &spec.VersionedAttestation{
+			Version: spec.DataVersionPhase0,
+			Phase0:  attestations[i],
+		}
+	}
+
+	return res
+}
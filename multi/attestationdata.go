@@ -28,7 +28,7 @@ func (s *Service) AttestationData(ctx context.Context,
 	*phase0.AttestationData,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "AttestationData", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		attestationData, err := client.(consensusclient.AttestationDataProvider).AttestationData(ctx, slot, committeeIndex)
 		if err != nil {
 			return nil, err
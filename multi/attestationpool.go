@@ -22,7 +22,7 @@ import (
 
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "AttestationPool", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		attestationPool, err := client.(consensusclient.AttestationPoolProvider).AttestationPool(ctx, slot)
 		if err != nil {
 			return nil, err
@@ -37,3 +37,27 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 	}
 	return res.([]*phase0.Attestation), nil
 }
+
+// AttestationPoolForCommittee obtains the attestation pool for a given slot and committee.
+func (s *Service) AttestationPoolForCommittee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]*phase0.Attestation,
+	error,
+) {
+	res, err := s.doCall(ctx, "AttestationPoolForCommittee", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationPool, err := client.(consensusclient.AttestationPoolProvider).AttestationPoolForCommittee(ctx, slot, committeeIndex)
+		if err != nil {
+			return nil, err
+		}
+		return attestationPool, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*phase0.Attestation), nil
+}
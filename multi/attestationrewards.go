@@ -0,0 +1,62 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationRewards provides the attestation rewards for a given epoch.
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+// will be applied.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	res, err := s.doCall(ctx, "AttestationRewards", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationRewards, err := client.(consensusclient.AttestationRewardsProvider).AttestationRewards(ctx, epoch, validatorIndices)
+		if err != nil {
+			return nil, err
+		}
+		return attestationRewards, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.AttestationRewards), nil
+}
+
+// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+// supplied no filter will be applied.
+func (s *Service) AttestationRewardsByPubKey(ctx context.Context, epoch phase0.Epoch, validatorPubKeys []phase0.BLSPubKey) (*api.AttestationRewards, error) {
+	res, err := s.doCall(ctx, "AttestationRewardsByPubKey", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationRewards, err := client.(consensusclient.AttestationRewardsProvider).AttestationRewardsByPubKey(ctx, epoch, validatorPubKeys)
+		if err != nil {
+			return nil, err
+		}
+		return attestationRewards, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.AttestationRewards), nil
+}
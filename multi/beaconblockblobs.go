@@ -22,7 +22,7 @@ import (
 
 // BeaconBlockBlobs fetches the blobs given a block ID.
 func (s *Service) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconBlockBlobs", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconBlockBlobs, err := client.(consensusclient.BeaconBlockBlobsProvider).BeaconBlockBlobs(ctx, blockID)
 		if err != nil {
 			return nil, err
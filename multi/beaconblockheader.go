@@ -18,11 +18,12 @@ import (
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // BeaconBlockHeader provides the block header of a given block ID.
 func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconBlockHeader", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconBlockHeader, err := client.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, blockID)
 		if err != nil {
 			return nil, err
@@ -37,3 +38,27 @@ func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.B
 	}
 	return res.(*api.BeaconBlockHeader), nil
 }
+
+// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+func (s *Service) BeaconBlockHeaders(ctx context.Context,
+	slot *phase0.Slot,
+	parentRoot *phase0.Root,
+) (
+	[]*api.BeaconBlockHeader,
+	error,
+) {
+	res, err := s.doCall(ctx, "BeaconBlockHeaders", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		beaconBlockHeaders, err := client.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeaders(ctx, slot, parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		return beaconBlockHeaders, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*api.BeaconBlockHeader), nil
+}
@@ -30,7 +30,7 @@ func (s *Service) BeaconBlockProposal(ctx context.Context,
 	*spec.VersionedBeaconBlock,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconBlockProposal", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.BeaconBlockProposalProvider).BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 		if err != nil {
 			return nil, err
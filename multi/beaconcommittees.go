@@ -22,7 +22,7 @@ import (
 
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
 func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconCommittees", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconCommittees, err := client.(consensusclient.BeaconCommitteesProvider).BeaconCommittees(ctx, stateID)
 		if err != nil {
 			return nil, err
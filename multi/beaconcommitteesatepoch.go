@@ -23,7 +23,7 @@ import (
 
 // BeaconCommitteesAtEpoch fetches all beacon committees for the given epoch at the given state.
 func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) ([]*api.BeaconCommittee, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconCommitteesAtEpoch", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconCommittees, err := client.(consensusclient.BeaconCommitteesProvider).BeaconCommitteesAtEpoch(ctx, stateID, epoch)
 		if err != nil {
 			return nil, err
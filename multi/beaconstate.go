@@ -21,9 +21,9 @@ import (
 )
 
 // BeaconState fetches a beacon state.
-// N.B if the requested beacon state is not available this will return nil without an error.
+// N.B if the requested beacon state is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "BeaconState", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		beaconState, err := client.(consensusclient.BeaconStateProvider).BeaconState(ctx, stateID)
 		if err != nil {
 			return nil, err
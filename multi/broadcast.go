@@ -0,0 +1,124 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// BroadcastError is returned when a broadcast call fails on all clients.
+type BroadcastError struct {
+	// Errors are the errors returned by each client, keyed by address.
+	Errors map[string]error
+}
+
+func (e BroadcastError) Error() string {
+	addresses := make([]string, 0, len(e.Errors))
+	for address := range e.Errors {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	errs := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		errs = append(errs, fmt.Sprintf("%s: %v", address, e.Errors[address]))
+	}
+
+	return fmt.Sprintf("failed on all %d clients: %s", len(addresses), strings.Join(errs, "; "))
+}
+
+// Unwrap returns the errors returned by each client, allowing errors.Is() and errors.As()
+// to match the errors of individual clients.
+func (e BroadcastError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// Retryable returns true if the error returned by any of the clients is retryable.
+func (e BroadcastError) Retryable() bool {
+	for _, err := range e.Errors {
+		if api.IsRetryable(err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// doBroadcastCall carries out a call on all active clients concurrently, succeeding if any
+// of them succeed.  The response is that of the first successful client in order, which is
+// also returned.
+func (s *Service) doBroadcastCall(ctx context.Context,
+	activeClients []consensusclient.Service,
+	call callFunc,
+	errHandler errHandlerFunc,
+) (
+	interface{},
+	consensusclient.Service,
+	error,
+) {
+	responses := make([]interface{}, len(activeClients))
+	errs := make([]error, len(activeClients))
+	var wg sync.WaitGroup
+	for i := range activeClients {
+		wg.Add(1)
+		go func(i int, client consensusclient.Service) {
+			defer wg.Done()
+			res, err := call(ctx, client)
+			if err != nil {
+				_, err = s.handleCallError(ctx, client, err, errHandler)
+			}
+			responses[i] = res
+			errs[i] = err
+		}(i, activeClients[i])
+	}
+	wg.Wait()
+
+	broadcastErr := BroadcastError{
+		Errors: make(map[string]error),
+	}
+	var res interface{}
+	var responder consensusclient.Service
+	succeeded := false
+	for i, client := range activeClients {
+		if errs[i] != nil {
+			broadcastErr.Errors[client.Address()] = errs[i]
+			continue
+		}
+		if !succeeded {
+			res = responses[i]
+			responder = client
+			succeeded = true
+		}
+	}
+	if !succeeded {
+		return nil, nil, broadcastErr
+	}
+	if len(broadcastErr.Errors) > 0 {
+		s.log.Debug().Err(broadcastErr).Msg("Broadcast call failed on some clients")
+	}
+
+	return res, responder, nil
+}
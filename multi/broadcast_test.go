@@ -0,0 +1,146 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// submissionClient is a mock client that records attestation submissions, optionally failing them.
+type submissionClient struct {
+	*mock.Service
+	fail bool
+	// err is the error returned when failing; defaults to a rejection.
+	err error
+
+	mu          sync.Mutex
+	submissions int
+}
+
+func (c *submissionClient) SubmitAttestations(_ context.Context, _ []*phase0.Attestation) error {
+	c.mu.Lock()
+	c.submissions++
+	c.mu.Unlock()
+	if c.fail {
+		if c.err != nil {
+			return c.err
+		}
+		return errors.New("submission rejected")
+	}
+
+	return nil
+}
+
+func newSubmissionClients(t *testing.T, failures ...bool) ([]*submissionClient, []client.Service) {
+	t.Helper()
+
+	submissionClients := make([]*submissionClient, 0, len(failures))
+	clients := make([]client.Service, 0, len(failures))
+	for i, fail := range failures {
+		consensusClient, err := mock.New(context.Background(), mock.WithName(fmt.Sprintf("mock %d", i+1)))
+		require.NoError(t, err)
+		submissionClient := &submissionClient{
+			Service: consensusClient,
+			fail:    fail,
+		}
+		submissionClients = append(submissionClients, submissionClient)
+		clients = append(clients, submissionClient)
+	}
+
+	return submissionClients, clients
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		failures []bool
+		err      string
+	}{
+		{
+			name:     "AllSucceed",
+			failures: []bool{false, false, false},
+		},
+		{
+			name:     "SomeFail",
+			failures: []bool{true, false, true},
+		},
+		{
+			name:     "AllFail",
+			failures: []bool{true, true},
+			err:      "failed on all 2 clients: mock 1: submission rejected; mock 2: submission rejected",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissionClients, clients := newSubmissionClients(t, test.failures...)
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithSubmissionStrategy(multi.StrategyBroadcast),
+			)
+			require.NoError(t, err)
+
+			err = s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+			if test.err != "" {
+				var broadcastErr multi.BroadcastError
+				require.True(t, errors.As(err, &broadcastErr))
+				require.Len(t, broadcastErr.Errors, len(clients))
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// All clients should have received the submission.
+			for _, submissionClient := range submissionClients {
+				require.Equal(t, 1, submissionClient.submissions)
+			}
+		})
+	}
+}
+
+func TestBroadcastErrorIs(t *testing.T) {
+	ctx := context.Background()
+
+	submissionClients, clients := newSubmissionClients(t, true, true)
+	submissionClients[1].err = http.Error{Method: nethttp.MethodPost, StatusCode: nethttp.StatusBadRequest}
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+		multi.WithSubmissionStrategy(multi.StrategyBroadcast),
+	)
+	require.NoError(t, err)
+
+	err = s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+	require.ErrorIs(t, err, api.ErrInvalidRequest)
+	require.NotErrorIs(t, err, api.ErrNotFound)
+}
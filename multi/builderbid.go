@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BuilderBid fetches the bid of a builder for the execution payload of the given slot,
+// built on the given parent hash, for the proposer with the given public key.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	res, err := s.doCall(ctx, "BuilderBid", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		bid, err := client.(consensusclient.BuilderBidProvider).BuilderBid(ctx, slot, parentHash, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return bid, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.VersionedSignedBuilderBid), nil
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// BuilderStatus returns nil if the builder is ready to provide bids, otherwise an error.
+func (s *Service) BuilderStatus(ctx context.Context) error {
+	_, err := s.doCall(ctx, "BuilderStatus", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.BuilderStatusProvider).BuilderStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return true, nil
+	}, nil)
+	return err
+}
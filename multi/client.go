@@ -15,10 +15,13 @@ package multi
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -35,7 +38,7 @@ func (s *Service) monitor(ctx context.Context) {
 		case <-ctx.Done():
 			log.Trace().Msg("Context done; monitor stopping")
 			return
-		case <-time.After(30 * time.Second):
+		case <-time.After(s.healthCheckInterval):
 			s.recheck(ctx)
 		}
 	}
@@ -52,7 +55,7 @@ func (s *Service) recheck(ctx context.Context) {
 
 	// Ping each client to update its state.
 	for _, client := range clients {
-		if ping(ctx, client) {
+		if ping(ctx, client, s.maxSyncDistance) {
 			s.activateClient(ctx, client)
 		} else {
 			s.deactivateClient(ctx, client)
@@ -115,8 +118,9 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 }
 
 // ping pings a client, returning true if it is ready to serve requests and
-// false otherwise.
-func ping(ctx context.Context, client consensusclient.Service) bool {
+// false otherwise.  A syncing client is ready to serve requests if it is within
+// the maximum sync distance.
+func ping(ctx context.Context, client consensusclient.Service, maxSyncDistance phase0.Slot) bool {
 	log := zerolog.Ctx(ctx)
 
 	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
@@ -131,7 +135,9 @@ func ping(ctx context.Context, client consensusclient.Service) bool {
 		return false
 	}
 
-	return (!syncState.IsSyncing) || (syncState.HeadSlot == 0 && syncState.SyncDistance == 0)
+	return (!syncState.IsSyncing) ||
+		(syncState.HeadSlot == 0 && syncState.SyncDistance == 0) ||
+		(maxSyncDistance > 0 && syncState.SyncDistance <= maxSyncDistance)
 }
 
 // callFunc is the definition for a call function.  It provides a generic return interface
@@ -143,9 +149,38 @@ type callFunc func(ctx context.Context, client consensusclient.Service) (interfa
 // result in a provider failover.
 type errHandlerFunc func(ctx context.Context, client consensusclient.Service, err error) (bool, error)
 
-// doCall carries out a call on the active clients in turn until one succeeds.
-func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
-	log := s.log.With().Logger()
+// noActiveClientsError is returned when there are no active clients to serve a call.
+type noActiveClientsError struct {
+	msg string
+}
+
+func (e noActiveClientsError) Error() string {
+	return e.msg
+}
+
+// Retryable returns true, as clients may become active again.
+func (noActiveClientsError) Retryable() bool {
+	return true
+}
+
+// doCall carries out a call on the active clients, returning nil rather than an error
+// that matches api.ErrNotFound if configured with WithNilOnNotFound().
+func (s *Service) doCall(ctx context.Context, name string, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+	res, err := s.callClients(ctx, name, call, errHandler)
+	if err != nil && s.nilOnNotFound && errors.Is(err, api.ErrNotFound) {
+		return nil, nil
+	}
+
+	return res, err
+}
+
+// callClients carries out a call on the active clients according to the service's strategy.
+// With the failover strategy the active clients are called in turn, best scoring first,
+// until one succeeds.  Submissions, being calls whose names start with "Submit", use the
+// service's submission strategy.  If the named call has a route then only the routed
+// clients are used.
+func (s *Service) callClients(ctx context.Context, name string, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+	log := s.log.With().Str("call", name).Logger()
 	ctx = log.WithContext(ctx)
 
 	// Grab local copy of active clients in case it is updated whilst we are using it.
@@ -162,7 +197,71 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 	}
 
 	if len(activeClients) == 0 {
-		return nil, errors.New("no active clients to which to make call")
+		return nil, noActiveClientsError{msg: "no active clients to which to make call"}
+	}
+
+	if addresses, exists := s.routes[name]; exists {
+		activeClients = routedClients(activeClients, addresses)
+		if len(activeClients) == 0 {
+			return nil, noActiveClientsError{msg: fmt.Sprintf("no active routed clients to which to make %s call", name)}
+		}
+	}
+
+	if s.maxHeadLag > 0 && headLagCalls[name] {
+		activeClients = s.headLagClients(ctx, activeClients)
+	}
+
+	// Prefer the healthiest clients.
+	activeClients = s.orderByScore(activeClients)
+	call = s.scoredCall(call)
+
+	strategy := s.strategy
+	if strings.HasPrefix(name, "Submit") {
+		strategy = s.submissionStrategy
+	}
+	callStrategy, exists, err := callStrategyFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		strategy = callStrategy
+	}
+	call, publishMetadata := isolateResponseMetadata(ctx, call)
+
+	var res interface{}
+	var responder consensusclient.Service
+	switch strategy {
+	case StrategyQuorum:
+		res, responder, err = s.doQuorumCall(ctx, activeClients, call, errHandler)
+	case StrategyFastest:
+		res, responder, err = s.doFastestCall(ctx, name, activeClients, call, errHandler)
+	case StrategyBroadcast:
+		res, responder, err = s.doBroadcastCall(ctx, activeClients, call, errHandler)
+	default:
+		res, responder, err = s.doFailoverCall(ctx, name, activeClients, call, errHandler)
+	}
+	publishMetadata(responder)
+
+	return res, err
+}
+
+// doFailoverCall carries out a call on the active clients in turn until one succeeds,
+// returning the response along with the client that provided it.
+func (s *Service) doFailoverCall(ctx context.Context,
+	name string,
+	activeClients []consensusclient.Service,
+	call callFunc,
+	errHandler errHandlerFunc,
+) (
+	interface{},
+	consensusclient.Service,
+	error,
+) {
+	// Sticky calls prefer the client that served them earlier in the epoch.
+	sticky := false
+	var epoch phase0.Epoch
+	if s.epochSticky && stickyCalls[name] {
+		activeClients, epoch, sticky = s.stickyClients(ctx, activeClients)
 	}
 
 	var err error
@@ -170,29 +269,72 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 	for _, client := range activeClients {
 		res, err = call(ctx, client)
 		if err != nil {
-			failover := true
-			if errHandler != nil {
-				failover, err = errHandler(ctx, client, err)
-			}
-
+			var failover bool
+			failover, err = s.handleCallError(ctx, client, err, errHandler)
 			if failover {
-				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Deactivating client on error")
 				// Failed with this client; try the next.
-				s.deactivateClient(ctx, client)
 				continue
 			}
 
 			// No failover required, return.
-			return res, err
+			return res, client, err
 		}
 		if res == nil {
 			// No response from this client; try the next.
-			err = errors.New("empty response")
+			err = errors.Wrap(api.ErrNotFound, "empty response")
 			continue
 		}
-		return res, nil
+		if sticky {
+			s.setStickyClient(epoch, client)
+		}
+		if s.verifyConsistency && verifiedCalls[name] && len(activeClients) > 1 {
+			secondary := activeClients[0]
+			if secondary == client {
+				secondary = activeClients[1]
+			}
+			s.verifyCall(name, call, client, secondary, res)
+		}
+		return res, client, nil
+	}
+	return nil, nil, err
+}
+
+// handleCallError handles an error returned from a call to a client, deactivating the client
+// if the error requires a failover.  It returns if the error requires a failover, along with
+// the error, which may have been rewritten by the error handler.  Errors that match
+// api.ErrNotFound fail over without deactivating the client, as the item may be
+// available from another client.  Errors that are *api.UnknownVersionError are returned
+// without failing over, as they carry valid data from the node.
+func (s *Service) handleCallError(ctx context.Context,
+	client consensusclient.Service,
+	err error,
+	errHandler errHandlerFunc,
+) (
+	bool,
+	error,
+) {
+	failover := true
+	if errHandler != nil {
+		failover, err = errHandler(ctx, client, err)
 	}
-	return nil, err
+	if failover && errors.Is(err, api.ErrNotFound) {
+		return true, err
+	}
+	var unknownVersionErr *api.UnknownVersionError
+	if errors.As(err, &unknownVersionErr) {
+		// The node returned valid data that the client does not understand, so there is
+		// no reason to deactivate it.
+		return false, err
+	}
+	if failover && s.failoverPolicy != nil {
+		failover = s.failoverPolicy(ctx, client, err)
+	}
+	if failover {
+		s.log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Deactivating client on error")
+		s.deactivateClient(ctx, client)
+	}
+
+	return failover, err
 }
 
 // providerInfo returns information on the provider.
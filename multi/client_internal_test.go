@@ -17,6 +17,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/mock"
@@ -141,3 +142,41 @@ func TestRecheck(t *testing.T) {
 	// Should re-activate in recheck so not return an error.
 	require.NoError(t, err)
 }
+
+// TestRecheckSyncDistance tests that recheck moves clients between lists according
+// to their sync distance.
+func TestRecheckSyncDistance(t *testing.T) {
+	ctx := context.Background()
+
+	consensusClient, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	syncingClient, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	syncingClient.SyncDistance = 20
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			consensusClient,
+			syncingClient,
+		}),
+		WithHealthCheckInterval(time.Hour),
+		WithMaxSyncDistance(10),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+	require.Len(t, multi.activeClients, 1)
+	require.Len(t, multi.inactiveClients, 1)
+
+	// Syncing client comes within the maximum sync distance.
+	syncingClient.SyncDistance = 5
+	multi.recheck(ctx)
+	require.Len(t, multi.activeClients, 2)
+	require.Empty(t, multi.inactiveClients)
+
+	// Syncing client falls behind again.
+	syncingClient.SyncDistance = 11
+	multi.recheck(ctx)
+	require.Len(t, multi.activeClients, 1)
+	require.Equal(t, []consensusclient.Service{syncingClient}, multi.inactiveClients)
+}
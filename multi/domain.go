@@ -33,7 +33,7 @@ func (s *Service) Domain(ctx context.Context,
 	phase0.Domain,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "Domain", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		domain, err := client.(consensusclient.DomainProvider).Domain(ctx, domainType, epoch)
 		if err != nil {
 			return nil, err
@@ -56,7 +56,7 @@ func (s *Service) GenesisDomain(ctx context.Context,
 	phase0.Domain,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "GenesisDomain", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		domain, err := client.(consensusclient.DomainProvider).GenesisDomain(ctx, domainType)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// eventDedupSize is the number of recent events remembered for deduplication.
+const eventDedupSize = 1024
+
+// eventDeduplicator remembers recent events, to allow the same event received
+// from multiple clients to be delivered once.
+type eventDeduplicator struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+	keys []string
+	next int
+}
+
+func newEventDeduplicator() *eventDeduplicator {
+	return &eventDeduplicator{
+		seen: make(map[string]struct{}, eventDedupSize),
+		keys: make([]string, eventDedupSize),
+	}
+}
+
+// firstSeen returns true if the event has not been seen recently, remembering it.
+func (d *eventDeduplicator) firstSeen(event *api.Event) bool {
+	key := eventKey(event)
+	if key == "" {
+		// Unable to identify the event, so treat it as new.
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.seen[key]; exists {
+		return false
+	}
+	if d.keys[d.next] != "" {
+		delete(d.seen, d.keys[d.next])
+	}
+	d.keys[d.next] = key
+	d.next = (d.next + 1) % eventDedupSize
+	d.seen[key] = struct{}{}
+
+	return true
+}
+
+// eventKey returns a key identifying the event.  Events for the chain are identified
+// by their slot or epoch and block root, as clients can differ in other details of them.
+// Other events are identified by their contents.
+func eventKey(event *api.Event) string {
+	switch data := event.Data.(type) {
+	case *api.HeadEvent:
+		return fmt.Sprintf("%s:%d:%#x", event.Topic, data.Slot, data.Block)
+	case *api.BlockEvent:
+		return fmt.Sprintf("%s:%d:%#x", event.Topic, data.Slot, data.Block)
+	case *api.FinalizedCheckpointEvent:
+		return fmt.Sprintf("%s:%d:%#x", event.Topic, data.Epoch, data.Block)
+	case *api.ChainReorgEvent:
+		return fmt.Sprintf("%s:%d:%#x:%#x", event.Topic, data.Slot, data.OldHeadBlock, data.NewHeadBlock)
+	case *api.BlobSidecarEvent:
+		return fmt.Sprintf("%s:%d:%#x:%d", event.Topic, data.Slot, data.BlockRoot, data.Index)
+	default:
+		encoded, err := json.Marshal(event.Data)
+		if err != nil {
+			return ""
+		}
+
+		return fmt.Sprintf("%s:%x", event.Topic, sha256.Sum256(encoded))
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregatedEvents(t *testing.T) {
+	received := make([]*api.Event, 0)
+	handler := func(event *api.Event) {
+		received = append(received, event)
+	}
+
+	dedup := newEventDeduplicator()
+	handler1 := &activeHandler{log: zerolog.Nop(), address: "client 1", handler: handler, dedup: dedup}
+	handler2 := &activeHandler{log: zerolog.Nop(), address: "client 2", handler: handler, dedup: dedup}
+
+	head := &api.Event{
+		Topic: "head",
+		Data: &api.HeadEvent{
+			Slot:  1,
+			Block: phase0.Root{0x01},
+		},
+	}
+	// Same head from a different client, which differs in a detail that does not identify the event.
+	otherHead := &api.Event{
+		Topic: "head",
+		Data: &api.HeadEvent{
+			Slot:            1,
+			Block:           phase0.Root{0x01},
+			EpochTransition: true,
+		},
+	}
+	nextHead := &api.Event{
+		Topic: "head",
+		Data: &api.HeadEvent{
+			Slot:  2,
+			Block: phase0.Root{0x02},
+		},
+	}
+	exit := &api.Event{
+		Topic: "voluntary_exit",
+		Data: &phase0.SignedVoluntaryExit{
+			Message: &phase0.VoluntaryExit{
+				Epoch:          1,
+				ValidatorIndex: 2,
+			},
+		},
+	}
+
+	handler1.handleEvent(head)
+	handler2.handleEvent(otherHead)
+	handler2.handleEvent(nextHead)
+	handler1.handleEvent(nextHead)
+	handler2.handleEvent(exit)
+	handler1.handleEvent(exit)
+
+	require.Equal(t, []*api.Event{head, nextHead, exit}, received)
+}
+
+func TestEventDeduplicatorEviction(t *testing.T) {
+	dedup := newEventDeduplicator()
+
+	event := func(slot phase0.Slot) *api.Event {
+		return &api.Event{
+			Topic: "block",
+			Data: &api.BlockEvent{
+				Slot: slot,
+			},
+		}
+	}
+
+	require.True(t, dedup.firstSeen(event(0)))
+	require.False(t, dedup.firstSeen(event(0)))
+	for i := 1; i < eventDedupSize; i++ {
+		require.True(t, dedup.firstSeen(event(phase0.Slot(i))))
+	}
+	// The first event is still remembered.
+	require.False(t, dedup.firstSeen(event(0)))
+	// Adding another event evicts the first.
+	require.True(t, dedup.firstSeen(event(phase0.Slot(eventDedupSize))))
+	require.True(t, dedup.firstSeen(event(0)))
+}
@@ -33,7 +33,12 @@ func (s *Service) Events(ctx context.Context,
 	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
 
 	// Because events are streams we treat them differently from all other calls.
-	// We listen to all active clients, and only pass along events from the currently active provider.
+	// We listen to all active clients, and only pass along events from the currently active provider,
+	// or if events are aggregated pass along the first instance of each event from any provider.
+	var dedup *eventDeduplicator
+	if s.aggregatedEvents {
+		dedup = newEventDeduplicator()
+	}
 
 	// Grab local copy of both active and inactive clients in case it is updated whilst we are using it.
 	s.clientsMu.RLock()
@@ -48,6 +53,7 @@ func (s *Service) Events(ctx context.Context,
 			log:     log.With().Logger(),
 			address: client.Address(),
 			handler: handler,
+			dedup:   dedup,
 		}
 		if err := client.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
 			inactiveClients = append(inactiveClients, client)
@@ -63,6 +69,7 @@ func (s *Service) Events(ctx context.Context,
 			log:     log.With().Logger(),
 			address: inactiveClient.Address(),
 			handler: handler,
+			dedup:   dedup,
 		}
 		go func(c consensusclient.Service, ah *activeHandler) {
 			for {
@@ -97,10 +104,19 @@ type activeHandler struct {
 	log     zerolog.Logger
 	address string
 	handler consensusclient.EventHandlerFunc
+	// dedup deduplicates events across providers; nil if events are not aggregated.
+	dedup *eventDeduplicator
 }
 
 func (h *activeHandler) handleEvent(event *api.Event) {
 	h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Event received")
+	if h.dedup != nil {
+		if h.dedup.firstSeen(event) {
+			h.log.Trace().Str("address", h.address).Str("topic", event.Topic).Msg("Forwarding as first instance of event")
+			h.handler(event)
+		}
+		return
+	}
 	// We only forward events from the currently active provider.  If we did not do this then we could end up with
 	// inconsistent results, for example a client may receive a `head` event and a subsequent call to fetch the head
 	// block end up with an earlier block.
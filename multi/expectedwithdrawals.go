@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// ExpectedWithdrawals fetches the withdrawals expected to be included in the next block built on the given state.
+func (s *Service) ExpectedWithdrawals(ctx context.Context, stateID string) ([]*api.ExpectedWithdrawal, error) {
+	res, err := s.doCall(ctx, "ExpectedWithdrawals", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		expectedWithdrawals, err := client.(consensusclient.ExpectedWithdrawalsProvider).ExpectedWithdrawals(ctx, stateID)
+		if err != nil {
+			return nil, err
+		}
+		return expectedWithdrawals, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*api.ExpectedWithdrawal), nil
+}
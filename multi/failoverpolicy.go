@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+)
+
+// FailoverPolicyFunc decides if an error returned from a call to a client should
+// result in the client being deactivated and the call failing over to another client.
+// It is consulted after any call-specific error handling.
+type FailoverPolicyFunc func(ctx context.Context, client consensusclient.Service, err error) bool
+
+// FailoverOnServerErrors is a failover policy that fails over on server errors, rate
+// limiting and failures to connect, but not on other client errors, such as bad requests,
+// which indicate a problem with the call rather than the client.
+func FailoverOnServerErrors(_ context.Context, _ consensusclient.Service, err error) bool {
+	var httpErr http.Error
+	if !errors.As(err, &httpErr) {
+		// Not a response from the server, so a failure to connect or similar.
+		return true
+	}
+
+	return httpErr.StatusCode >= nethttp.StatusInternalServerError ||
+		httpErr.StatusCode == nethttp.StatusTooManyRequests
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		policy      multi.FailoverPolicyFunc
+		err         error
+		failover    bool
+		expectedErr string
+	}{
+		{
+			name:     "DefaultBadRequest",
+			err:      http.Error{Method: nethttp.MethodPost, StatusCode: nethttp.StatusBadRequest, Data: []byte("bad")},
+			failover: true,
+		},
+		{
+			name:        "ServerErrorsBadRequest",
+			policy:      multi.FailoverOnServerErrors,
+			err:         errors.Wrap(http.Error{Method: nethttp.MethodPost, StatusCode: nethttp.StatusBadRequest, Data: []byte("bad")}, "failed"),
+			expectedErr: "failed: POST failed with status 400: bad",
+		},
+		{
+			name:     "ServerErrorsServiceUnavailable",
+			policy:   multi.FailoverOnServerErrors,
+			err:      http.Error{Method: nethttp.MethodPost, StatusCode: nethttp.StatusServiceUnavailable},
+			failover: true,
+		},
+		{
+			name:     "ServerErrorsConnection",
+			policy:   multi.FailoverOnServerErrors,
+			err:      errors.New("connection refused"),
+			failover: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissionClients, clients := newSubmissionClients(t, true, false)
+			submissionClients[0].err = test.err
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithFailoverPolicy(test.policy),
+			)
+			require.NoError(t, err)
+
+			err = s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+			if test.failover {
+				require.NoError(t, err)
+				require.Equal(t, 1, submissionClients[1].submissions)
+				require.NotEqual(t, "mock 1", s.(*multi.Service).ClientStatuses()[0].Address)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+				require.Zero(t, submissionClients[1].submissions)
+				require.True(t, s.(*multi.Service).ClientStatuses()[0].Active)
+				require.Equal(t, "mock 1", s.(*multi.Service).ClientStatuses()[0].Address)
+			}
+		})
+	}
+}
+
+// notFoundClient is a mock client that does not have any block roots.
+type notFoundClient struct {
+	*mock.Service
+}
+
+func (*notFoundClient) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+	return nil, http.Error{Method: nethttp.MethodGet, StatusCode: nethttp.StatusNotFound}
+}
+
+func TestNotFoundFailover(t *testing.T) {
+	ctx := context.Background()
+
+	mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			&notFoundClient{Service: mock1},
+			mock2,
+		}),
+		multi.WithFailoverPolicy(multi.FailoverOnServerErrors),
+	)
+	require.NoError(t, err)
+
+	// The second client should provide the root.
+	root, err := s.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	require.NotNil(t, root)
+
+	// The first client should remain active.
+	for _, status := range s.(*multi.Service).ClientStatuses() {
+		require.True(t, status.Active)
+	}
+
+	// If no client has the item the error should be returned.
+	s, err = multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			&notFoundClient{Service: mock1},
+		}),
+	)
+	require.NoError(t, err)
+	_, err = s.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.ErrorIs(t, err, api.ErrNotFound)
+	require.True(t, s.(*multi.Service).ClientStatuses()[0].Active)
+	// If configured, nil should be returned rather than the error.
+	s, err = multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			&notFoundClient{Service: mock1},
+		}),
+		multi.WithNilOnNotFound(true),
+	)
+	require.NoError(t, err)
+	root, err = s.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	require.Nil(t, root)
+}
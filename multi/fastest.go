@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+type fastestResult struct {
+	client consensusclient.Service
+	res    interface{}
+	err    error
+}
+
+// doFastestCall carries out a call on all active clients concurrently, returning the
+// first successful response, along with the client that provided it, and cancelling the
+// outstanding calls.  The client that provided the response is reported in logs and metrics.
+func (s *Service) doFastestCall(ctx context.Context,
+	name string,
+	activeClients []consensusclient.Service,
+	call callFunc,
+	errHandler errHandlerFunc,
+) (
+	interface{},
+	consensusclient.Service,
+	error,
+) {
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Results are buffered so that outstanding calls can complete after the first success.
+	resultsCh := make(chan *fastestResult, len(activeClients))
+	for _, client := range activeClients {
+		go func(client consensusclient.Service) {
+			res, err := call(callCtx, client)
+			if err != nil && callCtx.Err() == nil {
+				_, err = s.handleCallError(ctx, client, err, errHandler)
+			}
+			if err == nil && res == nil {
+				err = errors.Wrap(api.ErrNotFound, "empty response")
+			}
+			resultsCh <- &fastestResult{
+				client: client,
+				res:    res,
+				err:    err,
+			}
+		}(client)
+	}
+
+	var err error
+	for range activeClients {
+		result := <-resultsCh
+		if result.err == nil {
+			s.log.Debug().Str("call", name).Str("address", result.client.Address()).Msg("Call won by client")
+			incWinsMetric(ctx, name, result.client.Address())
+
+			return result.res, result.client, nil
+		}
+		err = result.err
+	}
+
+	return nil, nil, err
+}
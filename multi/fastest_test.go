@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFastest(t *testing.T) {
+	ctx := context.Background()
+
+	slowMock, err := mock.New(ctx)
+	require.NoError(t, err)
+	slowMock.HeadSlot = 10
+	slowClient, err := testclients.NewSleepy(ctx, 500*time.Millisecond, 600*time.Millisecond, slowMock)
+	require.NoError(t, err)
+	fastMock, err := mock.New(ctx)
+	require.NoError(t, err)
+	fastMock.HeadSlot = 11
+	fastClient, err := testclients.NewSleepy(ctx, time.Millisecond, 10*time.Millisecond, fastMock)
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			slowClient,
+			fastClient,
+		}),
+		multi.WithStrategy(multi.StrategyFastest),
+	)
+	require.NoError(t, err)
+
+	started := time.Now()
+	syncState, err := s.(client.NodeSyncingProvider).NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(11), syncState.HeadSlot)
+	require.Less(t, time.Since(started), 500*time.Millisecond)
+}
+
+// raceClient is a mock client whose attestation submissions take a given time,
+// recording if they are cancelled.
+type raceClient struct {
+	*mock.Service
+	delay time.Duration
+
+	mu        sync.Mutex
+	cancelled bool
+}
+
+func (c *raceClient) SubmitAttestations(ctx context.Context, _ []*phase0.Attestation) error {
+	select {
+	case <-time.After(c.delay):
+		return nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		c.cancelled = true
+		c.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+func TestFastestSubmission(t *testing.T) {
+	ctx := context.Background()
+
+	slowMock, err := mock.New(ctx, mock.WithName("slow"))
+	require.NoError(t, err)
+	slowClient := &raceClient{Service: slowMock, delay: time.Second}
+	fastMock, err := mock.New(ctx, mock.WithName("fast"))
+	require.NoError(t, err)
+	fastClient := &raceClient{Service: fastMock, delay: time.Millisecond}
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients([]client.Service{
+			slowClient,
+			fastClient,
+		}),
+		multi.WithSubmissionStrategy(multi.StrategyFastest),
+	)
+	require.NoError(t, err)
+
+	started := time.Now()
+	require.NoError(t, s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}))
+	require.Less(t, time.Since(started), 500*time.Millisecond)
+
+	// The outstanding submission should be cancelled.
+	require.Eventually(t, func() bool {
+		slowClient.mu.Lock()
+		defer slowClient.mu.Unlock()
+
+		return slowClient.cancelled
+	}, time.Second, 10*time.Millisecond)
+
+	// The cancelled submission should not count against the client.
+	for _, status := range s.(*multi.Service).ClientStatuses() {
+		require.True(t, status.Active)
+	}
+}
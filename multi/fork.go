@@ -22,7 +22,7 @@ import (
 
 // Fork fetches fork information for the given state.
 func (s *Service) Fork(ctx context.Context, stateID string) (*phase0.Fork, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "Fork", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		fork, err := client.(consensusclient.ForkProvider).Fork(ctx, stateID)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ForkDigest provides the fork digest at a given epoch.
+func (s *Service) ForkDigest(ctx context.Context, epoch phase0.Epoch) (phase0.ForkDigest, error) {
+	res, err := s.doCall(ctx, "ForkDigest", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		forkDigest, err := client.(consensusclient.ForkDigestProvider).ForkDigest(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		return forkDigest, nil
+	}, nil)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+	return res.(phase0.ForkDigest), nil
+}
+
+// ForkVersion provides the fork version at a given epoch.
+func (s *Service) ForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, error) {
+	res, err := s.doCall(ctx, "ForkVersion", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		forkVersion, err := client.(consensusclient.ForkDigestProvider).ForkVersion(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		return forkVersion, nil
+	}, nil)
+	if err != nil {
+		return phase0.Version{}, err
+	}
+	return res.(phase0.Version), nil
+}
+
+// nextForkVersion is the result of a call to NextForkVersion.
+type nextForkVersion struct {
+	version phase0.Version
+	epoch   phase0.Epoch
+}
+
+// NextForkVersion provides the version and epoch of the first fork scheduled after a given epoch.
+// If no fork is scheduled this returns the fork version at the given epoch and FAR_FUTURE_EPOCH.
+func (s *Service) NextForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error) {
+	res, err := s.doCall(ctx, "NextForkVersion", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		version, forkEpoch, err := client.(consensusclient.ForkDigestProvider).NextForkVersion(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		return &nextForkVersion{
+			version: version,
+			epoch:   forkEpoch,
+		}, nil
+	}, nil)
+	if err != nil {
+		return phase0.Version{}, 0, err
+	}
+	next := res.(*nextForkVersion)
+	return next.version, next.epoch, nil
+}
@@ -22,7 +22,7 @@ import (
 
 // ForkSchedule provides details of past and future changes in the chain's fork version.
 func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "ForkSchedule", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		forkSchedule, err := client.(consensusclient.ForkScheduleProvider).ForkSchedule(ctx)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,89 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// headLagCalls are the calls that are only served by clients close to the best-known
+// head when a maximum head lag is set.
+var headLagCalls = map[string]bool{
+	"AttestationData":     true,
+	"AttesterDuties":      true,
+	"ProposerDuties":      true,
+	"SyncCommitteeDuties": true,
+}
+
+// headSlots obtains the head slot of each of the clients.  Clients whose head slot
+// cannot be obtained are not present in the result.
+func headSlots(ctx context.Context, clients []consensusclient.Service) map[consensusclient.Service]phase0.Slot {
+	var mu sync.Mutex
+	res := make(map[consensusclient.Service]phase0.Slot, len(clients))
+
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		provider, isProvider := client.(consensusclient.NodeSyncingProvider)
+		if !isProvider {
+			continue
+		}
+		wg.Add(1)
+		go func(client consensusclient.Service, provider consensusclient.NodeSyncingProvider) {
+			defer wg.Done()
+			syncState, err := provider.NodeSyncing(ctx)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			res[client] = syncState.HeadSlot
+			mu.Unlock()
+		}(client, provider)
+	}
+	wg.Wait()
+
+	return res
+}
+
+// headLagClients returns the clients whose head is within the maximum head lag of the
+// best-known head.  Clients whose head cannot be obtained are retained, as are the
+// clients if none report a head.
+func (s *Service) headLagClients(ctx context.Context, clients []consensusclient.Service) []consensusclient.Service {
+	slots := headSlots(ctx, clients)
+	if len(slots) == 0 {
+		return clients
+	}
+
+	bestSlot := phase0.Slot(0)
+	for _, slot := range slots {
+		if slot > bestSlot {
+			bestSlot = slot
+		}
+	}
+
+	res := make([]consensusclient.Service, 0, len(clients))
+	for _, client := range clients {
+		slot, exists := slots[client]
+		if exists && slot+s.maxHeadLag < bestSlot {
+			s.log.Debug().Str("address", client.Address()).Uint64("head_slot", uint64(slot)).Uint64("best_head_slot", uint64(bestSlot)).Msg("Client head too far behind; excluding")
+			continue
+		}
+		res = append(res, client)
+	}
+
+	return res
+}
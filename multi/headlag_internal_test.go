@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxHeadLag(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		maxHeadLag   phase0.Slot
+		headSlot1    phase0.Slot
+		client1Calls int
+		client2Calls int
+	}{
+		{
+			name:         "Disabled",
+			headSlot1:    100,
+			client1Calls: 1,
+		},
+		{
+			name:         "WithinLag",
+			maxHeadLag:   10,
+			headSlot1:    195,
+			client1Calls: 1,
+		},
+		{
+			name:         "BeyondLag",
+			maxHeadLag:   10,
+			headSlot1:    100,
+			client2Calls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+			require.NoError(t, err)
+			mock1.HeadSlot = test.headSlot1
+			client1 := &countingClient{Service: mock1}
+			mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+			require.NoError(t, err)
+			mock2.HeadSlot = 200
+			client2 := &countingClient{Service: mock2}
+
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients([]consensusclient.Service{
+					client1,
+					client2,
+				}),
+				WithMaxHeadLag(test.maxHeadLag),
+			)
+			require.NoError(t, err)
+
+			_, err = s.(*Service).AttestationData(ctx, 1, 0)
+			require.NoError(t, err)
+			require.Equal(t, test.client1Calls, client1.calls)
+			require.Equal(t, test.client2Calls, client2.calls)
+		})
+	}
+}
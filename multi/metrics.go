@@ -24,6 +24,9 @@ import (
 var (
 	providersMetric      *prometheus.GaugeVec
 	providerActiveMetric *prometheus.GaugeVec
+	providerScoreMetric  *prometheus.GaugeVec
+	divergencesMetric    *prometheus.CounterVec
+	winsMetric           *prometheus.CounterVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -60,6 +63,33 @@ func registerPrometheusMetrics(_ context.Context) error {
 	if err := prometheus.Register(providerActiveMetric); err != nil {
 		return errors.Wrap(err, "failed to register provider_state")
 	}
+	providerScoreMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "provider_score",
+		Help:      "Score of provider, based on its recent latency and error rate",
+	}, []string{"provider"})
+	if err := prometheus.Register(providerScoreMetric); err != nil {
+		return errors.Wrap(err, "failed to register provider_score")
+	}
+	divergencesMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "divergences_total",
+		Help:      "Number of responses that diverged between providers",
+	}, []string{"call"})
+	if err := prometheus.Register(divergencesMetric); err != nil {
+		return errors.Wrap(err, "failed to register divergences_total")
+	}
+	winsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "multi",
+		Name:      "wins_total",
+		Help:      "Number of calls made with the fastest strategy that were won by the provider",
+	}, []string{"call", "provider"})
+	if err := prometheus.Register(winsMetric); err != nil {
+		return errors.Wrap(err, "failed to register wins_total")
+	}
 
 	return nil
 }
@@ -79,3 +109,21 @@ func setProvidersMetric(_ context.Context, state string, count int) {
 		providersMetric.WithLabelValues(state).Set(float64(count))
 	}
 }
+
+func setProviderScoreMetric(_ context.Context, provider string, score float64) {
+	if providerScoreMetric != nil {
+		providerScoreMetric.WithLabelValues(provider).Set(score)
+	}
+}
+
+func incDivergenceMetric(_ context.Context, call string) {
+	if divergencesMetric != nil {
+		divergencesMetric.WithLabelValues(call).Inc()
+	}
+}
+
+func incWinsMetric(_ context.Context, call string, provider string) {
+	if winsMetric != nil {
+		winsMetric.WithLabelValues(call, provider).Inc()
+	}
+}
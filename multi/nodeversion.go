@@ -17,11 +17,12 @@ import (
 	"context"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 )
 
 // NodeVersion provides the version information of the node.
 func (s *Service) NodeVersion(ctx context.Context) (string, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "NodeVersion", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		aggregate, err := client.(consensusclient.NodeVersionProvider).NodeVersion(ctx)
 		if err != nil {
 			return nil, err
@@ -36,3 +37,21 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 	}
 	return res.(string), nil
 }
+
+// ParsedNodeVersion provides the version information of the node parsed in to its component parts.
+func (s *Service) ParsedNodeVersion(ctx context.Context) (*apiv1.NodeVersion, error) {
+	res, err := s.doCall(ctx, "ParsedNodeVersion", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		nodeVersion, err := client.(consensusclient.ParsedNodeVersionProvider).ParsedNodeVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return nodeVersion, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*apiv1.NodeVersion), nil
+}
@@ -14,21 +14,36 @@
 package multi
 
 import (
+	"fmt"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel     zerolog.Level
-	monitor      metrics.Service
-	clients      []consensusclient.Service
-	addresses    []string
-	timeout      time.Duration
-	extraHeaders map[string]string
+	logLevel            zerolog.Level
+	monitor             metrics.Service
+	clients             []consensusclient.Service
+	addresses           []string
+	timeout             time.Duration
+	extraHeaders        map[string]string
+	strategy            Strategy
+	submissionStrategy  Strategy
+	quorumClients       int
+	healthCheckInterval time.Duration
+	maxSyncDistance     phase0.Slot
+	maxHeadLag          phase0.Slot
+	routes              map[string][]string
+	epochSticky         bool
+	aggregatedEvents    bool
+	failoverPolicy      FailoverPolicyFunc
+	verifyConsistency   bool
+	divergenceHandler   DivergenceHandlerFunc
+	nilOnNotFound       bool
 }
 
 // Parameter is the interface for service parameters.
@@ -84,12 +99,121 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithStrategy sets the strategy used to select the clients that serve each call.
+func WithStrategy(strategy Strategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.strategy = strategy
+	})
+}
+
+// WithSubmissionStrategy sets the strategy used to select the clients that serve
+// each submission.  StrategyFastest races each submission against the active clients,
+// cancelling the outstanding requests once the first succeeds, which suits time-critical
+// submissions such as blocks.
+func WithSubmissionStrategy(strategy Strategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submissionStrategy = strategy
+	})
+}
+
+// WithQuorumClients sets the number of active clients queried by calls made with the
+// quorum strategy.  A value of 0 means that all active clients are queried.
+func WithQuorumClients(quorumClients int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.quorumClients = quorumClients
+	})
+}
+
+// WithHealthCheckInterval sets the interval at which clients are checked, with inactive
+// clients that have become healthy returned to the active list.
+func WithHealthCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheckInterval = interval
+	})
+}
+
+// WithMaxSyncDistance sets the maximum sync distance, in slots, at which a syncing client
+// is still considered healthy.  A value of 0 means that a client must not be syncing.
+func WithMaxSyncDistance(maxSyncDistance phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxSyncDistance = maxSyncDistance
+	})
+}
+
+// WithRoute routes the named call, for example "BeaconState", to the clients with the given
+// addresses.  Routed calls fail if none of the routed clients are active.
+func WithRoute(call string, addresses ...string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.routes[call] = append(p.routes[call], addresses...)
+	})
+}
+
+// WithEpochSticky sets whether duty and attestation data calls made in an epoch are
+// sent to the same client, unless it fails.  This avoids the calls being served by clients
+// with differing views of the chain.  It only applies to the failover strategy.
+func WithEpochSticky(epochSticky bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.epochSticky = epochSticky
+	})
+}
+
+// WithAggregatedEvents sets whether events are received from all clients and deduplicated,
+// rather than only being received from the active client.  This allows events to continue
+// to be delivered if any individual client fails.  Note that the event handler can be
+// called concurrently when events are aggregated.
+func WithAggregatedEvents(aggregatedEvents bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregatedEvents = aggregatedEvents
+	})
+}
+
+// WithFailoverPolicy sets the policy that decides if an error returned from a call to
+// a client results in a failover.  By default all errors result in a failover, unless
+// handled by the call itself.
+func WithFailoverPolicy(policy FailoverPolicyFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.failoverPolicy = policy
+	})
+}
+
+// WithConsistencyVerification sets whether the responses to critical calls, such as finality
+// and duties, are cross-checked against a second client in the background.  Divergences are
+// logged, counted in metrics, and passed to the handler if supplied.  Note that calls for
+// moving state, such as "head", can diverge briefly as clients process new blocks.
+func WithConsistencyVerification(verifyConsistency bool, handler DivergenceHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.verifyConsistency = verifyConsistency
+		p.divergenceHandler = handler
+	})
+}
+
+// WithMaxHeadLag sets the maximum number of slots that a client's head can be behind the
+// best-known head of the active clients for it to serve duty and attestation data calls.
+// A value of 0 disables the check.
+func WithMaxHeadLag(maxHeadLag phase0.Slot) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxHeadLag = maxHeadLag
+	})
+}
+
+// WithNilOnNotFound sets whether providers return nil without an error, rather than an error
+// that matches api.ErrNotFound, when none of the clients can supply the requested item.
+// This provides the behavior of earlier versions of the library.  Clients are still
+// called in turn, as an item not found on one client may be available from another.
+func WithNilOnNotFound(nilOnNotFound bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nilOnNotFound = nilOnNotFound
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:     zerolog.GlobalLevel(),
-		timeout:      2 * time.Second,
-		extraHeaders: make(map[string]string),
+		logLevel:            zerolog.GlobalLevel(),
+		timeout:             2 * time.Second,
+		extraHeaders:        make(map[string]string),
+		healthCheckInterval: 30 * time.Second,
+		routes:              make(map[string][]string),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -100,6 +224,23 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	if parameters.healthCheckInterval <= 0 {
+		return nil, errors.New("no health check interval specified")
+	}
+	if parameters.strategy < StrategyFailover || parameters.strategy > StrategyBroadcast {
+		return nil, errors.New("invalid strategy")
+	}
+	if parameters.submissionStrategy < StrategyFailover || parameters.submissionStrategy > StrategyBroadcast {
+		return nil, errors.New("invalid submission strategy")
+	}
+	if parameters.quorumClients < 0 {
+		return nil, errors.New("quorum clients cannot be negative")
+	}
+	for call, addresses := range parameters.routes {
+		if len(addresses) == 0 {
+			return nil, fmt.Errorf("no addresses specified for %s route", call)
+		}
+	}
 	if len(parameters.clients)+len(parameters.addresses) == 0 {
 		return nil, errors.New("no Ethereum 2 clients specified")
 	}
@@ -30,7 +30,7 @@ func (s *Service) ProposerDuties(ctx context.Context,
 	[]*api.ProposerDuty,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "ProposerDuties", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, epoch, validatorIndices)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,166 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// QuorumError is returned when the clients queried by a quorum call fail to agree on a response.
+type QuorumError struct {
+	// Queried is the number of clients queried.
+	Queried int
+	// Required is the number of clients required to agree.
+	Required int
+	// Agreed is the largest number of clients that agreed on a response.
+	Agreed int
+	// Errored is the number of clients that returned an error.
+	Errored int
+	// Errors are the errors returned by each client that errored, keyed by address.
+	Errors map[string]error
+}
+
+func (e QuorumError) Error() string {
+	return fmt.Sprintf("no quorum: %d of %d clients agreed with %d required (%d errored)", e.Agreed, e.Queried, e.Required, e.Errored)
+}
+
+// Unwrap returns the errors returned by each client, allowing errors.Is() and errors.As()
+// to match the errors of individual clients.
+func (e QuorumError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// Retryable returns true if any of the clients returned a response, as clients may agree
+// once they have processed the same data, or if the error returned by any of the clients
+// is retryable.
+func (e QuorumError) Retryable() bool {
+	if e.Agreed > 0 {
+		return true
+	}
+	for _, err := range e.Errors {
+		if api.IsRetryable(err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type quorumResult struct {
+	client consensusclient.Service
+	res    interface{}
+	err    error
+}
+
+// doQuorumCall carries out a call on the active clients concurrently, returning the response
+// agreed by a majority of them, along with the first client to return it.  Responses are
+// compared by value.
+func (s *Service) doQuorumCall(ctx context.Context,
+	activeClients []consensusclient.Service,
+	call callFunc,
+	errHandler errHandlerFunc,
+) (
+	interface{},
+	consensusclient.Service,
+	error,
+) {
+	clients := activeClients
+	if s.quorumClients > 0 && s.quorumClients < len(clients) {
+		clients = clients[:s.quorumClients]
+	}
+	required := len(clients)/2 + 1
+
+	// Results are buffered so that calls can complete after the quorum is decided.
+	resultsCh := make(chan *quorumResult, len(clients))
+	for _, client := range clients {
+		go func(client consensusclient.Service) {
+			res, err := call(ctx, client)
+			if err != nil {
+				_, err = s.handleCallError(ctx, client, err, errHandler)
+			}
+			resultsCh <- &quorumResult{
+				client: client,
+				res:    res,
+				err:    err,
+			}
+		}(client)
+	}
+
+	// Group responses by value, returning as soon as a response reaches quorum
+	// or quorum can no longer be reached.
+	responses := make([]interface{}, 0, len(clients))
+	responders := make([]consensusclient.Service, 0, len(clients))
+	counts := make([]int, 0, len(clients))
+	agreed := 0
+	errored := 0
+	var errs map[string]error
+	for received := 1; received <= len(clients); received++ {
+		result := <-resultsCh
+		if result.err != nil {
+			errored++
+			if errs == nil {
+				errs = make(map[string]error)
+			}
+			errs[result.client.Address()] = result.err
+		} else {
+			matched := false
+			for i := range responses {
+				if reflect.DeepEqual(responses[i], result.res) {
+					counts[i]++
+					matched = true
+					if counts[i] >= required {
+						return responses[i], responders[i], nil
+					}
+					if counts[i] > agreed {
+						agreed = counts[i]
+					}
+
+					break
+				}
+			}
+			if !matched {
+				responses = append(responses, result.res)
+				responders = append(responders, result.client)
+				counts = append(counts, 1)
+				if required == 1 {
+					return result.res, result.client, nil
+				}
+				if agreed == 0 {
+					agreed = 1
+				}
+			}
+		}
+		if agreed+len(clients)-received < required {
+			break
+		}
+	}
+
+	return nil, nil, QuorumError{
+		Queried:  len(clients),
+		Required: required,
+		Agreed:   agreed,
+		Errored:  errored,
+		Errors:   errs,
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorum(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		headSlots     []phase0.Slot
+		quorumClients int
+		headSlot      phase0.Slot
+		err           *multi.QuorumError
+	}{
+		{
+			name:      "Unanimous",
+			headSlots: []phase0.Slot{10, 10, 10},
+			headSlot:  10,
+		},
+		{
+			name:      "Majority",
+			headSlots: []phase0.Slot{10, 11, 10},
+			headSlot:  10,
+		},
+		{
+			name:      "NoMajority",
+			headSlots: []phase0.Slot{10, 11, 12},
+			err: &multi.QuorumError{
+				Queried:  3,
+				Required: 2,
+				Agreed:   1,
+			},
+		},
+		{
+			name:      "Split",
+			headSlots: []phase0.Slot{10, 10, 11, 11},
+			err: &multi.QuorumError{
+				Queried:  4,
+				Required: 3,
+				Agreed:   2,
+			},
+		},
+		{
+			name:          "QuorumClients",
+			headSlots:     []phase0.Slot{10, 10, 11, 11, 11},
+			quorumClients: 3,
+			headSlot:      10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]client.Service, 0, len(test.headSlots))
+			for _, headSlot := range test.headSlots {
+				consensusClient, err := mock.New(ctx)
+				require.NoError(t, err)
+				consensusClient.HeadSlot = headSlot
+				clients = append(clients, consensusClient)
+			}
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithStrategy(multi.StrategyQuorum),
+				multi.WithQuorumClients(test.quorumClients),
+			)
+			require.NoError(t, err)
+
+			syncState, err := s.(client.NodeSyncingProvider).NodeSyncing(ctx)
+			if test.err != nil {
+				var quorumErr multi.QuorumError
+				require.True(t, errors.As(err, &quorumErr))
+				require.Equal(t, *test.err, quorumErr)
+				require.True(t, api.IsRetryable(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.headSlot, syncState.HeadSlot)
+			}
+		})
+	}
+}
+
+func TestQuorumErrors(t *testing.T) {
+	ctx := context.Background()
+
+	clients := make([]client.Service, 0, 3)
+	for _, name := range []string{"mock 1", "mock 2", "mock 3"} {
+		consensusClient, err := mock.New(ctx, mock.WithName(name))
+		require.NoError(t, err)
+		clients = append(clients, &notFoundClient{Service: consensusClient})
+	}
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+		multi.WithStrategy(multi.StrategyQuorum),
+	)
+	require.NoError(t, err)
+
+	_, err = s.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	var quorumErr multi.QuorumError
+	require.True(t, errors.As(err, &quorumErr))
+	require.Equal(t, 0, quorumErr.Agreed)
+	require.Positive(t, quorumErr.Errored)
+	require.ErrorIs(t, err, api.ErrNotFound)
+	require.False(t, api.IsRetryable(err))
+}
@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// RawCall calls the given endpoint on the node, returning the response regardless
+// of its status code.  The call is only retried against another client if it
+// could not be made; responses with error status codes are returned as-is.
+func (s *Service) RawCall(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	opts *api.RawCallOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	res, err := s.doCall(ctx, "RawCall", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		response, err := client.(consensusclient.RawCallProvider).RawCall(ctx, method, endpoint, body, opts)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.RawResponse), nil
+}
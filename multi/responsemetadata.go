@@ -0,0 +1,59 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// isolateResponseMetadata wraps a call so that, if the caller requested response metadata,
+// each client records the metadata of its response separately rather than writing to the
+// caller's metadata, as clients can be called concurrently and can outlive the call.
+// The returned function copies the metadata of the supplied client's response to that of
+// the caller, and should be called with the client whose response is returned.
+func isolateResponseMetadata(ctx context.Context, call callFunc) (callFunc, func(client consensusclient.Service)) {
+	metadata := api.ResponseMetadataFromContext(ctx)
+	if metadata == nil {
+		return call, func(consensusclient.Service) {}
+	}
+
+	var mu sync.Mutex
+	clientMetadata := make(map[consensusclient.Service]*api.ResponseMetadata)
+
+	isolatedCall := func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		res := &api.ResponseMetadata{}
+		mu.Lock()
+		clientMetadata[client] = res
+		mu.Unlock()
+
+		return call(api.WithResponseMetadata(ctx, res), client)
+	}
+
+	publish := func(client consensusclient.Service) {
+		if client == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if res, exists := clientMetadata[client]; exists {
+			*metadata = *res
+		}
+	}
+
+	return isolatedCall, publish
+}
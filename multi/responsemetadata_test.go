@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// metadataClient is a mock client that records response metadata in the same way
+// as the http client, after a given delay.
+type metadataClient struct {
+	*mock.Service
+	delay      time.Duration
+	statusCode int
+}
+
+func (c *metadataClient) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
+	time.Sleep(c.delay)
+	if metadata := api.ResponseMetadataFromContext(ctx); metadata != nil {
+		*metadata = api.ResponseMetadata{StatusCode: c.statusCode}
+	}
+
+	return c.Service.NodeSyncing(ctx)
+}
+
+func TestResponseMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	mock1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	mock2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	for _, strategy := range []multi.Strategy{multi.StrategyFailover, multi.StrategyFastest, multi.StrategyQuorum, multi.StrategyBroadcast} {
+		s, err := multi.New(ctx,
+			multi.WithLogLevel(zerolog.Disabled),
+			multi.WithClients([]client.Service{
+				&metadataClient{Service: mock1, delay: time.Millisecond, statusCode: 201},
+				&metadataClient{Service: mock2, delay: 50 * time.Millisecond, statusCode: 202},
+			}),
+			multi.WithStrategy(strategy),
+		)
+		require.NoError(t, err)
+
+		metadata := &api.ResponseMetadata{}
+		_, err = s.(client.NodeSyncingProvider).NodeSyncing(api.WithResponseMetadata(ctx, metadata))
+		require.NoError(t, err)
+		// Allow any outstanding calls to complete.
+		time.Sleep(100 * time.Millisecond)
+		require.Equal(t, 201, metadata.StatusCode)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// routedClients returns the clients with the given addresses.
+func routedClients(clients []consensusclient.Service, addresses []string) []consensusclient.Service {
+	res := make([]consensusclient.Service, 0, len(addresses))
+	for _, client := range clients {
+		clientAddress := normaliseRouteAddress(client.Address())
+		for _, address := range addresses {
+			if clientAddress == normaliseRouteAddress(address) {
+				res = append(res, client)
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// normaliseRouteAddress normalises an address for comparison.
+func normaliseRouteAddress(address string) string {
+	return strings.TrimSuffix(address, "/")
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes(t *testing.T) {
+	ctx := context.Background()
+
+	clients := make([]client.Service, 0, 3)
+	for i := 1; i <= 3; i++ {
+		consensusClient, err := mock.New(ctx, mock.WithName(fmt.Sprintf("mock %d", i)))
+		require.NoError(t, err)
+		consensusClient.HeadSlot = phase0.Slot(i)
+		clients = append(clients, consensusClient)
+	}
+
+	tests := []struct {
+		name     string
+		params   []multi.Parameter
+		headSlot phase0.Slot
+		err      string
+	}{
+		{
+			name:     "Unrouted",
+			headSlot: 1,
+		},
+		{
+			name: "Routed",
+			params: []multi.Parameter{
+				multi.WithRoute("NodeSyncing", "mock 3/"),
+			},
+			headSlot: 3,
+		},
+		{
+			name: "OtherCallRouted",
+			params: []multi.Parameter{
+				multi.WithRoute("BeaconState", "mock 3"),
+			},
+			headSlot: 1,
+		},
+		{
+			name: "RoutedMultiple",
+			params: []multi.Parameter{
+				multi.WithRoute("NodeSyncing", "mock 4", "mock 2"),
+			},
+			headSlot: 2,
+		},
+		{
+			name: "RoutedUnknown",
+			params: []multi.Parameter{
+				multi.WithRoute("NodeSyncing", "mock 4"),
+			},
+			err: "no active routed clients to which to make NodeSyncing call",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := append([]multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+			}, test.params...)
+			s, err := multi.New(ctx, params...)
+			require.NoError(t, err)
+
+			syncState, err := s.(client.NodeSyncingProvider).NodeSyncing(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.headSlot, syncState.HeadSlot)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// scoreWindow is the number of recent calls used to score a client.
+const scoreWindow = 128
+
+// ClientScore is the score of a client, based on its recent calls.
+type ClientScore struct {
+	// Address is the address of the client.
+	Address string
+	// Calls is the number of recent calls from which the score is calculated.
+	Calls int
+	// ErrorRate is the proportion of recent calls that failed.
+	ErrorRate float64
+	// LatencyP50 is the median latency of recent calls.
+	LatencyP50 time.Duration
+	// LatencyP95 is the 95th percentile latency of recent calls.
+	LatencyP95 time.Duration
+	// Score is the overall score of the client, between 0 and 1, with higher being better.
+	// A client without recent calls has a score of 1.
+	Score float64
+}
+
+// callSample is the outcome of a single call.
+type callSample struct {
+	latency time.Duration
+	failed  bool
+}
+
+// clientSamples are the recent call samples for a client, held in a ring buffer,
+// along with its overall call statistics.
+type clientSamples struct {
+	samples []callSample
+	next    int
+	// latencies are the latencies of the recent call samples in increasing order, and
+	// sampleFailures the number of them that failed, maintained as samples are recorded.
+	latencies      []time.Duration
+	sampleFailures int
+
+	calls         uint64
+	failures      uint64
+	lastError     error
+	lastErrorTime time.Time
+	lastSuccess   time.Time
+}
+
+// scores tracks the recent calls of clients.
+type scores struct {
+	mu      sync.Mutex
+	clients map[string]*clientSamples
+}
+
+func newScores() *scores {
+	return &scores{
+		clients: make(map[string]*clientSamples),
+	}
+}
+
+// record records the outcome of a call to the client with the given address.
+func (s *scores) record(address string, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples, exists := s.clients[address]
+	if !exists {
+		samples = &clientSamples{
+			samples:   make([]callSample, 0, scoreWindow),
+			latencies: make([]time.Duration, 0, scoreWindow),
+		}
+		s.clients[address] = samples
+	}
+	sample := callSample{
+		latency: latency,
+		failed:  err != nil,
+	}
+	samples.calls++
+	if err != nil {
+		samples.failures++
+		samples.lastError = err
+		samples.lastErrorTime = time.Now()
+	} else {
+		samples.lastSuccess = time.Now()
+	}
+	if len(samples.samples) < scoreWindow {
+		samples.samples = append(samples.samples, sample)
+	} else {
+		samples.remove(samples.samples[samples.next])
+		samples.samples[samples.next] = sample
+	}
+	samples.add(sample)
+	samples.next = (samples.next + 1) % scoreWindow
+}
+
+// add adds a sample to the running statistics.
+func (c *clientSamples) add(sample callSample) {
+	i := sort.Search(len(c.latencies), func(i int) bool { return c.latencies[i] >= sample.latency })
+	c.latencies = append(c.latencies, 0)
+	copy(c.latencies[i+1:], c.latencies[i:])
+	c.latencies[i] = sample.latency
+	if sample.failed {
+		c.sampleFailures++
+	}
+}
+
+// remove removes a sample from the running statistics.
+func (c *clientSamples) remove(sample callSample) {
+	i := sort.Search(len(c.latencies), func(i int) bool { return c.latencies[i] >= sample.latency })
+	c.latencies = append(c.latencies[:i], c.latencies[i+1:]...)
+	if sample.failed {
+		c.sampleFailures--
+	}
+}
+
+// score calculates the score for the client with the given address.
+func (s *scores) score(address string) *ClientScore {
+	score := &ClientScore{
+		Address: address,
+		Score:   1,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples, exists := s.clients[address]
+	if !exists || len(samples.latencies) == 0 {
+		return score
+	}
+
+	calls := len(samples.latencies)
+	score.Calls = calls
+	score.ErrorRate = float64(samples.sampleFailures) / float64(calls)
+	score.LatencyP50 = samples.latencies[(calls-1)*50/100]
+	score.LatencyP95 = samples.latencies[(calls-1)*95/100]
+	// Errors dominate the score; latency differentiates between reliable clients.
+	score.Score = (1 - score.ErrorRate) / (1 + score.LatencyP95.Seconds())
+
+	return score
+}
+
+// Scores returns the scores of the active and inactive clients.
+func (s *Service) Scores() []*ClientScore {
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	clients = append(clients, s.activeClients...)
+	clients = append(clients, s.inactiveClients...)
+	s.clientsMu.RUnlock()
+
+	res := make([]*ClientScore, 0, len(clients))
+	for _, client := range clients {
+		res = append(res, s.scores.score(client.Address()))
+	}
+
+	return res
+}
+
+// scoredCall wraps a call function to record the outcome of each call.
+// Calls that fail because their context was cancelled are not recorded, and calls for
+// data that is not found are recorded as successful, as neither reflects on the client.
+func (s *Service) scoredCall(call callFunc) callFunc {
+	return func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		started := time.Now()
+		res, err := call(ctx, client)
+		if err != nil && (ctx.Err() != nil || errors.Is(err, context.Canceled)) {
+			return res, err
+		}
+		latency := time.Since(started)
+		callErr := err
+		if errors.Is(err, api.ErrNotFound) {
+			callErr = nil
+		}
+		s.scores.record(client.Address(), latency, callErr)
+		setProviderScoreMetric(ctx, client.Address(), s.scores.score(client.Address()).Score)
+
+		return res, err
+	}
+}
+
+// orderByScore returns the clients ordered by their scores, best first.
+// Scores are compared to two decimal places, so that insignificant differences in
+// latency do not reorder clients; clients with equal scores retain their relative order.
+func (s *Service) orderByScore(clients []consensusclient.Service) []consensusclient.Service {
+	clientScores := make(map[consensusclient.Service]float64, len(clients))
+	for _, client := range clients {
+		clientScores[client] = math.Round(s.scores.score(client.Address()).Score * 100)
+	}
+
+	ordered := make([]consensusclient.Service, len(clients))
+	copy(ordered, clients)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return clientScores[ordered[i]] > clientScores[ordered[j]]
+	})
+
+	return ordered
+}
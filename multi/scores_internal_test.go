@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScore(t *testing.T) {
+	s := newScores()
+
+	// Unknown clients have a perfect score.
+	require.Equal(t, &ClientScore{Address: "unknown", Score: 1}, s.score("unknown"))
+
+	for i := 1; i <= 100; i++ {
+		var err error
+		if i%10 == 0 {
+			err = errors.New("failed")
+		}
+		s.record("client", time.Duration(i)*time.Millisecond, err)
+	}
+	score := s.score("client")
+	require.Equal(t, 100, score.Calls)
+	require.InDelta(t, 0.1, score.ErrorRate, 0.0001)
+	require.Equal(t, 50*time.Millisecond, score.LatencyP50)
+	require.Equal(t, 95*time.Millisecond, score.LatencyP95)
+	require.InDelta(t, 0.9/1.095, score.Score, 0.0001)
+
+	// Only the most recent calls are scored.
+	for i := 0; i < scoreWindow; i++ {
+		s.record("client", time.Second, nil)
+	}
+	score = s.score("client")
+	require.Equal(t, scoreWindow, score.Calls)
+	require.Zero(t, score.ErrorRate)
+	require.Equal(t, time.Second, score.LatencyP50)
+
+	// Percentiles track the window as samples are replaced.
+	for i := 0; i < scoreWindow/2; i++ {
+		s.record("client", time.Duration(i)*time.Millisecond, errors.New("failed"))
+	}
+	score = s.score("client")
+	require.Equal(t, scoreWindow, score.Calls)
+	require.InDelta(t, 0.5, score.ErrorRate, 0.0001)
+	require.Equal(t, 63*time.Millisecond, score.LatencyP50)
+	require.Equal(t, time.Second, score.LatencyP95)
+}
+
+func TestScoredCall(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx, mock.WithName("mock"))
+	require.NoError(t, err)
+	s := &Service{
+		scores: newScores(),
+	}
+
+	// Data not found does not count as a failure.
+	_, err = s.scoredCall(func(context.Context, consensusclient.Service) (interface{}, error) {
+		return nil, api.ErrNotFound
+	})(ctx, client)
+	require.ErrorIs(t, err, api.ErrNotFound)
+	score := s.scores.score(client.Address())
+	require.Equal(t, 1, score.Calls)
+	require.Zero(t, score.ErrorRate)
+
+	// Cancelled calls are not recorded.
+	_, err = s.scoredCall(func(context.Context, consensusclient.Service) (interface{}, error) {
+		return nil, context.Canceled
+	})(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, s.scores.score(client.Address()).Calls)
+
+	_, err = s.scoredCall(func(context.Context, consensusclient.Service) (interface{}, error) {
+		return nil, errors.New("failed")
+	})(ctx, client)
+	require.Error(t, err)
+	score = s.scores.score(client.Address())
+	require.Equal(t, 2, score.Calls)
+	require.InDelta(t, 0.5, score.ErrorRate, 0.0001)
+}
+
+func TestOrderByScore(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+	client3, err := mock.New(ctx, mock.WithName("mock 3"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+			client3,
+		}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	// Without scores the order is retained.
+	clients := []consensusclient.Service{client1, client2, client3}
+	require.Equal(t, clients, multi.orderByScore(clients))
+
+	multi.scores.record(client1.Address(), 10*time.Millisecond, errors.New("failed"))
+	multi.scores.record(client2.Address(), 500*time.Millisecond, nil)
+	multi.scores.record(client3.Address(), 10*time.Millisecond, nil)
+	require.Equal(t, []consensusclient.Service{client3, client2, client1}, multi.orderByScore(clients))
+	require.Equal(t, client3.Address(), multi.Address())
+
+	scores := multi.Scores()
+	require.Len(t, scores, 3)
+	require.Zero(t, scores[0].Score)
+}
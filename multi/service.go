@@ -16,9 +16,11 @@ package multi
 import (
 	"context"
 	"sync"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -31,6 +33,27 @@ type Service struct {
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
 	inactiveClients []consensusclient.Service
+
+	strategy            Strategy
+	submissionStrategy  Strategy
+	quorumClients       int
+	healthCheckInterval time.Duration
+	maxSyncDistance     phase0.Slot
+	maxHeadLag          phase0.Slot
+	routes              map[string][]string
+	epochSticky         bool
+	aggregatedEvents    bool
+	failoverPolicy      FailoverPolicyFunc
+	verifyConsistency   bool
+	divergenceHandler   DivergenceHandlerFunc
+	nilOnNotFound       bool
+	timeout             time.Duration
+
+	// scores track the recent calls of each client.
+	scores *scores
+
+	// sticky is the client selected for sticky calls in the current epoch.
+	sticky sticky
 }
 
 // New creates a new Ethereum 2 client with multiple endpoints.
@@ -60,7 +83,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	activeClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	inactiveClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	for _, client := range parameters.clients {
-		if ping(ctx, client) {
+		if ping(ctx, client, parameters.maxSyncDistance) {
 			activeClients = append(activeClients, client)
 		} else {
 			inactiveClients = append(inactiveClients, client)
@@ -77,7 +100,7 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 			log.Error().Str("provider", address).Msg("Provider not present; dropping from rotation")
 			continue
 		}
-		if ping(ctx, client) {
+		if ping(ctx, client, parameters.maxSyncDistance) {
 			activeClients = append(activeClients, client)
 			setProviderActiveMetric(ctx, client.Address(), "active")
 		} else {
@@ -93,9 +116,24 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	setProvidersMetric(ctx, "inactive", len(inactiveClients))
 
 	s := &Service{
-		log:             log,
-		activeClients:   activeClients,
-		inactiveClients: inactiveClients,
+		log:                 log,
+		activeClients:       activeClients,
+		inactiveClients:     inactiveClients,
+		strategy:            parameters.strategy,
+		submissionStrategy:  parameters.submissionStrategy,
+		quorumClients:       parameters.quorumClients,
+		healthCheckInterval: parameters.healthCheckInterval,
+		maxSyncDistance:     parameters.maxSyncDistance,
+		maxHeadLag:          parameters.maxHeadLag,
+		routes:              parameters.routes,
+		epochSticky:         parameters.epochSticky,
+		aggregatedEvents:    parameters.aggregatedEvents,
+		failoverPolicy:      parameters.failoverPolicy,
+		verifyConsistency:   parameters.verifyConsistency,
+		divergenceHandler:   parameters.divergenceHandler,
+		nilOnNotFound:       parameters.nilOnNotFound,
+		timeout:             parameters.timeout,
+		scores:              newScores(),
 	}
 
 	// Kick off monitor.
@@ -110,11 +148,13 @@ func (s *Service) Name() string {
 }
 
 // Address returns the address of the client.
+// This is the address of the best scoring active client.
 func (s *Service) Address() string {
 	s.clientsMu.RLock()
-	defer s.clientsMu.RUnlock()
-	if len(s.activeClients) > 0 {
-		return s.activeClients[0].Address()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+	if len(activeClients) > 0 {
+		return s.orderByScore(activeClients)[0].Address()
 	}
 	return "none"
 }
@@ -49,6 +49,62 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no Ethereum 2 clients specified",
 		},
+		{
+			name: "HealthCheckIntervalZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+				multi.WithHealthCheckInterval(0),
+			},
+			err: "problem with parameters: no health check interval specified",
+		},
+		{
+			name: "RouteAddressesMissing",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+				multi.WithRoute("BeaconState"),
+			},
+			err: "problem with parameters: no addresses specified for BeaconState route",
+		},
+		{
+			name: "StrategyInvalid",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+				multi.WithStrategy(multi.Strategy(-1)),
+			},
+			err: "problem with parameters: invalid strategy",
+		},
+		{
+			name: "SubmissionStrategyInvalid",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+				multi.WithSubmissionStrategy(multi.Strategy(99)),
+			},
+			err: "problem with parameters: invalid submission strategy",
+		},
+		{
+			name: "QuorumClientsNegative",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients([]client.Service{
+					consensusclient1,
+				}),
+				multi.WithStrategy(multi.StrategyQuorum),
+				multi.WithQuorumClients(-1),
+			},
+			err: "problem with parameters: quorum clients cannot be negative",
+		},
 		{
 			name: "AllClientsInactive",
 			params: []multi.Parameter{
@@ -105,25 +161,51 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttesterSlashingSubmitter)(nil), s)
+	assert.Implements(t, (*client.BLSToExecutionChangePoolProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateRandaoAtEpochProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlobSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.DataColumnSidecarsProvider)(nil), s)
+	assert.Implements(t, (*client.BlockAttestationsProvider)(nil), s)
+	assert.Implements(t, (*client.BuilderBidProvider)(nil), s)
+	assert.Implements(t, (*client.BuilderBlindedBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BuilderStatusProvider)(nil), s)
+	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.DepositSnapshotProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.FilteredEventsProvider)(nil), s)
+	assert.Implements(t, (*client.TypedEventsProvider)(nil), s)
+	assert.Implements(t, (*client.ExpectedWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingConsolidationsProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientFinalityUpdateProvider)(nil), s)
+	assert.Implements(t, (*client.LightClientOptimisticUpdateProvider)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
+	assert.Implements(t, (*client.ForkChoiceProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.ParsedNodeVersionProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingPoolProvider)(nil), s)
+	assert.Implements(t, (*client.ProposerSlashingSubmitter)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ProposalProvider)(nil), s)
+	assert.Implements(t, (*client.SignedBlindedBeaconBlockProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -132,11 +214,18 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorLivenessProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAggregateAttestationProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.VersionedAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitPoolProvider)(nil), s)
 
 	// Non-standard extensions.
+	assert.Implements(t, (*client.CapabilitiesProvider)(nil), s)
 	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.ForkDigestProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*client.RawCallProvider)(nil), s)
 }
@@ -21,14 +21,14 @@ import (
 )
 
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
-// N.B if a signed beacon block for the block ID is not available this will return nil without an error.
+// N.B if a signed beacon block for the block ID is not available this will return an error that matches api.ErrNotFound.
 func (s *Service) SignedBeaconBlock(ctx context.Context,
 	blockID string,
 ) (
 	*spec.VersionedSignedBeaconBlock,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SignedBeaconBlock", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.SignedBeaconBlockProvider).SignedBeaconBlock(ctx, blockID)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+// N.B if a signed blinded beacon block for the block ID is not available this will return an error that matches api.ErrNotFound.
+func (s *Service) SignedBlindedBeaconBlock(ctx context.Context,
+	blockID string,
+) (
+	*api.VersionedSignedBlindedBeaconBlock,
+	error,
+) {
+	res, err := s.doCall(ctx, "SignedBlindedBeaconBlock", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		block, err := client.(consensusclient.SignedBlindedBeaconBlockProvider).SignedBlindedBeaconBlock(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		return block, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.VersionedSignedBlindedBeaconBlock), nil
+}
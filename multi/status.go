@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// ClientStatus is the status of a client.
+type ClientStatus struct {
+	// Name is the name of the client.
+	Name string
+	// Address is the address of the client.
+	Address string
+	// Active is true if the client is currently active.
+	Active bool
+	// Calls is the number of calls made to the client.
+	Calls uint64
+	// Failures is the number of calls made to the client that failed.
+	Failures uint64
+	// LastError is the error returned by the most recent failed call, if any.
+	LastError error
+	// LastErrorTime is the time of the most recent failed call, if any.
+	LastErrorTime time.Time
+	// LastSuccessTime is the time of the most recent successful call, if any.
+	LastSuccessTime time.Time
+	// Score is the score of the client, based on its recent calls.
+	Score *ClientScore
+}
+
+// ClientStatuses returns the status of each client, active clients first.
+func (s *Service) ClientStatuses() []*ClientStatus {
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	inactiveClients := s.inactiveClients
+	s.clientsMu.RUnlock()
+
+	res := make([]*ClientStatus, 0, len(activeClients)+len(inactiveClients))
+	for _, client := range activeClients {
+		res = append(res, s.clientStatus(client, true))
+	}
+	for _, client := range inactiveClients {
+		res = append(res, s.clientStatus(client, false))
+	}
+
+	return res
+}
+
+func (s *Service) clientStatus(client consensusclient.Service, active bool) *ClientStatus {
+	status := &ClientStatus{
+		Name:    client.Name(),
+		Address: client.Address(),
+		Active:  active,
+		Score:   s.scores.score(client.Address()),
+	}
+
+	s.scores.mu.Lock()
+	if samples, exists := s.scores.clients[client.Address()]; exists {
+		status.Calls = samples.calls
+		status.Failures = samples.failures
+		status.LastError = samples.lastError
+		status.LastErrorTime = samples.lastErrorTime
+		status.LastSuccessTime = samples.lastSuccess
+	}
+	s.scores.mu.Unlock()
+
+	return status
+}
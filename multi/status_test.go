@@ -0,0 +1,58 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientStatuses(t *testing.T) {
+	ctx := context.Background()
+
+	_, clients := newSubmissionClients(t, true, false)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+	)
+	require.NoError(t, err)
+
+	// The first client fails the submission and is deactivated; the second succeeds.
+	require.NoError(t, s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}))
+
+	statuses := s.(*multi.Service).ClientStatuses()
+	require.Len(t, statuses, 2)
+
+	require.Equal(t, "mock 2", statuses[0].Address)
+	require.True(t, statuses[0].Active)
+	require.Equal(t, uint64(1), statuses[0].Calls)
+	require.Zero(t, statuses[0].Failures)
+	require.NoError(t, statuses[0].LastError)
+	require.False(t, statuses[0].LastSuccessTime.IsZero())
+
+	require.Equal(t, "mock 1", statuses[1].Address)
+	require.False(t, statuses[1].Active)
+	require.Equal(t, uint64(1), statuses[1].Calls)
+	require.Equal(t, uint64(1), statuses[1].Failures)
+	require.EqualError(t, statuses[1].LastError, "submission rejected")
+	require.True(t, statuses[1].LastSuccessTime.IsZero())
+	require.Zero(t, statuses[1].Score.Score)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// stickyCalls are the calls that go to the same client throughout an epoch when
+// epoch-sticky selection is enabled.
+var stickyCalls = map[string]bool{
+	"AggregateAttestation":          true,
+	"AttestationData":               true,
+	"AttesterDuties":                true,
+	"ProposerDuties":                true,
+	"SyncCommitteeDuties":           true,
+	"VersionedAggregateAttestation": true,
+}
+
+// sticky holds the client selected for sticky calls in an epoch.
+type sticky struct {
+	mu     sync.Mutex
+	epoch  phase0.Epoch
+	client consensusclient.Service
+
+	// Chain parameters used to calculate the current epoch; obtained on first use.
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+}
+
+// currentEpoch returns the current epoch.
+func (s *Service) currentEpoch(ctx context.Context) (phase0.Epoch, error) {
+	s.sticky.mu.Lock()
+	initialised := s.sticky.slotsPerEpoch != 0
+	genesisTime := s.sticky.genesisTime
+	slotDuration := s.sticky.slotDuration
+	slotsPerEpoch := s.sticky.slotsPerEpoch
+	s.sticky.mu.Unlock()
+
+	if !initialised {
+		var err error
+		genesisTime, err = s.GenesisTime(ctx)
+		if err != nil {
+			return 0, err
+		}
+		slotDuration, err = s.SlotDuration(ctx)
+		if err != nil {
+			return 0, err
+		}
+		slotsPerEpoch, err = s.SlotsPerEpoch(ctx)
+		if err != nil {
+			return 0, err
+		}
+		s.sticky.mu.Lock()
+		s.sticky.genesisTime = genesisTime
+		s.sticky.slotDuration = slotDuration
+		s.sticky.slotsPerEpoch = slotsPerEpoch
+		s.sticky.mu.Unlock()
+	}
+
+	if slotDuration == 0 || slotsPerEpoch == 0 || time.Now().Before(genesisTime) {
+		return 0, nil
+	}
+
+	return phase0.Epoch(uint64(time.Since(genesisTime)/slotDuration) / slotsPerEpoch), nil
+}
+
+// stickyClients returns the active clients with the client selected for the current
+// epoch, if any, first.  It also returns the current epoch.
+func (s *Service) stickyClients(ctx context.Context,
+	activeClients []consensusclient.Service,
+) (
+	[]consensusclient.Service,
+	phase0.Epoch,
+	bool,
+) {
+	epoch, err := s.currentEpoch(ctx)
+	if err != nil {
+		s.log.Debug().Err(err).Msg("Failed to obtain current epoch; not using sticky client")
+		return activeClients, 0, false
+	}
+
+	s.sticky.mu.Lock()
+	stickyClient := s.sticky.client
+	stickyEpoch := s.sticky.epoch
+	s.sticky.mu.Unlock()
+	if stickyClient == nil || stickyEpoch != epoch {
+		return activeClients, epoch, true
+	}
+
+	res := make([]consensusclient.Service, 0, len(activeClients))
+	for _, client := range activeClients {
+		if client == stickyClient {
+			res = append(res, client)
+			break
+		}
+	}
+	for _, client := range activeClients {
+		if client != stickyClient {
+			res = append(res, client)
+		}
+	}
+
+	return res, epoch, true
+}
+
+// setStickyClient sets the client used for sticky calls in the epoch.
+func (s *Service) setStickyClient(epoch phase0.Epoch, client consensusclient.Service) {
+	s.sticky.mu.Lock()
+	defer s.sticky.mu.Unlock()
+
+	if s.sticky.client == client && s.sticky.epoch == epoch {
+		return
+	}
+	s.sticky.client = client
+	s.sticky.epoch = epoch
+	s.log.Trace().Uint64("epoch", uint64(epoch)).Str("address", client.Address()).Msg("Selected sticky client for epoch")
+}
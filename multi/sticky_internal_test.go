@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient is a mock client that counts attestation data calls.
+type countingClient struct {
+	*mock.Service
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *countingClient) AttestationData(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) (*phase0.AttestationData, error) {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+
+	return c.Service.AttestationData(ctx, slot, committeeIndex)
+}
+
+func TestEpochSticky(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		epochSticky  bool
+		client1Calls int
+		client2Calls int
+	}{
+		{
+			name:         "Disabled",
+			client1Calls: 1,
+			client2Calls: 1,
+		},
+		{
+			name:         "Enabled",
+			epochSticky:  true,
+			client1Calls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock1, err := mock.New(ctx, mock.WithName("mock 1"), mock.WithGenesisTime(time.Now().Add(-time.Hour)))
+			require.NoError(t, err)
+			client1 := &countingClient{Service: mock1}
+			mock2, err := mock.New(ctx, mock.WithName("mock 2"), mock.WithGenesisTime(time.Now().Add(-time.Hour)))
+			require.NoError(t, err)
+			client2 := &countingClient{Service: mock2}
+
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients([]consensusclient.Service{
+					client1,
+					client2,
+				}),
+				WithEpochSticky(test.epochSticky),
+			)
+			require.NoError(t, err)
+			multi := s.(*Service)
+
+			_, err = multi.AttestationData(ctx, 1, 2)
+			require.NoError(t, err)
+
+			// Make the first client score badly, so that it would not usually be selected.
+			for i := 0; i < 10; i++ {
+				multi.scores.record(client1.Address(), time.Second, errors.New("failed"))
+			}
+			_, err = multi.AttestationData(ctx, 1, 2)
+			require.NoError(t, err)
+
+			require.Equal(t, test.client1Calls, client1.calls)
+			require.Equal(t, test.client2Calls, client2.calls)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// Strategy defines how the clients that serve a call are selected.
+type Strategy int
+
+const (
+	// StrategyFailover calls active clients in turn until one succeeds.
+	StrategyFailover Strategy = iota
+	// StrategyQuorum calls active clients concurrently and returns the response
+	// agreed by a majority of them.
+	StrategyQuorum
+	// StrategyFastest calls active clients concurrently and returns the first
+	// successful response, cancelling the outstanding calls.
+	StrategyFastest
+	// StrategyBroadcast calls active clients concurrently, succeeding if any of them
+	// succeed.  It is intended for submissions.
+	StrategyBroadcast
+)
+
+var strategyStrings = [...]string{
+	"failover",
+	"quorum",
+	"fastest",
+	"broadcast",
+}
+
+// String returns a string representation of the strategy.
+func (s Strategy) String() string {
+	if int(s) < 0 || int(s) >= len(strategyStrings) {
+		return "unknown"
+	}
+
+	return strategyStrings[s]
+}
+
+// WithCallStrategy returns a copy of the context with call options attached that
+// override the selection strategy for calls made with it, regardless of the service-wide
+// strategies.
+// Any existing call options attached to the context are retained.
+func WithCallStrategy(ctx context.Context, strategy Strategy) context.Context {
+	opts := &api.CallOpts{}
+	if existing := api.CallOptsFromContext(ctx); existing != nil {
+		*opts = *existing
+	}
+	opts.Strategy = strategy.String()
+
+	return api.WithCallOpts(ctx, opts)
+}
+
+// callStrategyFromContext returns the strategy set in the call options attached to the
+// context, if any.
+func callStrategyFromContext(ctx context.Context) (Strategy, bool, error) {
+	opts := api.CallOptsFromContext(ctx)
+	if opts == nil || opts.Strategy == "" {
+		return StrategyFailover, false, nil
+	}
+
+	for i := range strategyStrings {
+		if strategyStrings[i] == opts.Strategy {
+			return Strategy(i), true, nil
+		}
+	}
+
+	return StrategyFailover, false, fmt.Errorf("unknown call strategy %s", opts.Strategy)
+}
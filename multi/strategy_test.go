@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallStrategy(t *testing.T) {
+	tests := []struct {
+		name               string
+		submissionStrategy multi.Strategy
+		callStrategy       *multi.Strategy
+		submissions        int
+	}{
+		{
+			name:               "Default",
+			submissionStrategy: multi.StrategyFailover,
+			submissions:        1,
+		},
+		{
+			name:               "OverrideBroadcast",
+			submissionStrategy: multi.StrategyFailover,
+			callStrategy:       strategyPtr(multi.StrategyBroadcast),
+			submissions:        3,
+		},
+		{
+			name:               "OverrideFailover",
+			submissionStrategy: multi.StrategyBroadcast,
+			callStrategy:       strategyPtr(multi.StrategyFailover),
+			submissions:        1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			submissionClients, clients := newSubmissionClients(t, false, false, false)
+
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithSubmissionStrategy(test.submissionStrategy),
+			)
+			require.NoError(t, err)
+
+			if test.callStrategy != nil {
+				ctx = multi.WithCallStrategy(ctx, *test.callStrategy)
+			}
+			require.NoError(t, s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}))
+
+			submissions := 0
+			for _, submissionClient := range submissionClients {
+				submissions += submissionClient.submissions
+			}
+			require.Equal(t, test.submissions, submissions)
+		})
+	}
+}
+
+func strategyPtr(strategy multi.Strategy) *multi.Strategy {
+	return &strategy
+}
+
+func TestCallStrategyCallOpts(t *testing.T) {
+	ctx := context.Background()
+	submissionClients, clients := newSubmissionClients(t, false, false, false)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+	)
+	require.NoError(t, err)
+
+	// The strategy is retained alongside other call options.
+	ctx = api.WithCallTimeout(multi.WithCallStrategy(ctx, multi.StrategyBroadcast), time.Minute)
+	require.Equal(t, "broadcast", api.CallOptsFromContext(ctx).Strategy)
+	require.NoError(t, s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}))
+	submissions := 0
+	for _, submissionClient := range submissionClients {
+		submissions += submissionClient.submissions
+	}
+	require.Equal(t, 3, submissions)
+
+	ctx = api.WithCallOpts(context.Background(), &api.CallOpts{Strategy: "unknown"})
+	require.EqualError(t, s.(client.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{}), "unknown call strategy unknown")
+}
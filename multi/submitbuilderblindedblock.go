@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder,
+// returning the execution payload (and blobs bundle post-Deneb) that unblinds it.
+func (s *Service) SubmitBuilderBlindedBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	res, err := s.doCall(ctx, "SubmitBuilderBlindedBlock", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		response, err := client.(consensusclient.BuilderBlindedBlockSubmitter).SubmitBuilderBlindedBlock(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		return response, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*api.VersionedSubmitBlindedBlockResponse), nil
+}
@@ -30,7 +30,7 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context,
 	[]*api.SyncCommitteeDuty,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "SyncCommitteeDuties", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, epoch, validatorIndices)
 		if err != nil {
 			return nil, err
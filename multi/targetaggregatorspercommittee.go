@@ -22,7 +22,7 @@ import (
 
 // TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
 func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "TargetAggregatorsPerCommittee", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		aggregators, err := client.(consensusclient.TargetAggregatorsPerCommitteeProvider).TargetAggregatorsPerCommittee(ctx)
 		if err != nil {
 			return nil, err
@@ -31,7 +31,7 @@ func (s *Service) Validators(ctx context.Context,
 	map[phase0.ValidatorIndex]*api.Validator,
 	error,
 ) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doCall(ctx, "Validators", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		block, err := client.(consensusclient.ValidatorsProvider).Validators(ctx, stateID, validatorIndices)
 		if err != nil {
 			return nil, err
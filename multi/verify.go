@@ -0,0 +1,64 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"reflect"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+)
+
+// verifiedCalls are the calls whose responses are cross-checked against a second client
+// when consistency verification is enabled.
+var verifiedCalls = map[string]bool{
+	"AttesterDuties":  true,
+	"BeaconBlockRoot": true,
+	"Finality":        true,
+	"ProposerDuties":  true,
+}
+
+// DivergenceHandlerFunc is called when the response from a client diverges from
+// that of a second client for the same call.
+type DivergenceHandlerFunc func(ctx context.Context, call string, primary consensusclient.Service, secondary consensusclient.Service)
+
+// verifyCall cross-checks the response from the primary client against that of the
+// secondary client in the background, reporting any divergence.
+func (s *Service) verifyCall(name string,
+	call callFunc,
+	primary consensusclient.Service,
+	secondary consensusclient.Service,
+	primaryRes interface{},
+) {
+	go func() {
+		// The verification outlives the original call, so cannot use its context.
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		defer cancel()
+
+		secondaryRes, err := call(ctx, secondary)
+		if err != nil {
+			s.log.Debug().Str("call", name).Str("address", secondary.Address()).Err(err).Msg("Failed to obtain response for verification")
+			return
+		}
+		if reflect.DeepEqual(primaryRes, secondaryRes) {
+			return
+		}
+
+		s.log.Warn().Str("call", name).Str("primary", primary.Address()).Str("secondary", secondary.Address()).Msg("Responses from clients diverged")
+		incDivergenceMetric(ctx, name)
+		if s.divergenceHandler != nil {
+			s.divergenceHandler(ctx, name, primary, secondary)
+		}
+	}()
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// rootClient is a mock client that returns a fixed block root.
+type rootClient struct {
+	*mock.Service
+	root phase0.Root
+}
+
+func (c *rootClient) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+	root := c.root
+	return &root, nil
+}
+
+func TestConsistencyVerification(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		roots    []phase0.Root
+		diverged bool
+	}{
+		{
+			name:  "Consistent",
+			roots: []phase0.Root{{0x01}, {0x01}},
+		},
+		{
+			name:     "Divergent",
+			roots:    []phase0.Root{{0x01}, {0x02}},
+			diverged: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := make([]client.Service, 0, len(test.roots))
+			for i, root := range test.roots {
+				consensusClient, err := mock.New(ctx, mock.WithName(fmt.Sprintf("mock %d", i+1)))
+				require.NoError(t, err)
+				clients = append(clients, &rootClient{Service: consensusClient, root: root})
+			}
+
+			divergences := make(chan string, 1)
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithClients(clients),
+				multi.WithConsistencyVerification(true, func(_ context.Context, call string, _ client.Service, _ client.Service) {
+					divergences <- call
+				}),
+			)
+			require.NoError(t, err)
+
+			_, err = s.(client.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+			require.NoError(t, err)
+
+			select {
+			case call := <-divergences:
+				require.True(t, test.diverged)
+				require.Equal(t, "BeaconBlockRoot", call)
+			case <-time.After(100 * time.Millisecond):
+				require.False(t, test.diverged)
+			}
+		})
+	}
+}
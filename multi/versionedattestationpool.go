@@ -0,0 +1,64 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedAttestationPool obtains the attestation pool for a given slot.
+func (s *Service) VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error) {
+	res, err := s.doCall(ctx, "VersionedAttestationPool", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationPool, err := client.(consensusclient.VersionedAttestationPoolProvider).VersionedAttestationPool(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		return attestationPool, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*spec.VersionedAttestation), nil
+}
+
+// VersionedAttestationPoolForCommittee obtains the attestation pool for a given slot and committee.
+func (s *Service) VersionedAttestationPoolForCommittee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]*spec.VersionedAttestation,
+	error,
+) {
+	res, err := s.doCall(ctx, "VersionedAttestationPoolForCommittee", func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationPool, err := client.(consensusclient.VersionedAttestationPoolProvider).VersionedAttestationPoolForCommittee(ctx, slot, committeeIndex)
+		if err != nil {
+			return nil, err
+		}
+		return attestationPool, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.([]*spec.VersionedAttestation), nil
+}
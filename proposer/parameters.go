@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposer
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// SignerFunc signs a block.
+// It is supplied with the slot and root of the block, and is expected to
+// return the proposer's signature over the block.
+type SignerFunc func(ctx context.Context, slot phase0.Slot, blockRoot phase0.Root) (phase0.BLSSignature, error)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	client             consensusclient.Service
+	signer             SignerFunc
+	blindedEnabled     bool
+	builderBoostFactor uint64
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client used to obtain and submit proposals.
+// The client must be able to provide and submit beacon blocks; if it is also
+// able to provide and submit blinded beacon blocks these will be used when
+// blinded proposals are enabled.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithSigner sets the function used to sign blocks.
+func WithSigner(signer SignerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signer = signer
+	})
+}
+
+// WithBlindedProposals sets whether to request blinded proposals from the client.
+func WithBlindedProposals(enabled bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blindedEnabled = enabled
+	})
+}
+
+// WithBuilderBoostFactor sets the builder boost factor used when requesting proposals
+// from clients that can supply either blinded or unblinded proposals.  It is a percentage
+// multiplier applied to the builder's payload value when the client compares it with its
+// local payload.  It is ignored if blinded proposals are not enabled.
+func WithBuilderBoostFactor(factor uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.builderBoostFactor = factor
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:           zerolog.GlobalLevel(),
+		builderBoostFactor: 100,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.BeaconBlockProposalProvider); !isProvider {
+		return nil, errors.New("client is not a beacon block proposal provider")
+	}
+	if _, isSubmitter := parameters.client.(consensusclient.BeaconBlockSubmitter); !isSubmitter {
+		return nil, errors.New("client is not a beacon block submitter")
+	}
+	if parameters.signer == nil {
+		return nil, errors.New("no signer specified")
+	}
+
+	return &parameters, nil
+}
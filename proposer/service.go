@@ -0,0 +1,264 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposer
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Proposal is the result of a successful block proposal.
+type Proposal struct {
+	// Slot is the slot of the proposed block.
+	Slot phase0.Slot
+	// Root is the root of the proposed block.
+	Root phase0.Root
+	// Blinded is true if the proposal was made with a blinded block.
+	Blinded bool
+}
+
+// Service proposes blocks, handling both blinded and unblinded proposals.
+type Service struct {
+	log                     zerolog.Logger
+	proposalProvider        consensusclient.BeaconBlockProposalProvider
+	submitter               consensusclient.BeaconBlockSubmitter
+	blindedProposalProvider consensusclient.BlindedBeaconBlockProposalProvider
+	blindedSubmitter        consensusclient.BlindedBeaconBlockSubmitter
+	unifiedProposalProvider consensusclient.ProposalProvider
+	builderBoostFactor      uint64
+	signer                  SignerFunc
+}
+
+// New creates a new block proposer.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "proposer").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:              log,
+		proposalProvider: parameters.client.(consensusclient.BeaconBlockProposalProvider),
+		submitter:        parameters.client.(consensusclient.BeaconBlockSubmitter),
+		signer:           parameters.signer,
+	}
+
+	if parameters.blindedEnabled {
+		blindedProposalProvider, isProvider := parameters.client.(consensusclient.BlindedBeaconBlockProposalProvider)
+		blindedSubmitter, isSubmitter := parameters.client.(consensusclient.BlindedBeaconBlockSubmitter)
+		if isProvider && isSubmitter {
+			s.blindedProposalProvider = blindedProposalProvider
+			s.blindedSubmitter = blindedSubmitter
+		} else {
+			log.Warn().Msg("Client does not support blinded proposals; only unblinded proposals will be made")
+		}
+	}
+
+	if unifiedProposalProvider, isProvider := parameters.client.(consensusclient.ProposalProvider); isProvider {
+		s.unifiedProposalProvider = unifiedProposalProvider
+		if s.blindedSubmitter != nil {
+			s.builderBoostFactor = parameters.builderBoostFactor
+		}
+	}
+
+	return s, nil
+}
+
+// ProposeBlock obtains a proposal for the given slot, signs it with the signer
+// and submits the signed block to the appropriate endpoint.
+// If the client can supply blinded or unblinded proposals from a single request this
+// is tried first, with the builder boost factor (or 0 if blinded proposals are not
+// enabled).  Otherwise, if blinded proposals are enabled a blinded proposal is requested,
+// falling back to an unblinded proposal if the blinded proposal cannot be obtained.
+func (s *Service) ProposeBlock(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+) (
+	*Proposal,
+	error,
+) {
+	log := s.log.With().Uint64("slot", uint64(slot)).Logger()
+
+	if s.unifiedProposalProvider != nil {
+		proposal, err := s.unifiedProposalProvider.Proposal(ctx, slot, randaoReveal, graffiti, s.builderBoostFactor)
+		switch {
+		case err != nil:
+			log.Debug().Err(err).Msg("Failed to obtain proposal; falling back to separate proposals")
+		case proposal == nil || proposal.IsEmpty():
+			log.Debug().Msg("No proposal returned; falling back to separate proposals")
+		default:
+			return s.proposeVersionedProposal(ctx, slot, proposal)
+		}
+	}
+
+	if s.blindedProposalProvider != nil {
+		proposal, err := s.blindedProposalProvider.BlindedBeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+		switch {
+		case err != nil:
+			log.Debug().Err(err).Msg("Failed to obtain blinded proposal; falling back to unblinded proposal")
+		case proposal == nil || proposal.IsEmpty():
+			log.Debug().Msg("No blinded proposal returned; falling back to unblinded proposal")
+		default:
+			return s.proposeBlindedBlock(ctx, slot, proposal)
+		}
+	}
+
+	proposal, err := s.proposalProvider.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal")
+	}
+	if proposal == nil || proposal.IsEmpty() {
+		return nil, errors.New("no proposal returned")
+	}
+
+	return s.proposeBlock(ctx, slot, proposal)
+}
+
+// proposeVersionedProposal signs and submits a proposal that may be blinded or unblinded.
+func (s *Service) proposeVersionedProposal(ctx context.Context, slot phase0.Slot, proposal *api.VersionedProposal) (*Proposal, error) {
+	if proposal.Blinded {
+		if s.blindedSubmitter == nil {
+			return nil, errors.New("blinded proposal returned but blinded proposals are not enabled")
+		}
+		block, err := proposal.BlindedBeaconBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		return s.proposeBlindedBlock(ctx, slot, block)
+	}
+
+	block, err := proposal.BeaconBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.proposeBlock(ctx, slot, block)
+}
+
+// proposeBlock signs and submits an unblinded block.
+func (s *Service) proposeBlock(ctx context.Context, slot phase0.Slot, proposal *spec.VersionedBeaconBlock) (*Proposal, error) {
+	root, err := proposal.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposal root")
+	}
+	sig, err := s.signer(ctx, slot, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign proposal")
+	}
+
+	signedBlock, err := signBlock(proposal, sig)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.submitter.SubmitBeaconBlock(ctx, signedBlock); err != nil {
+		return nil, errors.Wrap(err, "failed to submit block")
+	}
+	s.log.Trace().Uint64("slot", uint64(slot)).Stringer("root", root).Msg("Submitted block")
+
+	return &Proposal{
+		Slot: slot,
+		Root: root,
+	}, nil
+}
+
+// proposeBlindedBlock signs and submits a blinded block.
+func (s *Service) proposeBlindedBlock(ctx context.Context, slot phase0.Slot, proposal *api.VersionedBlindedBeaconBlock) (*Proposal, error) {
+	root, err := proposal.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain blinded proposal root")
+	}
+	sig, err := s.signer(ctx, slot, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign blinded proposal")
+	}
+
+	signedBlock, err := signBlindedBlock(proposal, sig)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.blindedSubmitter.SubmitBlindedBeaconBlock(ctx, signedBlock); err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded block")
+	}
+	s.log.Trace().Uint64("slot", uint64(slot)).Stringer("root", root).Msg("Submitted blinded block")
+
+	return &Proposal{
+		Slot:    slot,
+		Root:    root,
+		Blinded: true,
+	}, nil
+}
+
+// signBlock combines a block and its signature.
+func signBlock(block *spec.VersionedBeaconBlock, sig phase0.BLSSignature) (*spec.VersionedSignedBeaconBlock, error) {
+	signedBlock := &spec.VersionedSignedBeaconBlock{
+		Version: block.Version,
+	}
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		signedBlock.Phase0 = &phase0.SignedBeaconBlock{Message: block.Phase0, Signature: sig}
+	case spec.DataVersionAltair:
+		signedBlock.Altair = &altair.SignedBeaconBlock{Message: block.Altair, Signature: sig}
+	case spec.DataVersionBellatrix:
+		signedBlock.Bellatrix = &bellatrix.SignedBeaconBlock{Message: block.Bellatrix, Signature: sig}
+	case spec.DataVersionCapella:
+		signedBlock.Capella = &capella.SignedBeaconBlock{Message: block.Capella, Signature: sig}
+	case spec.DataVersionDeneb:
+		signedBlock.Deneb = &deneb.SignedBeaconBlock{Message: block.Deneb, Signature: sig}
+	default:
+		return nil, errors.New("unsupported block version")
+	}
+
+	return signedBlock, nil
+}
+
+// signBlindedBlock combines a blinded block and its signature.
+func signBlindedBlock(block *api.VersionedBlindedBeaconBlock, sig phase0.BLSSignature) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	signedBlock := &api.VersionedSignedBlindedBeaconBlock{
+		Version: block.Version,
+	}
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		signedBlock.Bellatrix = &apiv1bellatrix.SignedBlindedBeaconBlock{Message: block.Bellatrix, Signature: sig}
+	case spec.DataVersionCapella:
+		signedBlock.Capella = &apiv1capella.SignedBlindedBeaconBlock{Message: block.Capella, Signature: sig}
+	case spec.DataVersionDeneb:
+		signedBlock.Deneb = &apiv1deneb.SignedBlindedBeaconBlock{Message: block.Deneb, Signature: sig}
+	default:
+		return nil, errors.New("unsupported blinded block version")
+	}
+
+	return signedBlock, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proposer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/proposer"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testSigner(_ context.Context, _ phase0.Slot, _ phase0.Root) (phase0.BLSSignature, error) {
+	return phase0.BLSSignature{0x01}, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []proposer.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []proposer.Parameter{
+				proposer.WithLogLevel(zerolog.Disabled),
+				proposer.WithSigner(testSigner),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "SignerMissing",
+			params: []proposer.Parameter{
+				proposer.WithLogLevel(zerolog.Disabled),
+				proposer.WithClient(mockClient),
+			},
+			err: "problem with parameters: no signer specified",
+		},
+		{
+			name: "Good",
+			params: []proposer.Parameter{
+				proposer.WithLogLevel(zerolog.Disabled),
+				proposer.WithClient(mockClient),
+				proposer.WithSigner(testSigner),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := proposer.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProposeBlock(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		blinded         bool
+		params          []proposer.Parameter
+		signer          proposer.SignerFunc
+		expectedBlinded bool
+		err             string
+	}{
+		{
+			name:   "Unblinded",
+			signer: testSigner,
+		},
+		{
+			name:            "Blinded",
+			blinded:         true,
+			signer:          testSigner,
+			expectedBlinded: true,
+		},
+		{
+			name:    "BlindedNoBuilderBoost",
+			blinded: true,
+			params: []proposer.Parameter{
+				proposer.WithBuilderBoostFactor(0),
+			},
+			signer: testSigner,
+		},
+		{
+			name: "SignerFails",
+			signer: func(_ context.Context, _ phase0.Slot, _ phase0.Root) (phase0.BLSSignature, error) {
+				return phase0.BLSSignature{}, errors.New("signer failed")
+			},
+			err: "failed to sign proposal: signer failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := append([]proposer.Parameter{
+				proposer.WithLogLevel(zerolog.Disabled),
+				proposer.WithClient(mockClient),
+				proposer.WithSigner(test.signer),
+				proposer.WithBlindedProposals(test.blinded),
+			}, test.params...)
+			s, err := proposer.New(ctx, params...)
+			require.NoError(t, err)
+
+			proposal, err := s.ProposeBlock(ctx, 12345, phase0.BLSSignature{}, []byte("test"))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(12345), proposal.Slot)
+			require.Equal(t, test.expectedBlinded, proposal.Blinded)
+			require.NotEqual(t, phase0.Root{}, proposal.Root)
+		})
+	}
+}
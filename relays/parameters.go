@@ -0,0 +1,103 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relays
+
+import (
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	relays     []consensusclient.Service
+	minimumBid *uint256.Int
+	timeout    time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRelays sets the relays from which bids are obtained.
+// Each relay must be a builder bid provider.
+func WithRelays(relays []consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.relays = relays
+	})
+}
+
+// WithMinimumBid sets the minimum value, in wei, of a bid for it to be accepted.
+// Bids with a lower value are ignored.
+func WithMinimumBid(minimumBid *uint256.Int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.minimumBid = minimumBid
+	})
+}
+
+// WithTimeout sets the maximum duration to wait for relays to return their bids.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:   zerolog.GlobalLevel(),
+		minimumBid: uint256.NewInt(0),
+		timeout:    time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.relays) == 0 {
+		return nil, errors.New("no relays specified")
+	}
+	for _, relay := range parameters.relays {
+		if _, isProvider := relay.(consensusclient.BuilderBidProvider); !isProvider {
+			return nil, fmt.Errorf("relay %s is not a builder bid provider", relay.Address())
+		}
+	}
+	if parameters.minimumBid == nil {
+		return nil, errors.New("no minimum bid specified")
+	}
+	if parameters.timeout == 0 {
+		return nil, errors.New("no timeout specified")
+	}
+
+	return &parameters, nil
+}
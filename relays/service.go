@@ -0,0 +1,234 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relays obtains builder bids from multiple relays, selecting the best bid.
+package relays
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// RelayStats are the statistics for bid requests made to a relay.
+type RelayStats struct {
+	// Address is the address of the relay.
+	Address string
+	// Requests is the number of bid requests made to the relay.
+	Requests uint64
+	// Bids is the number of bids returned by the relay.
+	Bids uint64
+	// Errors is the number of bid requests that failed.
+	Errors uint64
+	// Wins is the number of times that the relay provided the selected bid.
+	Wins uint64
+	// LastLatency is the latency of the most recent bid request.
+	LastLatency time.Duration
+	// TotalLatency is the total latency of all bid requests.
+	TotalLatency time.Duration
+}
+
+// AverageLatency returns the average latency of bid requests made to the relay.
+func (r *RelayStats) AverageLatency() time.Duration {
+	if r.Requests == 0 {
+		return 0
+	}
+
+	return r.TotalLatency / time.Duration(r.Requests)
+}
+
+// Service obtains builder bids from multiple relays.
+type Service struct {
+	log        zerolog.Logger
+	relays     []consensusclient.Service
+	minimumBid *uint256.Int
+	timeout    time.Duration
+
+	statsMu sync.RWMutex
+	stats   map[string]*RelayStats
+}
+
+// New creates a new multi-relay service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "relays").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	stats := make(map[string]*RelayStats, len(parameters.relays))
+	for _, relay := range parameters.relays {
+		stats[relay.Address()] = &RelayStats{
+			Address: relay.Address(),
+		}
+	}
+
+	return &Service{
+		log:        log,
+		relays:     parameters.relays,
+		minimumBid: parameters.minimumBid,
+		timeout:    parameters.timeout,
+		stats:      stats,
+	}, nil
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "relays"
+}
+
+// Address returns the address of the service.
+func (*Service) Address() string {
+	return ""
+}
+
+type relayBid struct {
+	address string
+	bid     *api.VersionedSignedBuilderBid
+	value   *uint256.Int
+}
+
+// BuilderBid obtains bids from all relays concurrently, returning the bid with the
+// highest value.  Bids with a value below the minimum bid are ignored, and if two
+// relays provide bids of the same value the first to respond is selected.
+// If no relay provides an acceptable bid this will return an error that matches api.ErrNotFound.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	log := s.log.With().Uint64("slot", uint64(slot)).Str("parent_hash", parentHash.String()).Logger()
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	bidsCh := make(chan *relayBid, len(s.relays))
+	for _, relay := range s.relays {
+		go func(relay consensusclient.Service) {
+			bidsCh <- s.relayBid(ctx, relay, slot, parentHash, pubKey)
+		}(relay)
+	}
+
+	var best *relayBid
+	for range s.relays {
+		bid := <-bidsCh
+		if bid == nil {
+			continue
+		}
+		if bid.value.Lt(s.minimumBid) {
+			log.Debug().Str("relay", bid.address).Stringer("value", bid.value).Msg("Bid below minimum; ignoring")
+			continue
+		}
+		if best == nil || bid.value.Gt(best.value) {
+			best = bid
+		}
+	}
+
+	if best == nil {
+		return nil, errors.Wrap(api.ErrNotFound, "no acceptable builder bids returned")
+	}
+
+	s.statsMu.Lock()
+	s.stats[best.address].Wins++
+	s.statsMu.Unlock()
+	log.Trace().Str("relay", best.address).Stringer("value", best.value).Msg("Selected bid")
+
+	return best.bid, nil
+}
+
+// relayBid obtains a bid from a single relay, updating the relay's statistics.
+// It returns nil if the relay did not provide a valid bid.
+func (s *Service) relayBid(ctx context.Context,
+	relay consensusclient.Service,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) *relayBid {
+	started := time.Now()
+	bid, err := relay.(consensusclient.BuilderBidProvider).BuilderBid(ctx, slot, parentHash, pubKey)
+	latency := time.Since(started)
+
+	var value *uint256.Int
+	if err == nil && bid != nil {
+		value, err = bid.Value()
+		if err == nil && value == nil {
+			err = errors.New("bid has no value")
+		}
+	}
+
+	s.statsMu.Lock()
+	stats := s.stats[relay.Address()]
+	stats.Requests++
+	stats.LastLatency = latency
+	stats.TotalLatency += latency
+	switch {
+	case err != nil && !errors.Is(err, api.ErrNotFound):
+		stats.Errors++
+	case err == nil && bid != nil:
+		stats.Bids++
+	}
+	s.statsMu.Unlock()
+
+	switch {
+	case err != nil && errors.Is(err, api.ErrNotFound):
+		s.log.Trace().Str("relay", relay.Address()).Dur("latency", latency).Msg("No bid from relay")
+		return nil
+	case err != nil:
+		s.log.Debug().Str("relay", relay.Address()).Dur("latency", latency).Err(err).Msg("Failed to obtain bid from relay")
+		return nil
+	case bid == nil:
+		s.log.Trace().Str("relay", relay.Address()).Dur("latency", latency).Msg("No bid from relay")
+		return nil
+	}
+	s.log.Trace().Str("relay", relay.Address()).Dur("latency", latency).Stringer("value", value).Msg("Obtained bid from relay")
+
+	return &relayBid{
+		address: relay.Address(),
+		bid:     bid,
+		value:   value,
+	}
+}
+
+// RelayStats returns the statistics for each relay, ordered by address.
+func (s *Service) RelayStats() []*RelayStats {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+
+	res := make([]*RelayStats, 0, len(s.stats))
+	for _, stats := range s.stats {
+		statsCopy := *stats
+		res = append(res, &statsCopy)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Address < res[j].Address
+	})
+
+	return res
+}
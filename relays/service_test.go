@@ -0,0 +1,254 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relays_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/relays"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testRelay is a relay that returns a bid with a fixed value.
+type testRelay struct {
+	address string
+	value   *uint256.Int
+	err     error
+}
+
+func (*testRelay) Name() string {
+	return "test"
+}
+
+func (r *testRelay) Address() string {
+	return r.address
+}
+
+func (r *testRelay) BuilderBid(_ context.Context,
+	_ phase0.Slot,
+	parentHash phase0.Hash32,
+	_ phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return &api.VersionedSignedBuilderBid{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBuilderBid{
+			Message: &apiv1capella.BuilderBid{
+				Header: &capella.ExecutionPayloadHeader{
+					ParentHash: parentHash,
+				},
+				Value: r.value,
+			},
+		},
+	}, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []relays.Parameter
+		err    string
+	}{
+		{
+			name: "RelaysMissing",
+			params: []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no relays specified",
+		},
+		{
+			name: "RelayNotProvider",
+			params: []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+				relays.WithRelays([]consensusclient.Service{&struct{ consensusclient.Service }{mockClient}}),
+			},
+			err: "problem with parameters: relay mock is not a builder bid provider",
+		},
+		{
+			name: "MinimumBidNil",
+			params: []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+				relays.WithRelays([]consensusclient.Service{mockClient}),
+				relays.WithMinimumBid(nil),
+			},
+			err: "problem with parameters: no minimum bid specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+				relays.WithRelays([]consensusclient.Service{mockClient}),
+				relays.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+				relays.WithRelays([]consensusclient.Service{mockClient}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := relays.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuilderBid(t *testing.T) {
+	ctx := context.Background()
+
+	relay1 := &testRelay{address: "relay1", value: uint256.NewInt(100)}
+	relay2 := &testRelay{address: "relay2", value: uint256.NewInt(300)}
+	relay3 := &testRelay{address: "relay3", value: uint256.NewInt(200)}
+	erroringRelay := &testRelay{address: "erroring", err: errors.New("failed")}
+	emptyRelay := &testRelay{address: "empty", err: api.ErrNotFound}
+
+	tests := []struct {
+		name     string
+		relays   []consensusclient.Service
+		minimum  *uint256.Int
+		expected *uint256.Int
+		err      string
+	}{
+		{
+			name:     "Single",
+			relays:   []consensusclient.Service{relay1},
+			expected: uint256.NewInt(100),
+		},
+		{
+			name:     "Best",
+			relays:   []consensusclient.Service{relay1, relay2, relay3},
+			expected: uint256.NewInt(300),
+		},
+		{
+			name:     "Errors",
+			relays:   []consensusclient.Service{erroringRelay, emptyRelay, relay3},
+			expected: uint256.NewInt(200),
+		},
+		{
+			name:     "Minimum",
+			relays:   []consensusclient.Service{relay1, relay3},
+			minimum:  uint256.NewInt(150),
+			expected: uint256.NewInt(200),
+		},
+		{
+			name:    "BelowMinimum",
+			relays:  []consensusclient.Service{relay1, relay2, relay3},
+			minimum: uint256.NewInt(1000),
+			err:     "no acceptable builder bids returned: not found",
+		},
+		{
+			name:   "NoBids",
+			relays: []consensusclient.Service{erroringRelay, emptyRelay},
+			err:    "no acceptable builder bids returned: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := []relays.Parameter{
+				relays.WithLogLevel(zerolog.Disabled),
+				relays.WithRelays(test.relays),
+				relays.WithTimeout(time.Second),
+			}
+			if test.minimum != nil {
+				params = append(params, relays.WithMinimumBid(test.minimum))
+			}
+			s, err := relays.New(ctx, params...)
+			require.NoError(t, err)
+
+			bid, err := s.BuilderBid(ctx, 1, phase0.Hash32{0x01}, phase0.BLSPubKey{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, api.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+				value, err := bid.Value()
+				require.NoError(t, err)
+				require.Equal(t, test.expected, value)
+			}
+		})
+	}
+}
+
+func TestRelayStats(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := relays.New(ctx,
+		relays.WithLogLevel(zerolog.Disabled),
+		relays.WithRelays([]consensusclient.Service{
+			&testRelay{address: "relay2", value: uint256.NewInt(300)},
+			&testRelay{address: "relay1", value: uint256.NewInt(100)},
+			&testRelay{address: "erroring", err: errors.New("failed")},
+		}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err := s.BuilderBid(ctx, 1, phase0.Hash32{0x01}, phase0.BLSPubKey{})
+		require.NoError(t, err)
+	}
+
+	stats := s.RelayStats()
+	require.Len(t, stats, 3)
+
+	require.Equal(t, "erroring", stats[0].Address)
+	require.Equal(t, uint64(4), stats[0].Requests)
+	require.Equal(t, uint64(4), stats[0].Errors)
+	require.Equal(t, uint64(0), stats[0].Bids)
+	require.Equal(t, uint64(0), stats[0].Wins)
+
+	require.Equal(t, "relay1", stats[1].Address)
+	require.Equal(t, uint64(4), stats[1].Requests)
+	require.Equal(t, uint64(0), stats[1].Errors)
+	require.Equal(t, uint64(4), stats[1].Bids)
+	require.Equal(t, uint64(0), stats[1].Wins)
+
+	require.Equal(t, "relay2", stats[2].Address)
+	require.Equal(t, uint64(4), stats[2].Bids)
+	require.Equal(t, uint64(4), stats[2].Wins)
+	require.Equal(t, stats[2].TotalLatency/4, stats[2].AverageLatency())
+}
@@ -21,8 +21,11 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -53,6 +56,12 @@ type NodeVersionProvider interface {
 	NodeVersion(ctx context.Context) (string, error)
 }
 
+// ParsedNodeVersionProvider is the interface for providing the parsed node version.
+type ParsedNodeVersionProvider interface {
+	// ParsedNodeVersion returns the node version parsed in to its component parts.
+	ParsedNodeVersion(ctx context.Context) (*apiv1.NodeVersion, error)
+}
+
 // SlotDurationProvider is the interface for providing the duration of each slot of a chain.
 type SlotDurationProvider interface {
 	// SlotDuration provides the duration of a slot of the chain.
@@ -108,18 +117,52 @@ type DepositContractProvider interface {
 	DepositContract(ctx context.Context) (*apiv1.DepositContract, error)
 }
 
+// DepositSnapshotProvider is the interface for providing deposit tree snapshots.
+type DepositSnapshotProvider interface {
+	// DepositSnapshot provides a snapshot of the deposit contract Merkle tree.
+	DepositSnapshot(ctx context.Context) (*apiv1.DepositTreeSnapshot, error)
+}
+
+// SignedBlindedBeaconBlockProvider is the interface for providing blinded beacon blocks.
+type SignedBlindedBeaconBlockProvider interface {
+	// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+	SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error)
+}
+
 // SignedBeaconBlockProvider is the interface for providing beacon blocks.
 type SignedBeaconBlockProvider interface {
 	// SignedBeaconBlock fetches a signed beacon block given a block ID.
 	SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error)
 }
 
+// BlockAttestationsProvider is the interface for providing the attestations in a beacon block.
+type BlockAttestationsProvider interface {
+	// BlockAttestations fetches the attestations in a beacon block given a block ID.
+	BlockAttestations(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error)
+}
+
 // BeaconBlockBlobsProvider is the interface for providing blobs for a given beacon block.
 type BeaconBlockBlobsProvider interface {
 	// BeaconBlockBlobs fetches the blobs given a block ID.
 	BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error)
 }
 
+// BlobSidecarsProvider is the interface for providing blob sidecars.
+type BlobSidecarsProvider interface {
+	// BlobSidecars fetches the blob sidecars given a block ID.
+	// indices is a list of blob indices to restrict the returned values.  If no indices are supplied no filter
+	// will be applied.
+	BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error)
+}
+
+// DataColumnSidecarsProvider is the interface for providing data column sidecars.
+type DataColumnSidecarsProvider interface {
+	// DataColumnSidecars fetches the data column sidecars given a block ID.
+	// indices is a list of column indices to restrict the returned values.  If no indices are supplied no filter
+	// will be applied.
+	DataColumnSidecars(ctx context.Context, blockID string, indices []fulu.ColumnIndex) ([]*fulu.DataColumnSidecar, error)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the epoch at the given state.
@@ -167,6 +210,9 @@ type AttestationDataProvider interface {
 type AttestationPoolProvider interface {
 	// AttestationPool fetches the attestation pool for the given slot.
 	AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error)
+
+	// AttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+	AttestationPoolForCommittee(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]*phase0.Attestation, error)
 }
 
 // AttestationsSubmitter is the interface for submitting attestations.
@@ -175,6 +221,55 @@ type AttestationsSubmitter interface {
 	SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error
 }
 
+// VersionedAggregateAttestationProvider is the interface for providing versioned aggregate attestations.
+type VersionedAggregateAttestationProvider interface {
+	// VersionedAggregateAttestation fetches the aggregate attestation for the given slot, attestation data root
+	// and committee index.  The committee index is required to select the aggregate from Electra onwards, as the
+	// attestation data no longer contains it.
+	VersionedAggregateAttestation(ctx context.Context,
+		slot phase0.Slot,
+		attestationDataRoot phase0.Root,
+		committeeIndex phase0.CommitteeIndex,
+	) (
+		*spec.VersionedAttestation,
+		error,
+	)
+}
+
+// VersionedAttestationPoolProvider is the interface for providing versioned attestation pools.
+type VersionedAttestationPoolProvider interface {
+	// VersionedAttestationPool fetches the attestation pool for the given slot.
+	VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error)
+
+	// VersionedAttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+	VersionedAttestationPoolForCommittee(ctx context.Context,
+		slot phase0.Slot,
+		committeeIndex phase0.CommitteeIndex,
+	) (
+		[]*spec.VersionedAttestation,
+		error,
+	)
+}
+
+// VersionedAttestationsSubmitter is the interface for submitting versioned attestations.
+type VersionedAttestationsSubmitter interface {
+	// SubmitVersionedAttestations submits versioned attestations.
+	SubmitVersionedAttestations(ctx context.Context, attestations []*spec.VersionedAttestation) error
+}
+
+// AttestationRewardsProvider is the interface for providing attestation rewards.
+type AttestationRewardsProvider interface {
+	// AttestationRewards provides the attestation rewards for a given epoch.
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error)
+
+	// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+	// validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
+	// supplied no filter will be applied.
+	AttestationRewardsByPubKey(ctx context.Context, epoch phase0.Epoch, validatorPubKeys []phase0.BLSPubKey) (*apiv1.AttestationRewards, error)
+}
+
 // AttesterDutiesProvider is the interface for providing attester duties.
 type AttesterDutiesProvider interface {
 	// AttesterDuties obtains attester duties.
@@ -182,6 +277,18 @@ type AttesterDutiesProvider interface {
 	AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error)
 }
 
+// AttesterSlashingPoolProvider is the interface for providing attester slashing pools.
+type AttesterSlashingPoolProvider interface {
+	// AttesterSlashingPool fetches the attester slashing pool.
+	AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error)
+}
+
+// AttesterSlashingSubmitter is the interface for submitting attester slashings.
+type AttesterSlashingSubmitter interface {
+	// SubmitAttesterSlashing submits an attester slashing.
+	SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error
+}
+
 // SyncCommitteeDutiesProvider is the interface for providing sync committee duties.
 type SyncCommitteeDutiesProvider interface {
 	// SyncCommitteeDuties obtains sync committee duties.
@@ -213,6 +320,12 @@ type SyncCommitteeContributionsSubmitter interface {
 	SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error
 }
 
+// BLSToExecutionChangePoolProvider is the interface for providing BLS to execution change pools.
+type BLSToExecutionChangePoolProvider interface {
+	// BLSToExecutionChangePool fetches the BLS to execution change pool.
+	BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error)
+}
+
 // BLSToExecutionChangesSubmitter is the interface for submitting BLS to execution address changes.
 type BLSToExecutionChangesSubmitter interface {
 	// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
@@ -223,6 +336,10 @@ type BLSToExecutionChangesSubmitter interface {
 type BeaconBlockHeadersProvider interface {
 	// BeaconBlockHeader provides the block header of a given block ID.
 	BeaconBlockHeader(ctx context.Context, blockID string) (*apiv1.BeaconBlockHeader, error)
+
+	// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+	// Either filter may be nil; if both are nil the header of the canonical head is returned.
+	BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error)
 }
 
 // BeaconBlockProposalProvider is the interface for providing beacon block proposals.
@@ -261,6 +378,12 @@ type BeaconStateRandaoProvider interface {
 	BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error)
 }
 
+// BeaconStateRandaoAtEpochProvider is the interface for providing beacon state RANDAOs at a given epoch.
+type BeaconStateRandaoAtEpochProvider interface {
+	// BeaconStateRandaoAtEpoch fetches the RANDAO mix for the given epoch given a state ID.
+	BeaconStateRandaoAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*phase0.Root, error)
+}
+
 // BeaconStateRootProvider is the interface for providing beacon state roots.
 type BeaconStateRootProvider interface {
 	// BeaconStateRoot fetches a beacon state root given a state ID.
@@ -279,6 +402,12 @@ type BlindedBeaconBlockSubmitter interface {
 	SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error
 }
 
+// BlockRewardsProvider is the interface for providing block rewards.
+type BlockRewardsProvider interface {
+	// BlockRewards fetches the rewards received by the proposer of a block given a block ID.
+	BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error)
+}
+
 // ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
 type ValidatorRegistrationsSubmitter interface {
 	// SubmitValidatorRegistrations submits a validator registration.
@@ -291,6 +420,75 @@ type EventsProvider interface {
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// EventSubscription is a handle to an active events subscription.
+type EventSubscription interface {
+	// Close stops the subscription, returning once its events stream has been torn down.
+	Close()
+
+	// Done returns a channel that is closed once the events stream has been torn down,
+	// whether by Close, the context being done or the service being closed.
+	Done() <-chan struct{}
+
+	// Overflows returns the number of events dropped by the subscription because its
+	// buffer was full.
+	Overflows() uint64
+}
+
+// EventsSubscriber is the interface for subscribing to events with a closable handle.
+type EventsSubscriber interface {
+	// Subscribe feeds requested events with the given topics to the supplied handler
+	// until either the context is done or the returned subscription is closed.
+	Subscribe(ctx context.Context, topics []string, handler EventHandlerFunc) (EventSubscription, error)
+}
+
+// FilteredEventsProvider is the interface for providing events that are filtered before
+// being passed to the handler.
+type FilteredEventsProvider interface {
+	// FilteredEvents feeds requested events with the given topics that pass all of the
+	// filters to the supplied handler.
+	FilteredEvents(ctx context.Context,
+		topics []string,
+		filters []apiv1.EventFilter,
+		handler EventHandlerFunc,
+	) error
+}
+
+// TypedEventsProvider is the interface for providing typed event streams.
+// Each channel is closed when the supplied context is done or the underlying events
+// stream is torn down.
+type TypedEventsProvider interface {
+	// SubscribeHead provides a stream of head events.
+	SubscribeHead(ctx context.Context) (<-chan *apiv1.HeadEvent, error)
+
+	// SubscribeBlock provides a stream of block events.
+	SubscribeBlock(ctx context.Context) (<-chan *apiv1.BlockEvent, error)
+
+	// SubscribeFinalizedCheckpoint provides a stream of finalized checkpoint events.
+	SubscribeFinalizedCheckpoint(ctx context.Context) (<-chan *apiv1.FinalizedCheckpointEvent, error)
+
+	// SubscribeChainReorg provides a stream of chain reorganisation events.
+	SubscribeChainReorg(ctx context.Context) (<-chan *apiv1.ChainReorgEvent, error)
+
+	// SubscribePayloadAttributes provides a stream of payload attributes events.
+	SubscribePayloadAttributes(ctx context.Context) (<-chan *apiv1.PayloadAttributesEvent, error)
+
+	// SubscribeBlobSidecar provides a stream of blob sidecar events.
+	SubscribeBlobSidecar(ctx context.Context) (<-chan *apiv1.BlobSidecarEvent, error)
+
+	// SubscribeProposerSlashing provides a stream of proposer slashings.
+	SubscribeProposerSlashing(ctx context.Context) (<-chan *phase0.ProposerSlashing, error)
+
+	// SubscribeAttesterSlashing provides a stream of attester slashings.
+	SubscribeAttesterSlashing(ctx context.Context) (<-chan *phase0.AttesterSlashing, error)
+}
+
+// ExpectedWithdrawalsProvider is the interface for providing expected withdrawals.
+type ExpectedWithdrawalsProvider interface {
+	// ExpectedWithdrawals fetches the withdrawals expected to be included in the next block built on the given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	ExpectedWithdrawals(ctx context.Context, stateID string) ([]*apiv1.ExpectedWithdrawal, error)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
@@ -299,7 +497,7 @@ type FinalityProvider interface {
 
 // ForkChoiceProvider is the interface for providing fork choice information.
 type ForkChoiceProvider interface {
-	// Fork fetches all current fork choice context.
+	// ForkChoice fetches all current fork choice context.
 	ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error)
 }
 
@@ -321,12 +519,47 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context) (*apiv1.Genesis, error)
 }
 
+// LightClientFinalityUpdateProvider is the interface for providing light client finality updates.
+type LightClientFinalityUpdateProvider interface {
+	// LightClientFinalityUpdate fetches the latest light client finality update known to the beacon node.
+	LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error)
+}
+
+// LightClientOptimisticUpdateProvider is the interface for providing light client optimistic updates.
+type LightClientOptimisticUpdateProvider interface {
+	// LightClientOptimisticUpdate fetches the latest light client optimistic update known to the beacon node.
+	LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
 	NodeSyncing(ctx context.Context) (*apiv1.SyncState, error)
 }
 
+// PendingConsolidationsProvider is the interface for providing pending consolidations.
+type PendingConsolidationsProvider interface {
+	// PendingConsolidations fetches the pending consolidations given a state ID.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error)
+}
+
+// ProposalProvider is the interface for providing proposals.
+type ProposalProvider interface {
+	// Proposal fetches a proposal for signing, which may be blinded or unblinded.
+	// builderBoostFactor is a percentage multiplier applied to the builder's payload value when choosing between
+	// builder and local payloads; 0 requests a local payload, 100 weighs both equally.
+	Proposal(ctx context.Context,
+		slot phase0.Slot,
+		randaoReveal phase0.BLSSignature,
+		graffiti []byte,
+		builderBoostFactor uint64,
+	) (
+		*api.VersionedProposal,
+		error,
+	)
+}
+
 // ProposalPreparationsSubmitter is the interface for submitting proposal preparations.
 type ProposalPreparationsSubmitter interface {
 	// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
@@ -341,6 +574,18 @@ type ProposerDutiesProvider interface {
 	ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error)
 }
 
+// ProposerSlashingPoolProvider is the interface for providing proposer slashing pools.
+type ProposerSlashingPoolProvider interface {
+	// ProposerSlashingPool fetches the proposer slashing pool.
+	ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error)
+}
+
+// ProposerSlashingSubmitter is the interface for submitting proposer slashings.
+type ProposerSlashingSubmitter interface {
+	// SubmitProposerSlashing submits a proposer slashing.
+	SubmitProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error
+}
+
 // SpecProvider is the interface for providing spec data.
 type SpecProvider interface {
 	// Spec provides the spec information of the chain.
@@ -362,6 +607,13 @@ type ValidatorBalancesProvider interface {
 	ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error)
 }
 
+// ValidatorLivenessProvider is the interface for providing validator liveness.
+type ValidatorLivenessProvider interface {
+	// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+	// A validator is considered live if the beacon node has observed it to be active in the epoch.
+	ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for a given state.
@@ -389,10 +641,148 @@ type VoluntaryExitPoolProvider interface {
 	VoluntaryExitPool(ctx context.Context) ([]*phase0.SignedVoluntaryExit, error)
 }
 
+//
+// Builder API
+//
+
+// BuilderBidProvider is the interface for providing builder bids.
+type BuilderBidProvider interface {
+	// BuilderBid fetches the bid of a builder for the execution payload of the given slot,
+	// built on the given parent hash, for the proposer with the given public key.
+	BuilderBid(ctx context.Context,
+		slot phase0.Slot,
+		parentHash phase0.Hash32,
+		pubKey phase0.BLSPubKey,
+	) (
+		*api.VersionedSignedBuilderBid,
+		error,
+	)
+}
+
+// BuilderStatusProvider is the interface for providing the status of builders.
+type BuilderStatusProvider interface {
+	// BuilderStatus returns nil if the builder is ready to provide bids, otherwise an error.
+	BuilderStatus(ctx context.Context) error
+}
+
+// BuilderBlindedBlockSubmitter is the interface for submitting blinded beacon blocks to builders.
+type BuilderBlindedBlockSubmitter interface {
+	// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder,
+	// returning the execution payload (and blobs bundle post-Deneb) that unblinds it.
+	SubmitBuilderBlindedBlock(ctx context.Context,
+		block *api.VersionedSignedBlindedBeaconBlock,
+	) (
+		*api.VersionedSubmitBlindedBlockResponse,
+		error,
+	)
+}
+
+//
+// Keymanager API
+//
+
+// KeystoresManager is the interface for managing the local keystores of a validator client.
+type KeystoresManager interface {
+	// Keystores lists the local keystores of the validator client.
+	Keystores(ctx context.Context) ([]*apiv1.Keystore, error)
+
+	// ImportKeystores imports EIP-2335 keystores, with their passwords and optional
+	// EIP-3076 slashing protection interchange data, in to the validator client.
+	ImportKeystores(ctx context.Context,
+		keystores []string,
+		passwords []string,
+		slashingProtection string,
+	) (
+		[]*apiv1.KeyOperationResult,
+		error,
+	)
+
+	// DeleteKeystores deletes the keystores with the given public keys from the validator
+	// client, returning the slashing protection interchange data for the keys.
+	DeleteKeystores(ctx context.Context, pubKeys []phase0.BLSPubKey) (*apiv1.DeletedKeystores, error)
+}
+
+// RemoteKeysManager is the interface for managing the remote signer keys of a validator client.
+type RemoteKeysManager interface {
+	// RemoteKeys lists the remote signer keys of the validator client.
+	RemoteKeys(ctx context.Context) ([]*apiv1.RemoteKey, error)
+
+	// ImportRemoteKeys imports keys held by remote signers in to the validator client.
+	ImportRemoteKeys(ctx context.Context, keys []*apiv1.RemoteKey) ([]*apiv1.KeyOperationResult, error)
+
+	// DeleteRemoteKeys deletes the remote signer keys with the given public keys from the
+	// validator client.
+	DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*apiv1.KeyOperationResult, error)
+}
+
+// FeeRecipientManager is the interface for managing the fee recipients of validators in a validator client.
+type FeeRecipientManager interface {
+	// FeeRecipient fetches the fee recipient for the validator with the given public key.
+	FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.FeeRecipient, error)
+
+	// SetFeeRecipient sets the fee recipient for the validator with the given public key.
+	SetFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey, ethAddress bellatrix.ExecutionAddress) error
+
+	// DeleteFeeRecipient removes the fee recipient for the validator with the given public key,
+	// returning it to the validator client's default.
+	DeleteFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// GasLimitManager is the interface for managing the gas limits of validators in a validator client.
+type GasLimitManager interface {
+	// GasLimit fetches the gas limit for the validator with the given public key.
+	GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.GasLimit, error)
+
+	// SetGasLimit sets the gas limit for the validator with the given public key.
+	SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error
+
+	// DeleteGasLimit removes the gas limit for the validator with the given public key,
+	// returning it to the validator client's default.
+	DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// GraffitiManager is the interface for managing the graffiti of validators in a validator client.
+type GraffitiManager interface {
+	// Graffiti fetches the graffiti for the validator with the given public key.
+	Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (*apiv1.Graffiti, error)
+
+	// SetGraffiti sets the graffiti for the validator with the given public key.
+	SetGraffiti(ctx context.Context, pubKey phase0.BLSPubKey, graffiti string) error
+
+	// DeleteGraffiti removes the graffiti for the validator with the given public key,
+	// returning it to the validator client's default.
+	DeleteGraffiti(ctx context.Context, pubKey phase0.BLSPubKey) error
+}
+
+// VoluntaryExitSigner is the interface for obtaining signed voluntary exits from a validator client.
+type VoluntaryExitSigner interface {
+	// SignVoluntaryExit obtains a voluntary exit for the validator with the given public key,
+	// signed for the current epoch.
+	SignVoluntaryExit(ctx context.Context, pubKey phase0.BLSPubKey) (*phase0.SignedVoluntaryExit, error)
+
+	// SignVoluntaryExitAtEpoch obtains a voluntary exit for the validator with the given public key,
+	// signed for the given epoch.
+	SignVoluntaryExitAtEpoch(ctx context.Context, pubKey phase0.BLSPubKey, epoch phase0.Epoch) (*phase0.SignedVoluntaryExit, error)
+}
+
 //
 // Local extensions
 //
 
+// ForkDigestProvider provides fork digests and versions from the fork schedule.
+type ForkDigestProvider interface {
+	// ForkDigest provides the fork digest at a given epoch.
+	ForkDigest(ctx context.Context, epoch phase0.Epoch) (phase0.ForkDigest, error)
+
+	// ForkVersion provides the fork version at a given epoch.
+	ForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, error)
+
+	// NextForkVersion provides the version and epoch of the first fork scheduled after a given epoch.
+	// If no fork is scheduled this returns the fork version at the given epoch and FAR_FUTURE_EPOCH,
+	// as required for the eth2 ENR field.
+	NextForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error)
+}
+
 // DomainProvider provides a domain for a given domain type at an epoch.
 type DomainProvider interface {
 	// Domain provides a domain for a given domain type at a given epoch.
@@ -416,3 +806,17 @@ type NodeClientProvider interface {
 	// NodeClient provides the client for the node.
 	NodeClient(ctx context.Context) (string, error)
 }
+
+// CapabilitiesProvider provides the capabilities of the connected node.
+type CapabilitiesProvider interface {
+	// Capabilities provides the provider and submitter interfaces that are
+	// usable against the connected node.
+	Capabilities(ctx context.Context) (*api.Capabilities, error)
+}
+
+// RawCallProvider provides the ability to call arbitrary endpoints on the node.
+type RawCallProvider interface {
+	// RawCall calls the given endpoint on the node, returning the response regardless
+	// of its status code.  An error is returned only if the call could not be made.
+	RawCall(ctx context.Context, method string, endpoint string, body []byte, opts *api.RawCallOpts) (*api.RawResponse, error)
+}
@@ -4,6 +4,7 @@
 package altair
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -168,7 +169,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 380 {
+	if o3 != 380 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -221,7 +222,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'AttesterSlashings'
 	{
 		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
+		num, err := codecs.DecodeDynamicLength(buf, 2)
 		if err != nil {
 			return err
 		}
@@ -243,7 +244,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Attestations'
 	{
 		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
+		num, err := codecs.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
 		}
@@ -304,7 +304,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736629 {
+	if o7 != 2736629 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"io"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// beaconStateFixedSize is the size of the fixed part of the SSZ-encoded state.
+const beaconStateFixedSize = 2736629
+
+// beaconStateOffsets are the positions of the variable-length field offsets
+// in the fixed part of the SSZ-encoded state.
+var beaconStateOffsets = []int{524464, 524540, 524552, 524556, 2687248, 2687252, 2687377}
+
+// UnmarshalSSZFrom ssz unmarshals the BeaconState object from a reader.
+// Unlike UnmarshalSSZ it does not require the entire encoding to be held in
+// memory, as the per-validator lists are decoded directly from the reader.
+func (b *BeaconState) UnmarshalSSZFrom(r io.Reader) error {
+	validators := make([]*phase0.Validator, 0)
+	balances := make([]phase0.Gwei, 0)
+	inactivityScores := make([]uint64, 0)
+
+	streamed := []*codecs.SSZStreamedList{
+		{
+			Offset:      524552,
+			ElementSize: 121,
+			MaxElements: 1099511627776,
+			Decode: func(buf []byte) error {
+				validator := new(phase0.Validator)
+				if err := validator.UnmarshalSSZ(buf); err != nil {
+					return err
+				}
+				validators = append(validators, validator)
+
+				return nil
+			},
+		},
+		{
+			Offset:      524556,
+			ElementSize: 8,
+			MaxElements: 1099511627776,
+			Decode: func(buf []byte) error {
+				balances = append(balances, phase0.Gwei(ssz.UnmarshallUint64(buf)))
+
+				return nil
+			},
+		},
+		{
+			Offset:      2687377,
+			ElementSize: 8,
+			MaxElements: 1099511627776,
+			Decode: func(buf []byte) error {
+				inactivityScores = append(inactivityScores, ssz.UnmarshallUint64(buf))
+
+				return nil
+			},
+		},
+	}
+
+	if err := codecs.UnmarshalSSZStream(r, beaconStateFixedSize, beaconStateOffsets, streamed, b.UnmarshalSSZ); err != nil {
+		return err
+	}
+
+	b.Validators = validators
+	b.Balances = balances
+	b.InactivityScores = inactivityScores
+
+	return nil
+}
@@ -16,6 +16,7 @@ package altair_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -107,6 +108,18 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &phase0.IndexedAttestation{},
 		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &altair.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &altair.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &altair.LightClientOptimisticUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
@@ -191,6 +204,12 @@ func TestConsensusSpec(t *testing.T) {
 					require.NoError(t, err)
 					require.Equal(t, specSSZ, remarshalledSpecSSZ)
 
+					// Confirm streamed decoding gives the same result, where supported.
+					if streamer, isStreamer := clone.Clone(test.s).(interface{ UnmarshalSSZFrom(io.Reader) error }); isStreamer {
+						require.NoError(t, streamer.UnmarshalSSZFrom(bytes.NewReader(specSSZ)))
+						require.Equal(t, s2, streamer)
+					}
+
 					// Obtain the hash tree root from the YAML.
 					specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
 					require.NoError(t, err)
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+)
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.BeaconBlock](f)
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.BeaconBlockBody](f)
+}
+
+func FuzzBeaconStateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.BeaconState](f)
+}
+
+func FuzzContributionAndProofUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.ContributionAndProof](f)
+}
+
+func FuzzLightClientFinalityUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.LightClientFinalityUpdate](f)
+}
+
+func FuzzLightClientHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.LightClientHeader](f)
+}
+
+func FuzzLightClientOptimisticUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.LightClientOptimisticUpdate](f)
+}
+
+func FuzzSignedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SignedBeaconBlock](f)
+}
+
+func FuzzSignedContributionAndProofUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SignedContributionAndProof](f)
+}
+
+func FuzzSyncAggregateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SyncAggregate](f)
+}
+
+func FuzzSyncAggregatorSelectionDataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SyncAggregatorSelectionData](f)
+}
+
+func FuzzSyncCommitteeUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SyncCommittee](f)
+}
+
+func FuzzSyncCommitteeContributionUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SyncCommitteeContribution](f)
+}
+
+func FuzzSyncCommitteeMessageUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[altair.SyncCommitteeMessage](f)
+}
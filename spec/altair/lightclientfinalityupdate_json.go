@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// finalityBranchLength is the number of roots in the finality branch of a light client update.
+const finalityBranchLength = 6
+
+// lightClientFinalityUpdateJSON is the spec representation of the struct.
+type lightClientFinalityUpdateJSON struct {
+	AttestedHeader  *LightClientHeader `json:"attested_header"`
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	FinalityBranch  []phase0.Root      `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   string             `json:"signature_slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u *LightClientFinalityUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientFinalityUpdateJSON{
+		AttestedHeader:  u.AttestedHeader,
+		FinalizedHeader: u.FinalizedHeader,
+		FinalityBranch:  u.FinalityBranch,
+		SyncAggregate:   u.SyncAggregate,
+		SignatureSlot:   fmt.Sprintf("%d", u.SignatureSlot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *LightClientFinalityUpdate) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientFinalityUpdateJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	u.AttestedHeader = &LightClientHeader{}
+	if err := u.AttestedHeader.UnmarshalJSON(raw["attested_header"]); err != nil {
+		return errors.Wrap(err, "attested_header")
+	}
+
+	u.FinalizedHeader = &LightClientHeader{}
+	if err := u.FinalizedHeader.UnmarshalJSON(raw["finalized_header"]); err != nil {
+		return errors.Wrap(err, "finalized_header")
+	}
+
+	if err := json.Unmarshal(raw["finality_branch"], &u.FinalityBranch); err != nil {
+		return errors.Wrap(err, "finality_branch")
+	}
+	if len(u.FinalityBranch) != finalityBranchLength {
+		return fmt.Errorf("finality_branch: incorrect length %d", len(u.FinalityBranch))
+	}
+
+	u.SyncAggregate = &SyncAggregate{}
+	if err := u.SyncAggregate.UnmarshalJSON(raw["sync_aggregate"]); err != nil {
+		return errors.Wrap(err, "sync_aggregate")
+	}
+
+	if err := u.SignatureSlot.UnmarshalJSON(raw["signature_slot"]); err != nil {
+		return errors.Wrap(err, "signature_slot")
+	}
+
+	return nil
+}
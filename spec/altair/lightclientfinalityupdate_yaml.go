@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// lightClientFinalityUpdateYAML is the spec representation of the struct.
+type lightClientFinalityUpdateYAML struct {
+	AttestedHeader  *LightClientHeader `yaml:"attested_header"`
+	FinalizedHeader *LightClientHeader `yaml:"finalized_header"`
+	FinalityBranch  []string           `yaml:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `yaml:"sync_aggregate"`
+	SignatureSlot   uint64             `yaml:"signature_slot"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (u *LightClientFinalityUpdate) MarshalYAML() ([]byte, error) {
+	finalityBranch := make([]string, len(u.FinalityBranch))
+	for i := range u.FinalityBranch {
+		finalityBranch[i] = u.FinalityBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientFinalityUpdateYAML{
+		AttestedHeader:  u.AttestedHeader,
+		FinalizedHeader: u.FinalizedHeader,
+		FinalityBranch:  finalityBranch,
+		SyncAggregate:   u.SyncAggregate,
+		SignatureSlot:   uint64(u.SignatureSlot),
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (u *LightClientFinalityUpdate) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var data lightClientFinalityUpdateJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return u.UnmarshalJSON(bytes)
+}
@@ -4,6 +4,7 @@
 package bellatrix
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	ssz "github.com/ferranbt/fastssz"
@@ -181,7 +182,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 384 {
+	if o3 != 384 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -239,7 +240,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'AttesterSlashings'
 	{
 		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
+		num, err := codecs.DecodeDynamicLength(buf, 2)
 		if err != nil {
 			return err
 		}
@@ -261,7 +262,7 @@ func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Attestations'
 	{
 		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
+		num, err := codecs.DecodeDynamicLength(buf, 128)
 		if err != nil {
 			return err
 		}
@@ -317,7 +317,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736633 {
+	if o7 != 2736633 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -16,6 +16,7 @@ package bellatrix_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -200,6 +201,12 @@ func TestConsensusSpec(t *testing.T) {
 					require.NoError(t, err)
 					require.Equal(t, specSSZ, remarshalledSpecSSZ)
 
+					// Confirm streamed decoding gives the same result, where supported.
+					if streamer, isStreamer := clone.Clone(test.s).(interface{ UnmarshalSSZFrom(io.Reader) error }); isStreamer {
+						require.NoError(t, streamer.UnmarshalSSZFrom(bytes.NewReader(specSSZ)))
+						require.Equal(t, s2, streamer)
+					}
+
 					// Obtain the hash tree root from the YAML.
 					specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
 					require.NoError(t, err)
@@ -4,6 +4,7 @@
 package bellatrix
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -140,7 +141,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 508 {
+	if o10 != 508 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -170,7 +171,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 	// Field (13) 'Transactions'
 	{
 		buf = tail[o13:]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		num, err := codecs.DecodeDynamicLength(buf, 1048576)
 		if err != nil {
 			return err
 		}
@@ -116,7 +116,7 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 536 {
+	if o10 != 536 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -0,0 +1,38 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"fmt"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/goccy/go-yaml"
+)
+
+// MaxValidatorRegistrations is the maximum number of registrations in a list of
+// signed validator registrations, as defined by VALIDATOR_REGISTRY_LIMIT.
+const MaxValidatorRegistrations = 1099511627776
+
+// SignedValidatorRegistrations is a list of signed validator registrations, as
+// submitted to builders.
+type SignedValidatorRegistrations []*apiv1.SignedValidatorRegistration
+
+// String returns a string version of the structure.
+func (s SignedValidatorRegistrations) String() string {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
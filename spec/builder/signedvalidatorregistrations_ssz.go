@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// signedValidatorRegistrationSize is the SSZ size of a signed validator registration.
+const signedValidatorRegistrationSize = 180
+
+// The SSZ functions below are hand-written, as sszgen only generates containers
+// and the list of registrations is serialized as a bare list.
+
+// MarshalSSZ ssz marshals the SignedValidatorRegistrations object
+func (s SignedValidatorRegistrations) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// MarshalSSZTo ssz marshals the SignedValidatorRegistrations object to a target array
+func (s SignedValidatorRegistrations) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	if size := len(s); size > MaxValidatorRegistrations {
+		err = ssz.ErrListTooBigFn("SignedValidatorRegistrations", size, MaxValidatorRegistrations)
+		return
+	}
+	for ii := 0; ii < len(s); ii++ {
+		if s[ii] == nil {
+			s[ii] = new(apiv1.SignedValidatorRegistration)
+		}
+		if dst, err = s[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedValidatorRegistrations object
+func (s *SignedValidatorRegistrations) UnmarshalSSZ(buf []byte) error {
+	num, err := ssz.DivideInt2(len(buf), signedValidatorRegistrationSize, MaxValidatorRegistrations)
+	if err != nil {
+		return err
+	}
+	*s = make(SignedValidatorRegistrations, num)
+	for ii := 0; ii < num; ii++ {
+		(*s)[ii] = new(apiv1.SignedValidatorRegistration)
+		if err = (*s)[ii].UnmarshalSSZ(buf[ii*signedValidatorRegistrationSize : (ii+1)*signedValidatorRegistrationSize]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedValidatorRegistrations object
+func (s SignedValidatorRegistrations) SizeSSZ() (size int) {
+	return len(s) * signedValidatorRegistrationSize
+}
+
+// HashTreeRoot ssz hashes the SignedValidatorRegistrations object
+func (s SignedValidatorRegistrations) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedValidatorRegistrations object with a hasher
+func (s SignedValidatorRegistrations) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	subIndx := hh.Index()
+	num := uint64(len(s))
+	if num > MaxValidatorRegistrations {
+		err = ssz.ErrIncorrectListSize
+		return
+	}
+	for _, elem := range s {
+		if err = elem.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, MaxValidatorRegistrations)
+
+	return
+}
+
+// GetTree ssz hashes the SignedValidatorRegistrations object
+func (s SignedValidatorRegistrations) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedExecutionPayloadHeader contains a versioned execution payload header,
+// as used in place of the execution payload by blinded blocks and builder bids.
+type VersionedExecutionPayloadHeader struct {
+	Version   spec.DataVersion
+	Bellatrix *bellatrix.ExecutionPayloadHeader
+	Capella   *capella.ExecutionPayloadHeader
+	Deneb     *deneb.ExecutionPayloadHeader
+}
+
+// IsEmpty returns true if there is no header.
+func (v *VersionedExecutionPayloadHeader) IsEmpty() bool {
+	return v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// ParentHash returns the parent hash of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) ParentHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix header")
+		}
+
+		return v.Bellatrix.ParentHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella header")
+		}
+
+		return v.Capella.ParentHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb header")
+		}
+
+		return v.Deneb.ParentHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// BlockHash returns the block hash of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockHash() (phase0.Hash32, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Hash32{}, errors.New("no bellatrix header")
+		}
+
+		return v.Bellatrix.BlockHash, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Hash32{}, errors.New("no capella header")
+		}
+
+		return v.Capella.BlockHash, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Hash32{}, errors.New("no deneb header")
+		}
+
+		return v.Deneb.BlockHash, nil
+	default:
+		return phase0.Hash32{}, errors.New("unsupported version")
+	}
+}
+
+// BlockNumber returns the block number of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) BlockNumber() (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix header")
+		}
+
+		return v.Bellatrix.BlockNumber, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella header")
+		}
+
+		return v.Capella.BlockNumber, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb header")
+		}
+
+		return v.Deneb.BlockNumber, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload header.
+func (v *VersionedExecutionPayloadHeader) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix header")
+		}
+
+		return v.Bellatrix.FeeRecipient, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no capella header")
+		}
+
+		return v.Capella.FeeRecipient, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no deneb header")
+		}
+
+		return v.Deneb.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unsupported version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedExecutionPayloadHeader) String() string {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+
+		return v.Bellatrix.String()
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+
+		return v.Capella.String()
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+
+		return v.Deneb.String()
+	default:
+		return "unsupported version"
+	}
+}
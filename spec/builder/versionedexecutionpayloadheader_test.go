@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/builder"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestVersionedExecutionPayloadHeader(t *testing.T) {
+	parentHash := phase0.Hash32{0x01}
+	blockHash := phase0.Hash32{0x02}
+	feeRecipient := bellatrix.ExecutionAddress{0x03}
+
+	tests := []struct {
+		name   string
+		header *builder.VersionedExecutionPayloadHeader
+		err    string
+	}{
+		{
+			name: "Missing",
+			header: &builder.VersionedExecutionPayloadHeader{
+				Version: spec.DataVersionCapella,
+			},
+			err: "no capella header",
+		},
+		{
+			name: "UnsupportedVersion",
+			header: &builder.VersionedExecutionPayloadHeader{
+				Version: spec.DataVersionPhase0,
+			},
+			err: "unsupported version",
+		},
+		{
+			name: "Bellatrix",
+			header: &builder.VersionedExecutionPayloadHeader{
+				Version: spec.DataVersionBellatrix,
+				Bellatrix: &bellatrix.ExecutionPayloadHeader{
+					ParentHash:   parentHash,
+					BlockHash:    blockHash,
+					FeeRecipient: feeRecipient,
+					BlockNumber:  10,
+				},
+			},
+		},
+		{
+			name: "Capella",
+			header: &builder.VersionedExecutionPayloadHeader{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.ExecutionPayloadHeader{
+					ParentHash:   parentHash,
+					BlockHash:    blockHash,
+					FeeRecipient: feeRecipient,
+					BlockNumber:  10,
+				},
+			},
+		},
+		{
+			name: "Deneb",
+			header: &builder.VersionedExecutionPayloadHeader{
+				Version: spec.DataVersionDeneb,
+				Deneb: &deneb.ExecutionPayloadHeader{
+					ParentHash:    parentHash,
+					BlockHash:     blockHash,
+					FeeRecipient:  feeRecipient,
+					BlockNumber:   10,
+					BaseFeePerGas: uint256.NewInt(7),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.header.ParentHash()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, parentHash, res)
+
+			res, err = test.header.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, blockHash, res)
+
+			recipient, err := test.header.FeeRecipient()
+			require.NoError(t, err)
+			require.Equal(t, feeRecipient, recipient)
+
+			number, err := test.header.BlockNumber()
+			require.NoError(t, err)
+			require.Equal(t, uint64(10), number)
+
+			// Ensure that the header survives an SSZ round trip.
+			sszData, err := test.header.MarshalSSZ()
+			require.NoError(t, err)
+			var sszRes builder.VersionedExecutionPayloadHeader
+			require.NoError(t, sszRes.UnmarshalSSZ(sszData))
+			require.Equal(t, test.header.Version, sszRes.Version)
+			res, err = sszRes.BlockHash()
+			require.NoError(t, err)
+			require.Equal(t, blockHash, res)
+		})
+	}
+}
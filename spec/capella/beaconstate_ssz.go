@@ -338,7 +338,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736653 {
+	if o7 != 2736653 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -16,6 +16,7 @@ package capella_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -120,6 +121,18 @@ func TestConsensusSpec(t *testing.T) {
 			name: "IndexedAttestation",
 			s:    &phase0.IndexedAttestation{},
 		},
+		{
+			name: "LightClientFinalityUpdate",
+			s:    &capella.LightClientFinalityUpdate{},
+		},
+		{
+			name: "LightClientHeader",
+			s:    &capella.LightClientHeader{},
+		},
+		{
+			name: "LightClientOptimisticUpdate",
+			s:    &capella.LightClientOptimisticUpdate{},
+		},
 		{
 			name: "PendingAttestation",
 			s:    &phase0.PendingAttestation{},
@@ -208,6 +221,12 @@ func TestConsensusSpec(t *testing.T) {
 					require.NoError(t, err)
 					require.Equal(t, specSSZ, remarshalledSpecSSZ)
 
+					// Confirm streamed decoding gives the same result, where supported.
+					if streamer, isStreamer := clone.Clone(test.s).(interface{ UnmarshalSSZFrom(io.Reader) error }); isStreamer {
+						require.NoError(t, streamer.UnmarshalSSZFrom(bytes.NewReader(specSSZ)))
+						require.Equal(t, s2, streamer)
+					}
+
 					// Obtain the hash tree root from the YAML.
 					specYAMLRoot, err := os.ReadFile(filepath.Join(path, "roots.yaml"))
 					require.NoError(t, err)
@@ -4,6 +4,7 @@
 package capella
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -156,7 +157,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 512 {
+	if o10 != 512 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -191,7 +192,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 	// Field (13) 'Transactions'
 	{
 		buf = tail[o13:o14]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		num, err := codecs.DecodeDynamicLength(buf, 1048576)
 		if err != nil {
 			return err
 		}
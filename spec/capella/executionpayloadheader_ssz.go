@@ -119,7 +119,7 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 568 {
+	if o10 != 568 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+func FuzzBLSToExecutionChangeUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.BLSToExecutionChange](f)
+}
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.BeaconBlock](f)
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.BeaconBlockBody](f)
+}
+
+func FuzzBeaconStateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.BeaconState](f)
+}
+
+func FuzzExecutionPayloadUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.ExecutionPayload](f)
+}
+
+func FuzzExecutionPayloadHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.ExecutionPayloadHeader](f)
+}
+
+func FuzzHistoricalSummaryUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.HistoricalSummary](f)
+}
+
+func FuzzLightClientFinalityUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.LightClientFinalityUpdate](f)
+}
+
+func FuzzLightClientHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.LightClientHeader](f)
+}
+
+func FuzzLightClientOptimisticUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.LightClientOptimisticUpdate](f)
+}
+
+func FuzzSignedBLSToExecutionChangeUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.SignedBLSToExecutionChange](f)
+}
+
+func FuzzSignedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.SignedBeaconBlock](f)
+}
+
+func FuzzWithdrawalUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[capella.Withdrawal](f)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// lightClientHeaderYAML is the spec representation of the struct.
+type lightClientHeaderYAML struct {
+	Beacon          *phase0.BeaconBlockHeader `yaml:"beacon"`
+	Execution       *ExecutionPayloadHeader   `yaml:"execution"`
+	ExecutionBranch []string                  `yaml:"execution_branch"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (h *LightClientHeader) MarshalYAML() ([]byte, error) {
+	executionBranch := make([]string, len(h.ExecutionBranch))
+	for i := range h.ExecutionBranch {
+		executionBranch[i] = h.ExecutionBranch[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&lightClientHeaderYAML{
+		Beacon:          h.Beacon,
+		Execution:       h.Execution,
+		ExecutionBranch: executionBranch,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (h *LightClientHeader) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var data lightClientHeaderJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return h.UnmarshalJSON(bytes)
+}
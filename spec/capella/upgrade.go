@@ -0,0 +1,104 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// UpgradeToCapella upgrades a bellatrix beacon state to a capella beacon state,
+// as per upgrade_to_capella in the consensus specification.
+//
+// The spec is a chain specification as returned by SpecProvider.Spec(), and must
+// contain SLOTS_PER_EPOCH and CAPELLA_FORK_VERSION.
+//
+// The returned state shares data with the supplied state, so the supplied state
+// should not be altered after this call.
+func UpgradeToCapella(pre *bellatrix.BeaconState, spec map[string]interface{}) (*BeaconState, error) {
+	if pre == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if pre.Fork == nil {
+		return nil, errors.New("state has no fork")
+	}
+	if pre.LatestExecutionPayloadHeader == nil {
+		return nil, errors.New("state has no latest execution payload header")
+	}
+
+	slotsPerEpoch, isUint := spec["SLOTS_PER_EPOCH"].(uint64)
+	if !isUint || slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH not found in spec")
+	}
+	forkVersion, isVersion := spec["CAPELLA_FORK_VERSION"].(phase0.Version)
+	if !isVersion {
+		return nil, errors.New("CAPELLA_FORK_VERSION not found in spec")
+	}
+
+	epoch := phase0.Epoch(uint64(pre.Slot) / slotsPerEpoch)
+
+	header := pre.LatestExecutionPayloadHeader
+
+	return &BeaconState{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &phase0.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  forkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:           pre.LatestBlockHeader,
+		BlockRoots:                  pre.BlockRoots,
+		StateRoots:                  pre.StateRoots,
+		HistoricalRoots:             pre.HistoricalRoots,
+		ETH1Data:                    pre.ETH1Data,
+		ETH1DataVotes:               pre.ETH1DataVotes,
+		ETH1DepositIndex:            pre.ETH1DepositIndex,
+		Validators:                  pre.Validators,
+		Balances:                    pre.Balances,
+		RANDAOMixes:                 pre.RANDAOMixes,
+		Slashings:                   pre.Slashings,
+		PreviousEpochParticipation:  pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:   pre.CurrentEpochParticipation,
+		JustificationBits:           pre.JustificationBits,
+		PreviousJustifiedCheckpoint: pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         pre.FinalizedCheckpoint,
+		InactivityScores:            pre.InactivityScores,
+		CurrentSyncCommittee:        pre.CurrentSyncCommittee,
+		NextSyncCommittee:           pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeader{
+			ParentHash:       header.ParentHash,
+			FeeRecipient:     header.FeeRecipient,
+			StateRoot:        header.StateRoot,
+			ReceiptsRoot:     header.ReceiptsRoot,
+			LogsBloom:        header.LogsBloom,
+			PrevRandao:       header.PrevRandao,
+			BlockNumber:      header.BlockNumber,
+			GasLimit:         header.GasLimit,
+			GasUsed:          header.GasUsed,
+			Timestamp:        header.Timestamp,
+			ExtraData:        header.ExtraData,
+			BaseFeePerGas:    header.BaseFeePerGas,
+			BlockHash:        header.BlockHash,
+			TransactionsRoot: header.TransactionsRoot,
+			WithdrawalsRoot:  phase0.Root{},
+		},
+		NextWithdrawalIndex:          0,
+		NextWithdrawalValidatorIndex: 0,
+		HistoricalSummaries:          make([]*HistoricalSummary, 0),
+	}, nil
+}
@@ -0,0 +1,176 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeToCapella(t *testing.T) {
+	spec := map[string]any{
+		"SLOTS_PER_EPOCH":      uint64(32),
+		"CAPELLA_FORK_VERSION": phase0.Version{0x03, 0x00, 0x00, 0x00},
+	}
+
+	tests := []struct {
+		name string
+		pre  *bellatrix.BeaconState
+		spec map[string]any
+		err  string
+	}{
+		{
+			name: "Nil",
+			spec: spec,
+			err:  "no state supplied",
+		},
+		{
+			name: "ForkMissing",
+			pre: &bellatrix.BeaconState{
+				LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
+			},
+			spec: spec,
+			err:  "state has no fork",
+		},
+		{
+			name: "LatestExecutionPayloadHeaderMissing",
+			pre: &bellatrix.BeaconState{
+				Fork: &phase0.Fork{},
+			},
+			spec: spec,
+			err:  "state has no latest execution payload header",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			pre: &bellatrix.BeaconState{
+				Fork:                         &phase0.Fork{},
+				LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
+			},
+			spec: map[string]any{
+				"CAPELLA_FORK_VERSION": phase0.Version{0x03, 0x00, 0x00, 0x00},
+			},
+			err: "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "ForkVersionMissing",
+			pre: &bellatrix.BeaconState{
+				Fork:                         &phase0.Fork{},
+				LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{},
+			},
+			spec: map[string]any{
+				"SLOTS_PER_EPOCH": uint64(32),
+			},
+			err: "CAPELLA_FORK_VERSION not found in spec",
+		},
+		{
+			name: "Good",
+			pre: &bellatrix.BeaconState{
+				Slot: 6400,
+				Fork: &phase0.Fork{
+					PreviousVersion: phase0.Version{0x01, 0x00, 0x00, 0x00},
+					CurrentVersion:  phase0.Version{0x02, 0x00, 0x00, 0x00},
+					Epoch:           100,
+				},
+				Balances: []phase0.Gwei{32000000000},
+				LatestExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{
+					BlockNumber:      10,
+					BaseFeePerGas:    [32]byte{0x07},
+					TransactionsRoot: phase0.Root{0x01},
+				},
+			},
+			spec: spec,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			post, err := capella.UpgradeToCapella(test.pre, test.spec)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.pre.Slot, post.Slot)
+			require.Equal(t, &phase0.Fork{
+				PreviousVersion: phase0.Version{0x02, 0x00, 0x00, 0x00},
+				CurrentVersion:  phase0.Version{0x03, 0x00, 0x00, 0x00},
+				Epoch:           200,
+			}, post.Fork)
+			require.Equal(t, test.pre.Balances, post.Balances)
+			require.Equal(t, uint64(10), post.LatestExecutionPayloadHeader.BlockNumber)
+			require.Equal(t, [32]byte{0x07}, post.LatestExecutionPayloadHeader.BaseFeePerGas)
+			require.Equal(t, phase0.Root{0x01}, post.LatestExecutionPayloadHeader.TransactionsRoot)
+			require.Equal(t, phase0.Root{}, post.LatestExecutionPayloadHeader.WithdrawalsRoot)
+			require.Equal(t, capella.WithdrawalIndex(0), post.NextWithdrawalIndex)
+			require.Equal(t, phase0.ValidatorIndex(0), post.NextWithdrawalValidatorIndex)
+			require.NotNil(t, post.HistoricalSummaries)
+			require.Empty(t, post.HistoricalSummaries)
+		})
+	}
+}
+
+// TestUpgradeToCapellaConsensusSpec tests the upgrade against the Ethereum consensus spec fork tests.
+func TestUpgradeToCapellaConsensusSpec(t *testing.T) {
+	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+
+	spec := map[string]any{
+		"SLOTS_PER_EPOCH":      uint64(32),
+		"CAPELLA_FORK_VERSION": phase0.Version{0x03, 0x00, 0x00, 0x00},
+	}
+
+	baseDir := filepath.Join(os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), "tests", "mainnet", "capella", "fork", "fork", "pyspec_tests")
+	entries, err := os.ReadDir(baseDir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		t.Run(entry.Name(), func(t *testing.T) {
+			pre := &bellatrix.BeaconState{}
+			require.NoError(t, pre.UnmarshalSSZ(testReadSnappy(t, filepath.Join(baseDir, entry.Name(), "pre.ssz_snappy"))))
+			expected := &capella.BeaconState{}
+			require.NoError(t, expected.UnmarshalSSZ(testReadSnappy(t, filepath.Join(baseDir, entry.Name(), "post.ssz_snappy"))))
+
+			post, err := capella.UpgradeToCapella(pre, spec)
+			require.NoError(t, err)
+
+			expectedRoot, err := expected.HashTreeRoot()
+			require.NoError(t, err)
+			postRoot, err := post.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("%#x", expectedRoot), fmt.Sprintf("%#x", postRoot))
+		})
+	}
+}
+
+func testReadSnappy(t *testing.T, path string) []byte {
+	t.Helper()
+
+	compressed, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data, err := snappy.Decode(nil, compressed)
+	require.NoError(t, err)
+
+	return data
+}
@@ -4,6 +4,7 @@
 package deneb
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	ssz "github.com/ferranbt/fastssz"
@@ -55,6 +56,9 @@ func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	offset += len(e.ExtraData)
 
 	// Field (11) 'BaseFeePerGas'
+	if e.BaseFeePerGas == nil {
+		e.BaseFeePerGas = new(uint256.Int)
+	}
 	baseFeePerGas := e.BaseFeePerGas.Bytes32()
 	for i := 0; i < 32; i++ {
 		dst = append(dst, baseFeePerGas[31-i])
@@ -167,7 +171,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 528 {
+	if o10 != 528 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -213,7 +217,7 @@ func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
 	// Field (13) 'Transactions'
 	{
 		buf = tail[o13:o14]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		num, err := codecs.DecodeDynamicLength(buf, 1048576)
 		if err != nil {
 			return err
 		}
@@ -325,6 +329,9 @@ func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 
 	// Field (11) 'BaseFeePerGas'
 	baseFeePerGas := make([]byte, 32)
+	if e.BaseFeePerGas == nil {
+		e.BaseFeePerGas = new(uint256.Int)
+	}
 	baseFeePerGasBE := e.BaseFeePerGas.Bytes32()
 	for i := 0; i < 32; i++ {
 		baseFeePerGas[i] = baseFeePerGasBE[31-i]
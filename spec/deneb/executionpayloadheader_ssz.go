@@ -53,6 +53,9 @@ func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error
 	offset += len(e.ExtraData)
 
 	// Field (11) 'BaseFeePerGas'
+	if e.BaseFeePerGas == nil {
+		e.BaseFeePerGas = new(uint256.Int)
+	}
 	baseFeePerGas := e.BaseFeePerGas.Bytes32()
 	for i := 0; i < 32; i++ {
 		dst = append(dst, baseFeePerGas[31-i])
@@ -129,7 +132,7 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 584 {
+	if o10 != 584 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -234,6 +237,9 @@ func (e *ExecutionPayloadHeader) HashTreeRootWith(hh ssz.HashWalker) (err error)
 
 	// Field (11) 'BaseFeePerGas'
 	baseFeePerGas := make([]byte, 32)
+	if e.BaseFeePerGas == nil {
+		e.BaseFeePerGas = new(uint256.Int)
+	}
 	baseFeePerGasBE := e.BaseFeePerGas.Bytes32()
 	for i := 0; i < 32; i++ {
 		baseFeePerGas[i] = baseFeePerGasBE[31-i]
@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+)
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BeaconBlock](f)
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BeaconBlockBody](f)
+}
+
+func FuzzBeaconStateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BeaconState](f)
+}
+
+func FuzzBlobIdentifierUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlobIdentifier](f)
+}
+
+func FuzzBlobSidecarUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.BlobSidecar](f)
+}
+
+func FuzzExecutionPayloadUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.ExecutionPayload](f)
+}
+
+func FuzzExecutionPayloadHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.ExecutionPayloadHeader](f)
+}
+
+func FuzzLightClientFinalityUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.LightClientFinalityUpdate](f)
+}
+
+func FuzzLightClientHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.LightClientHeader](f)
+}
+
+func FuzzLightClientOptimisticUpdateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.LightClientOptimisticUpdate](f)
+}
+
+func FuzzSignedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBeaconBlock](f)
+}
+
+func FuzzSignedBlobSidecarUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[deneb.SignedBlobSidecar](f)
+}
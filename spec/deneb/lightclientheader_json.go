@@ -0,0 +1,69 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// executionBranchLength is the number of roots in the execution branch of a light client header.
+const executionBranchLength = 4
+
+// lightClientHeaderJSON is the spec representation of the struct.
+type lightClientHeaderJSON struct {
+	Beacon          *phase0.BeaconBlockHeader `json:"beacon"`
+	Execution       *ExecutionPayloadHeader   `json:"execution"`
+	ExecutionBranch []phase0.Root             `json:"execution_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *LightClientHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientHeaderJSON{
+		Beacon:          h.Beacon,
+		Execution:       h.Execution,
+		ExecutionBranch: h.ExecutionBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *LightClientHeader) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&lightClientHeaderJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	h.Beacon = &phase0.BeaconBlockHeader{}
+	if err := h.Beacon.UnmarshalJSON(raw["beacon"]); err != nil {
+		return errors.Wrap(err, "beacon")
+	}
+
+	h.Execution = &ExecutionPayloadHeader{}
+	if err := h.Execution.UnmarshalJSON(raw["execution"]); err != nil {
+		return errors.Wrap(err, "execution")
+	}
+
+	if err := json.Unmarshal(raw["execution_branch"], &h.ExecutionBranch); err != nil {
+		return errors.Wrap(err, "execution_branch")
+	}
+	if len(h.ExecutionBranch) != executionBranchLength {
+		return fmt.Errorf("execution_branch: incorrect length %d", len(h.ExecutionBranch))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestAttestationJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "AggregationBitsMissing",
+			input: []byte(`{"data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: missing",
+		},
+		{
+			name:  "AggregationBitsWrongType",
+			input: []byte(`{"aggregation_bits":true,"data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: invalid prefix",
+		},
+		{
+			name:  "AggregationBitsInvalid",
+			input: []byte(`{"aggregation_bits":"0xzz","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "DataMissing",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x0500000000000000"}`),
+			err:   "data: missing",
+		},
+		{
+			name:  "SignatureMissing",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"committee_bits":"0x0500000000000000"}`),
+			err:   "signature: missing",
+		},
+		{
+			name:  "SignatureShort",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a","committee_bits":"0x0500000000000000"}`),
+			err:   "signature: incorrect length",
+		},
+		{
+			name:  "CommitteeBitsMissing",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`),
+			err:   "committee_bits: missing",
+		},
+		{
+			name:  "CommitteeBitsWrongType",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":true}`),
+			err:   "committee_bits: invalid prefix",
+		},
+		{
+			name:  "CommitteeBitsShort",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x05000000000000"}`),
+			err:   "committee_bits: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"aggregation_bits":"0x0a0f01","data":{"slot":"66","index":"0","beacon_block_root":"0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37","source":{"epoch":"1","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1"},"target":{"epoch":"2","root":"0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2"}},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b","committee_bits":"0x0500000000000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.Attestation
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestAttestationYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{aggregation_bits: '0x0a0f01', data: {slot: 66, index: 0, beacon_block_root: '0x737b2949b471552a7f35fcd4c1b0ee2be3e2cc1b21eb3b3c0ba0f54b6a78ae37', source: {epoch: 1, root: '0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e1'}, target: {epoch: 2, root: '0xab0387b2f8c9d4c8b5dcea85fb3e69e5d2b8c7c53c1a5b1b3f4ae9d0c0d6f5e2'}}, signature: '0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b', committee_bits: '0x0500000000000000'}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.Attestation
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, testYAMLFormat([]byte(res.String())), testYAMLFormat(rt))
+				assert.Equal(t, testYAMLFormat(test.input), testYAMLFormat(rt))
+			}
+		})
+	}
+}
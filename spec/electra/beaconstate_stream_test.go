@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testStreamState() *electra.BeaconState {
+	syncCommittee := &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, 512),
+	}
+
+	return &electra.BeaconState{
+		GenesisTime:       1606824023,
+		Slot:              12345,
+		Fork:              &phase0.Fork{},
+		LatestBlockHeader: &phase0.BeaconBlockHeader{},
+		BlockRoots:        make([]phase0.Root, 8192),
+		StateRoots:        make([]phase0.Root, 8192),
+		HistoricalRoots:   []phase0.Root{{0x01}, {0x02}},
+		ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes: []*phase0.ETH1Data{
+			{BlockHash: make([]byte, 32), DepositCount: 3},
+		},
+		Validators: []*phase0.Validator{
+			{
+				PublicKey:             phase0.BLSPubKey{0x01},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+			},
+			{
+				PublicKey:             phase0.BLSPubKey{0x02},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      31000000000,
+				Slashed:               true,
+			},
+		},
+		Balances:                    []phase0.Gwei{32000000001, 31000000002},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		PreviousEpochParticipation:  []altair.ParticipationFlags{0x07, 0x03},
+		CurrentEpochParticipation:   []altair.ParticipationFlags{0x01, 0x00},
+		JustificationBits:           bitfield.Bitvector4{0x0f},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+		InactivityScores:            []uint64{0, 4},
+		CurrentSyncCommittee:        syncCommittee,
+		NextSyncCommittee:           syncCommittee,
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			LogsBloom:     [256]byte{},
+			ExtraData:     []byte{0x01, 0x02},
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+		HistoricalSummaries: []*capella.HistoricalSummary{{}},
+		PendingDeposits: []*electra.PendingDeposit{
+			{
+				Pubkey:                phase0.BLSPubKey{0x03},
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                1000000000,
+			},
+		},
+		PendingPartialWithdrawals: []*electra.PendingPartialWithdrawal{
+			{ValidatorIndex: 1, Amount: 2},
+		},
+		PendingConsolidations: []*electra.PendingConsolidation{
+			{SourceIndex: 0, TargetIndex: 1},
+		},
+	}
+}
+
+func TestBeaconStateUnmarshalSSZFrom(t *testing.T) {
+	input, err := testStreamState().MarshalSSZ()
+	require.NoError(t, err)
+
+	expected := &electra.BeaconState{}
+	require.NoError(t, expected.UnmarshalSSZ(input))
+
+	state := &electra.BeaconState{}
+	require.NoError(t, state.UnmarshalSSZFrom(bytes.NewReader(input)))
+	require.Equal(t, expected, state)
+
+	output, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, input, output)
+}
+
+func TestBeaconStateUnmarshalSSZFromErrors(t *testing.T) {
+	input, err := testStreamState().MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{
+			name: "Empty",
+			err:  ssz.ErrSize,
+		},
+		{
+			name:  "FixedPartShort",
+			input: input[:2736712],
+			err:   ssz.ErrSize,
+		},
+		{
+			// Truncated part way through the validators.
+			name:  "ValidatorsShort",
+			input: input[:2736713+32*2+72+121+60],
+			err:   ssz.ErrSize,
+		},
+		{
+			// Truncated before the pending partial withdrawals.
+			name:  "PendingPartialWithdrawalsMissing",
+			input: input[:len(input)-40],
+			err:   ssz.ErrOffset,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := &electra.BeaconState{}
+			require.ErrorIs(t, state.UnmarshalSSZFrom(bytes.NewReader(test.input)), test.err)
+		})
+	}
+}
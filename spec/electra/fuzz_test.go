@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+func FuzzAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.Attestation](f)
+}
+
+func FuzzAttesterSlashingUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.AttesterSlashing](f)
+}
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.BeaconBlock](f)
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.BeaconBlockBody](f)
+}
+
+func FuzzBeaconStateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.BeaconState](f)
+}
+
+func FuzzConsolidationRequestUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.ConsolidationRequest](f)
+}
+
+func FuzzDepositRequestUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.DepositRequest](f)
+}
+
+func FuzzExecutionRequestsUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.ExecutionRequests](f)
+}
+
+func FuzzIndexedAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.IndexedAttestation](f)
+}
+
+func FuzzPendingConsolidationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.PendingConsolidation](f)
+}
+
+func FuzzPendingDepositUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.PendingDeposit](f)
+}
+
+func FuzzPendingPartialWithdrawalUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.PendingPartialWithdrawal](f)
+}
+
+func FuzzSignedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.SignedBeaconBlock](f)
+}
+
+func FuzzSingleAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.SingleAttestation](f)
+}
+
+func FuzzWithdrawalRequestUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[electra.WithdrawalRequest](f)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// indexedAttestationJSON is the spec representation of the struct.
+type indexedAttestationJSON struct {
+	AttestingIndices []string                `json:"attesting_indices"`
+	Data             *phase0.AttestationData `json:"data"`
+	Signature        phase0.BLSSignature     `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i *IndexedAttestation) MarshalJSON() ([]byte, error) {
+	attestingIndices := make([]string, len(i.AttestingIndices))
+	for j := range i.AttestingIndices {
+		attestingIndices[j] = fmt.Sprintf("%d", i.AttestingIndices[j])
+	}
+
+	return json.Marshal(&indexedAttestationJSON{
+		AttestingIndices: attestingIndices,
+		Data:             i.Data,
+		Signature:        i.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *IndexedAttestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&indexedAttestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	attestingIndices := make([]string, 0)
+	if err := json.Unmarshal(raw["attesting_indices"], &attestingIndices); err != nil {
+		return errors.Wrap(err, "attesting_indices")
+	}
+	i.AttestingIndices = make([]uint64, len(attestingIndices))
+	for j := range attestingIndices {
+		if i.AttestingIndices[j], err = strconv.ParseUint(attestingIndices[j], 10, 64); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("attesting_indices: invalid value for index %d", j))
+		}
+	}
+
+	i.Data = &phase0.AttestationData{}
+	if err := i.Data.UnmarshalJSON(raw["data"]); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if err := i.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
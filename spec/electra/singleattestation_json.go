@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// singleAttestationJSON is the spec representation of the struct.
+type singleAttestationJSON struct {
+	CommitteeIndex string                  `json:"committee_index"`
+	AttesterIndex  phase0.ValidatorIndex   `json:"attester_index"`
+	Data           *phase0.AttestationData `json:"data"`
+	Signature      phase0.BLSSignature     `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SingleAttestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&singleAttestationJSON{
+		CommitteeIndex: fmt.Sprintf("%d", s.CommitteeIndex),
+		AttesterIndex:  s.AttesterIndex,
+		Data:           s.Data,
+		Signature:      s.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SingleAttestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&singleAttestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	committeeIndex := string(bytes.Trim(raw["committee_index"], `"`))
+	tmp, err := strconv.ParseUint(committeeIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "committee_index")
+	}
+	s.CommitteeIndex = phase0.CommitteeIndex(tmp)
+
+	if err := s.AttesterIndex.UnmarshalJSON(raw["attester_index"]); err != nil {
+		return errors.Wrap(err, "attester_index")
+	}
+
+	s.Data = &phase0.AttestationData{}
+	if err := s.Data.UnmarshalJSON(raw["data"]); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if err := s.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	return nil
+}
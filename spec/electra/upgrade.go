@@ -0,0 +1,263 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	farFutureEpoch                 = phase0.Epoch(0xffffffffffffffff)
+	unsetDepositRequestsStartIndex = uint64(0xffffffffffffffff)
+	compoundingWithdrawalPrefix    = byte(0x02)
+	genesisSlot                    = phase0.Slot(0)
+)
+
+// g2PointAtInfinity is the BLS signature used as a placeholder for pending deposits
+// created by the upgrade.
+var g2PointAtInfinity = phase0.BLSSignature{0xc0}
+
+// upgradeSpec holds the chain specification values required to upgrade to electra.
+type upgradeSpec struct {
+	slotsPerEpoch                       uint64
+	forkVersion                         phase0.Version
+	maxSeedLookahead                    uint64
+	minPerEpochChurnLimit               phase0.Gwei
+	churnLimitQuotient                  uint64
+	maxPerEpochActivationExitChurnLimit phase0.Gwei
+	effectiveBalanceIncrement           phase0.Gwei
+	minActivationBalance                phase0.Gwei
+}
+
+// UpgradeToElectra upgrades a deneb beacon state to an electra beacon state,
+// as per upgrade_to_electra in the consensus specification.
+//
+// The spec is a chain specification as returned by SpecProvider.Spec(), and must
+// contain SLOTS_PER_EPOCH, ELECTRA_FORK_VERSION, MAX_SEED_LOOKAHEAD,
+// MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA, CHURN_LIMIT_QUOTIENT,
+// MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT, EFFECTIVE_BALANCE_INCREMENT and
+// MIN_ACTIVATION_BALANCE.
+//
+// Validators and balances are copied, as the upgrade alters them.  All other
+// data is shared with the supplied state, so the supplied state should not be
+// altered after this call.
+func UpgradeToElectra(pre *deneb.BeaconState, spec map[string]interface{}) (*BeaconState, error) {
+	if pre == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if pre.Fork == nil {
+		return nil, errors.New("state has no fork")
+	}
+	if len(pre.Balances) != len(pre.Validators) {
+		return nil, errors.Errorf("state has %d validators but %d balances", len(pre.Validators), len(pre.Balances))
+	}
+
+	config, err := parseUpgradeSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := phase0.Epoch(uint64(pre.Slot) / config.slotsPerEpoch)
+	activationExitEpoch := epoch + 1 + phase0.Epoch(config.maxSeedLookahead)
+
+	validators := make([]*phase0.Validator, len(pre.Validators))
+	for i := range pre.Validators {
+		if pre.Validators[i] == nil {
+			return nil, errors.Errorf("validator %d missing", i)
+		}
+		validator := *pre.Validators[i]
+		validators[i] = &validator
+	}
+	balances := make([]phase0.Gwei, len(pre.Balances))
+	copy(balances, pre.Balances)
+
+	earliestExitEpoch := activationExitEpoch
+	for _, validator := range validators {
+		if validator.ExitEpoch != farFutureEpoch && validator.ExitEpoch > earliestExitEpoch {
+			earliestExitEpoch = validator.ExitEpoch
+		}
+	}
+	earliestExitEpoch++
+
+	post := &BeaconState{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &phase0.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  config.forkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:             pre.LatestBlockHeader,
+		BlockRoots:                    pre.BlockRoots,
+		StateRoots:                    pre.StateRoots,
+		HistoricalRoots:               pre.HistoricalRoots,
+		ETH1Data:                      pre.ETH1Data,
+		ETH1DataVotes:                 pre.ETH1DataVotes,
+		ETH1DepositIndex:              pre.ETH1DepositIndex,
+		Validators:                    validators,
+		Balances:                      balances,
+		RANDAOMixes:                   pre.RANDAOMixes,
+		Slashings:                     pre.Slashings,
+		PreviousEpochParticipation:    pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:     pre.CurrentEpochParticipation,
+		JustificationBits:             pre.JustificationBits,
+		PreviousJustifiedCheckpoint:   pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:    pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:           pre.FinalizedCheckpoint,
+		InactivityScores:              pre.InactivityScores,
+		CurrentSyncCommittee:          pre.CurrentSyncCommittee,
+		NextSyncCommittee:             pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader:  pre.LatestExecutionPayloadHeader,
+		NextWithdrawalIndex:           pre.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex:  pre.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:           pre.HistoricalSummaries,
+		DepositRequestsStartIndex:     unsetDepositRequestsStartIndex,
+		DepositBalanceToConsume:       0,
+		ExitBalanceToConsume:          0,
+		EarliestExitEpoch:             earliestExitEpoch,
+		ConsolidationBalanceToConsume: 0,
+		EarliestConsolidationEpoch:    activationExitEpoch,
+		PendingDeposits:               make([]*PendingDeposit, 0),
+		PendingPartialWithdrawals:     make([]*PendingPartialWithdrawal, 0),
+		PendingConsolidations:         make([]*PendingConsolidation, 0),
+	}
+
+	balanceChurnLimit := config.balanceChurnLimit(post, epoch)
+	activationExitChurnLimit := balanceChurnLimit
+	if config.maxPerEpochActivationExitChurnLimit < activationExitChurnLimit {
+		activationExitChurnLimit = config.maxPerEpochActivationExitChurnLimit
+	}
+	post.ExitBalanceToConsume = activationExitChurnLimit
+	post.ConsolidationBalanceToConsume = balanceChurnLimit - activationExitChurnLimit
+
+	// Move the balances of validators that are not yet active to pending deposits.
+	preActivation := make([]int, 0)
+	for i, validator := range validators {
+		if validator.ActivationEpoch == farFutureEpoch {
+			preActivation = append(preActivation, i)
+		}
+	}
+	sort.SliceStable(preActivation, func(i, j int) bool {
+		return validators[preActivation[i]].ActivationEligibilityEpoch < validators[preActivation[j]].ActivationEligibilityEpoch
+	})
+	for _, index := range preActivation {
+		validator := validators[index]
+		post.PendingDeposits = append(post.PendingDeposits, &PendingDeposit{
+			Pubkey:                validator.PublicKey,
+			WithdrawalCredentials: validator.WithdrawalCredentials,
+			Amount:                balances[index],
+			Signature:             g2PointAtInfinity,
+			Slot:                  genesisSlot,
+		})
+		balances[index] = 0
+		validator.EffectiveBalance = 0
+		validator.ActivationEligibilityEpoch = farFutureEpoch
+	}
+
+	// Ensure early adopters of compounding credentials go through the activation churn.
+	for index, validator := range validators {
+		if len(validator.WithdrawalCredentials) == 0 || validator.WithdrawalCredentials[0] != compoundingWithdrawalPrefix {
+			continue
+		}
+		if balances[index] <= config.minActivationBalance {
+			continue
+		}
+		post.PendingDeposits = append(post.PendingDeposits, &PendingDeposit{
+			Pubkey:                validator.PublicKey,
+			WithdrawalCredentials: validator.WithdrawalCredentials,
+			Amount:                balances[index] - config.minActivationBalance,
+			Signature:             g2PointAtInfinity,
+			Slot:                  genesisSlot,
+		})
+		balances[index] = config.minActivationBalance
+	}
+
+	return post, nil
+}
+
+// balanceChurnLimit returns the balance churn limit of the state at the given epoch,
+// as per get_balance_churn_limit in the consensus specification.
+func (u *upgradeSpec) balanceChurnLimit(state *BeaconState, epoch phase0.Epoch) phase0.Gwei {
+	totalActiveBalance := phase0.Gwei(0)
+	for _, validator := range state.Validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			totalActiveBalance += validator.EffectiveBalance
+		}
+	}
+	if totalActiveBalance < u.effectiveBalanceIncrement {
+		totalActiveBalance = u.effectiveBalanceIncrement
+	}
+
+	churn := totalActiveBalance / phase0.Gwei(u.churnLimitQuotient)
+	if churn < u.minPerEpochChurnLimit {
+		churn = u.minPerEpochChurnLimit
+	}
+
+	return churn - churn%u.effectiveBalanceIncrement
+}
+
+// parseUpgradeSpec obtains the values required to upgrade to electra from the spec.
+func parseUpgradeSpec(spec map[string]interface{}) (*upgradeSpec, error) {
+	config := &upgradeSpec{}
+
+	forkVersion, isVersion := spec["ELECTRA_FORK_VERSION"].(phase0.Version)
+	if !isVersion {
+		return nil, errors.New("ELECTRA_FORK_VERSION not found in spec")
+	}
+	config.forkVersion = forkVersion
+
+	uint64Values := map[string]*uint64{
+		"SLOTS_PER_EPOCH":      &config.slotsPerEpoch,
+		"MAX_SEED_LOOKAHEAD":   &config.maxSeedLookahead,
+		"CHURN_LIMIT_QUOTIENT": &config.churnLimitQuotient,
+	}
+	for k, v := range uint64Values {
+		val, isUint := spec[k].(uint64)
+		if !isUint {
+			return nil, errors.Errorf("%s not found in spec", k)
+		}
+		*v = val
+	}
+
+	gweiValues := map[string]*phase0.Gwei{
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         &config.minPerEpochChurnLimit,
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": &config.maxPerEpochActivationExitChurnLimit,
+		"EFFECTIVE_BALANCE_INCREMENT":               &config.effectiveBalanceIncrement,
+		"MIN_ACTIVATION_BALANCE":                    &config.minActivationBalance,
+	}
+	for k, v := range gweiValues {
+		val, isUint := spec[k].(uint64)
+		if !isUint {
+			return nil, errors.Errorf("%s not found in spec", k)
+		}
+		*v = phase0.Gwei(val)
+	}
+
+	if config.slotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH cannot be 0")
+	}
+	if config.churnLimitQuotient == 0 {
+		return nil, errors.New("CHURN_LIMIT_QUOTIENT cannot be 0")
+	}
+	if config.effectiveBalanceIncrement == 0 {
+		return nil, errors.New("EFFECTIVE_BALANCE_INCREMENT cannot be 0")
+	}
+
+	return config, nil
+}
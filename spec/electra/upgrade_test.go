@@ -0,0 +1,300 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// testMainnetUpgradeSpec returns the mainnet spec values required to upgrade to electra.
+func testMainnetUpgradeSpec() map[string]any {
+	return map[string]any{
+		"SLOTS_PER_EPOCH":                           uint64(32),
+		"ELECTRA_FORK_VERSION":                      phase0.Version{0x05, 0x00, 0x00, 0x00},
+		"MAX_SEED_LOOKAHEAD":                        uint64(4),
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         uint64(128000000000),
+		"CHURN_LIMIT_QUOTIENT":                      uint64(65536),
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": uint64(256000000000),
+		"EFFECTIVE_BALANCE_INCREMENT":               uint64(1000000000),
+		"MIN_ACTIVATION_BALANCE":                    uint64(32000000000),
+	}
+}
+
+func TestUpgradeToElectraErrors(t *testing.T) {
+	missingChurnLimitSpec := testMainnetUpgradeSpec()
+	delete(missingChurnLimitSpec, "MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA")
+	missingForkVersionSpec := testMainnetUpgradeSpec()
+	delete(missingForkVersionSpec, "ELECTRA_FORK_VERSION")
+	zeroSlotsPerEpochSpec := testMainnetUpgradeSpec()
+	zeroSlotsPerEpochSpec["SLOTS_PER_EPOCH"] = uint64(0)
+
+	tests := []struct {
+		name string
+		pre  *deneb.BeaconState
+		spec map[string]any
+		err  string
+	}{
+		{
+			name: "Nil",
+			spec: testMainnetUpgradeSpec(),
+			err:  "no state supplied",
+		},
+		{
+			name: "ForkMissing",
+			pre:  &deneb.BeaconState{},
+			spec: testMainnetUpgradeSpec(),
+			err:  "state has no fork",
+		},
+		{
+			name: "BalancesMismatch",
+			pre: &deneb.BeaconState{
+				Fork:       &phase0.Fork{},
+				Validators: []*phase0.Validator{{}},
+			},
+			spec: testMainnetUpgradeSpec(),
+			err:  "state has 1 validators but 0 balances",
+		},
+		{
+			name: "ValidatorMissing",
+			pre: &deneb.BeaconState{
+				Fork:       &phase0.Fork{},
+				Validators: []*phase0.Validator{nil},
+				Balances:   []phase0.Gwei{0},
+			},
+			spec: testMainnetUpgradeSpec(),
+			err:  "validator 0 missing",
+		},
+		{
+			name: "ForkVersionMissing",
+			pre: &deneb.BeaconState{
+				Fork: &phase0.Fork{},
+			},
+			spec: missingForkVersionSpec,
+			err:  "ELECTRA_FORK_VERSION not found in spec",
+		},
+		{
+			name: "ChurnLimitMissing",
+			pre: &deneb.BeaconState{
+				Fork: &phase0.Fork{},
+			},
+			spec: missingChurnLimitSpec,
+			err:  "MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA not found in spec",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			pre: &deneb.BeaconState{
+				Fork: &phase0.Fork{},
+			},
+			spec: zeroSlotsPerEpochSpec,
+			err:  "SLOTS_PER_EPOCH cannot be 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := electra.UpgradeToElectra(test.pre, test.spec)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestUpgradeToElectra(t *testing.T) {
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+	eth1Credentials := append([]byte{0x01}, make([]byte, 31)...)
+	compoundingCredentials := append([]byte{0x02}, make([]byte, 31)...)
+
+	pre := &deneb.BeaconState{
+		Slot: 6400,
+		Fork: &phase0.Fork{
+			PreviousVersion: phase0.Version{0x03, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x04, 0x00, 0x00, 0x00},
+			Epoch:           100,
+		},
+		Validators: []*phase0.Validator{
+			// Active.
+			{
+				PublicKey:                  phase0.BLSPubKey{0x00},
+				WithdrawalCredentials:      eth1Credentials,
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 0,
+				ActivationEpoch:            0,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			// Active with compounding credentials and excess balance.
+			{
+				PublicKey:                  phase0.BLSPubKey{0x01},
+				WithdrawalCredentials:      compoundingCredentials,
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 0,
+				ActivationEpoch:            0,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			// Pending activation.
+			{
+				PublicKey:                  phase0.BLSPubKey{0x02},
+				WithdrawalCredentials:      eth1Credentials,
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 150,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			// Pending activation, eligible earlier.
+			{
+				PublicKey:                  phase0.BLSPubKey{0x03},
+				WithdrawalCredentials:      eth1Credentials,
+				EffectiveBalance:           17000000000,
+				ActivationEligibilityEpoch: 100,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			// Exiting.
+			{
+				PublicKey:                  phase0.BLSPubKey{0x04},
+				WithdrawalCredentials:      eth1Credentials,
+				EffectiveBalance:           32000000000,
+				ActivationEligibilityEpoch: 0,
+				ActivationEpoch:            0,
+				ExitEpoch:                  300,
+				WithdrawableEpoch:          556,
+			},
+		},
+		Balances: []phase0.Gwei{
+			32000000000,
+			40000000000,
+			32000000000,
+			17000000000,
+			32000000000,
+		},
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			BlockNumber: 10,
+		},
+		NextWithdrawalIndex: 5,
+	}
+
+	post, err := electra.UpgradeToElectra(pre, testMainnetUpgradeSpec())
+	require.NoError(t, err)
+
+	require.Equal(t, &phase0.Fork{
+		PreviousVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+		CurrentVersion:  phase0.Version{0x05, 0x00, 0x00, 0x00},
+		Epoch:           200,
+	}, post.Fork)
+	require.Equal(t, pre.LatestExecutionPayloadHeader, post.LatestExecutionPayloadHeader)
+	require.Equal(t, pre.NextWithdrawalIndex, post.NextWithdrawalIndex)
+	require.Equal(t, uint64(0xffffffffffffffff), post.DepositRequestsStartIndex)
+	require.Equal(t, phase0.Gwei(0), post.DepositBalanceToConsume)
+	require.Equal(t, phase0.Epoch(301), post.EarliestExitEpoch)
+	require.Equal(t, phase0.Epoch(205), post.EarliestConsolidationEpoch)
+	require.Equal(t, phase0.Gwei(128000000000), post.ExitBalanceToConsume)
+	require.Equal(t, phase0.Gwei(0), post.ConsolidationBalanceToConsume)
+	require.Empty(t, post.PendingPartialWithdrawals)
+	require.Empty(t, post.PendingConsolidations)
+
+	require.Equal(t, []phase0.Gwei{
+		32000000000,
+		32000000000,
+		0,
+		0,
+		32000000000,
+	}, post.Balances)
+	for _, index := range []int{2, 3} {
+		require.Equal(t, phase0.Gwei(0), post.Validators[index].EffectiveBalance)
+		require.Equal(t, farFutureEpoch, post.Validators[index].ActivationEligibilityEpoch)
+	}
+
+	infinity := phase0.BLSSignature{0xc0}
+	require.Equal(t, []*electra.PendingDeposit{
+		{
+			Pubkey:                phase0.BLSPubKey{0x03},
+			WithdrawalCredentials: eth1Credentials,
+			Amount:                17000000000,
+			Signature:             infinity,
+			Slot:                  0,
+		},
+		{
+			Pubkey:                phase0.BLSPubKey{0x02},
+			WithdrawalCredentials: eth1Credentials,
+			Amount:                32000000000,
+			Signature:             infinity,
+			Slot:                  0,
+		},
+		{
+			Pubkey:                phase0.BLSPubKey{0x01},
+			WithdrawalCredentials: compoundingCredentials,
+			Amount:                8000000000,
+			Signature:             infinity,
+			Slot:                  0,
+		},
+	}, post.PendingDeposits)
+
+	// Ensure the supplied state has not been altered.
+	require.Equal(t, phase0.Gwei(40000000000), pre.Balances[1])
+	require.Equal(t, phase0.Gwei(17000000000), pre.Validators[3].EffectiveBalance)
+	require.Equal(t, phase0.Epoch(100), pre.Validators[3].ActivationEligibilityEpoch)
+}
+
+// TestUpgradeToElectraConsensusSpec tests the upgrade against the Ethereum consensus spec fork tests.
+func TestUpgradeToElectraConsensusSpec(t *testing.T) {
+	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+
+	baseDir := filepath.Join(os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), "tests", "mainnet", "electra", "fork", "fork", "pyspec_tests")
+	entries, err := os.ReadDir(baseDir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		t.Run(entry.Name(), func(t *testing.T) {
+			pre := &deneb.BeaconState{}
+			require.NoError(t, pre.UnmarshalSSZ(testReadSnappy(t, filepath.Join(baseDir, entry.Name(), "pre.ssz_snappy"))))
+			expected := &electra.BeaconState{}
+			require.NoError(t, expected.UnmarshalSSZ(testReadSnappy(t, filepath.Join(baseDir, entry.Name(), "post.ssz_snappy"))))
+
+			post, err := electra.UpgradeToElectra(pre, testMainnetUpgradeSpec())
+			require.NoError(t, err)
+
+			expectedRoot, err := expected.HashTreeRoot()
+			require.NoError(t, err)
+			postRoot, err := post.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("%#x", expectedRoot), fmt.Sprintf("%#x", postRoot))
+		})
+	}
+}
+
+func testReadSnappy(t *testing.T, path string) []byte {
+	t.Helper()
+
+	compressed, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data, err := snappy.Decode(nil, compressed)
+	require.NoError(t, err)
+
+	return data
+}
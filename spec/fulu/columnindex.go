@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ColumnIndex is the index of a data column.
+type ColumnIndex uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ColumnIndex) UnmarshalJSON(input []byte) error {
+	if len(input) == 0 {
+		return errors.New("input missing")
+	}
+
+	if !bytes.HasPrefix(input, []byte{'"'}) {
+		return errors.New("invalid prefix")
+	}
+	if !bytes.HasSuffix(input, []byte{'"'}) {
+		return errors.New("invalid suffix")
+	}
+
+	val, err := strconv.ParseUint(string(input[1:len(input)-1]), 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid value %s", string(input[1:len(input)-1]))
+	}
+	*c = ColumnIndex(val)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ColumnIndex) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return nil, errors.New("value nil")
+	}
+	return []byte(fmt.Sprintf(`"%d"`, *c)), nil
+}
@@ -0,0 +1,232 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/fulu"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+// testDataColumnSidecarFields returns the fields of a data column sidecar with the given
+// quote character, keyed by field name.  Cells are too large to usefully write out in
+// full, so they are generated here.
+func testDataColumnSidecarFields(quote string) map[string]string {
+	q := func(s string) string {
+		return quote + s + quote
+	}
+	cell := q("0x" + strings.Repeat("0102", fulu.CellLength/2))
+
+	return map[string]string{
+		"index":                           q("5"),
+		"column":                          fmt.Sprintf("[%s,%s]", cell, cell),
+		"kzg_commitments":                 fmt.Sprintf("[%s,%s]", q("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"), q("0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b")),
+		"kzg_proofs":                      fmt.Sprintf("[%s,%s]", q("0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"), q("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c")),
+		"signed_block_header":             `{"message":{"slot":"1","proposer_index":"2","parent_root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","state_root":"0x2102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20","body_root":"0x3102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"},"signature":"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`,
+		"kzg_commitments_inclusion_proof": fmt.Sprintf("[%s,%s,%s,%s]", q("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"), q("0x2102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"), q("0x3102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"), q("0x4102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")),
+	}
+}
+
+// testDataColumnSidecarJSON returns the JSON for a data column sidecar with the given
+// field overrides; an empty override removes the field.
+func testDataColumnSidecarJSON(overrides map[string]string) []byte {
+	fields := testDataColumnSidecarFields(`"`)
+	parts := make([]string, 0, len(fields))
+	for _, name := range []string{"index", "column", "kzg_commitments", "kzg_proofs", "signed_block_header", "kzg_commitments_inclusion_proof"} {
+		value := fields[name]
+		if override, exists := overrides[name]; exists {
+			value = override
+		}
+		if value == "" {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf(`"%s":%s`, name, value))
+	}
+
+	return []byte(fmt.Sprintf("{%s}", strings.Join(parts, ",")))
+}
+
+func TestDataColumnSidecarJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "IndexMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"index": ""}),
+			err:   "index: missing",
+		},
+		{
+			name:  "IndexWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"index": "true"}),
+			err:   "index: invalid prefix",
+		},
+		{
+			name:  "IndexInvalid",
+			input: testDataColumnSidecarJSON(map[string]string{"index": `"-1"`}),
+			err:   "index: invalid value -1: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ColumnMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"column": ""}),
+			err:   "column: missing",
+		},
+		{
+			name:  "ColumnWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"column": "true"}),
+			err:   "column: json: cannot unmarshal bool into Go value of type []fulu.Cell",
+		},
+		{
+			name:  "ColumnCellIncorrectLength",
+			input: testDataColumnSidecarJSON(map[string]string{"column": `["0x0102"]`}),
+			err:   "column: incorrect length",
+		},
+		{
+			name:  "KzgCommitmentsMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_commitments": ""}),
+			err:   "kzg_commitments: missing",
+		},
+		{
+			name:  "KzgCommitmentsWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_commitments": "true"}),
+			err:   "kzg_commitments: json: cannot unmarshal bool into Go value of type []deneb.KzgCommitment",
+		},
+		{
+			name:  "KzgProofsMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_proofs": ""}),
+			err:   "kzg_proofs: missing",
+		},
+		{
+			name:  "KzgProofsWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_proofs": "true"}),
+			err:   "kzg_proofs: json: cannot unmarshal bool into Go value of type []fulu.KzgCellProof",
+		},
+		{
+			name:  "KzgProofIncorrectLength",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_proofs": `["0x0102"]`}),
+			err:   "kzg_proofs: incorrect length",
+		},
+		{
+			name:  "SignedBlockHeaderMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"signed_block_header": ""}),
+			err:   "signed_block_header: missing",
+		},
+		{
+			name:  "SignedBlockHeaderWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"signed_block_header": "true"}),
+			err:   "signed_block_header: invalid JSON: json: cannot unmarshal bool into Go value of type phase0.signedBeaconBlockHeaderJSON",
+		},
+		{
+			name:  "KzgCommitmentsInclusionProofMissing",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_commitments_inclusion_proof": ""}),
+			err:   "kzg_commitments_inclusion_proof: missing",
+		},
+		{
+			name:  "KzgCommitmentsInclusionProofWrongType",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_commitments_inclusion_proof": "true"}),
+			err:   "kzg_commitments_inclusion_proof: json: cannot unmarshal bool into Go value of type []phase0.Root",
+		},
+		{
+			name:  "KzgCommitmentsInclusionProofShort",
+			input: testDataColumnSidecarJSON(map[string]string{"kzg_commitments_inclusion_proof": `["0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"]`}),
+			err:   "kzg_commitments_inclusion_proof: incorrect length 1",
+		},
+		{
+			name:  "Good",
+			input: testDataColumnSidecarJSON(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res fulu.DataColumnSidecar
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestDataColumnSidecarYAML(t *testing.T) {
+	fields := testDataColumnSidecarFields(`'`)
+	input := []byte(fmt.Sprintf("{index: 5, column: %s, kzg_commitments: %s, kzg_proofs: %s, signed_block_header: {message: {slot: 1, proposer_index: 2, parent_root: '0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20', state_root: '0x2102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20', body_root: '0x3102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20'}, signature: '0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0bb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b'}, kzg_commitments_inclusion_proof: %s}",
+		strings.ReplaceAll(fields["column"], ",", ", "),
+		strings.ReplaceAll(fields["kzg_commitments"], ",", ", "),
+		strings.ReplaceAll(fields["kzg_proofs"], ",", ", "),
+		strings.ReplaceAll(fields["kzg_commitments_inclusion_proof"], ",", ", "),
+	))
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: input,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res fulu.DataColumnSidecar
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, testYAMLFormat([]byte(res.String())), testYAMLFormat(rt))
+				assert.Equal(t, testYAMLFormat(test.input), testYAMLFormat(rt))
+			}
+		})
+	}
+}
+
+func TestDataColumnSidecarSSZ(t *testing.T) {
+	var sidecar fulu.DataColumnSidecar
+	require.NoError(t, json.Unmarshal(testDataColumnSidecarJSON(nil), &sidecar))
+
+	data, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, sidecar.SizeSSZ())
+
+	var res fulu.DataColumnSidecar
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, sidecar, res)
+
+	root, err := sidecar.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulu
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// dataColumnSidecarYAML is the spec representation of the struct.
+type dataColumnSidecarYAML struct {
+	Index                        uint64                          `yaml:"index"`
+	Column                       []string                        `yaml:"column"`
+	KzgCommitments               []string                        `yaml:"kzg_commitments"`
+	KzgProofs                    []string                        `yaml:"kzg_proofs"`
+	SignedBlockHeader            *phase0.SignedBeaconBlockHeader `yaml:"signed_block_header"`
+	KzgCommitmentsInclusionProof []string                        `yaml:"kzg_commitments_inclusion_proof"`
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (d *DataColumnSidecar) MarshalYAML() ([]byte, error) {
+	column := make([]string, len(d.Column))
+	for i := range d.Column {
+		column[i] = d.Column[i].String()
+	}
+	kzgCommitments := make([]string, len(d.KzgCommitments))
+	for i := range d.KzgCommitments {
+		kzgCommitments[i] = d.KzgCommitments[i].String()
+	}
+	kzgProofs := make([]string, len(d.KzgProofs))
+	for i := range d.KzgProofs {
+		kzgProofs[i] = d.KzgProofs[i].String()
+	}
+	kzgCommitmentsInclusionProof := make([]string, len(d.KzgCommitmentsInclusionProof))
+	for i := range d.KzgCommitmentsInclusionProof {
+		kzgCommitmentsInclusionProof[i] = d.KzgCommitmentsInclusionProof[i].String()
+	}
+
+	yamlBytes, err := yaml.MarshalWithOptions(&dataColumnSidecarYAML{
+		Index:                        uint64(d.Index),
+		Column:                       column,
+		KzgCommitments:               kzgCommitments,
+		KzgProofs:                    kzgProofs,
+		SignedBlockHeader:            d.SignedBlockHeader,
+		KzgCommitmentsInclusionProof: kzgCommitmentsInclusionProof,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *DataColumnSidecar) UnmarshalYAML(input []byte) error {
+	// This is very inefficient, but YAML is only used for spec tests so we do this
+	// rather than maintain a custom YAML unmarshaller.
+	var data dataColumnSidecarJSON
+	if err := yaml.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal YAML")
+	}
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return d.UnmarshalJSON(bytes)
+}
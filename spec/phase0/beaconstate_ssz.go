@@ -4,6 +4,7 @@
 package phase0
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -298,7 +299,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2687377 {
+	if o7 != 2687377 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -445,7 +446,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	// Field (15) 'PreviousEpochAttestations'
 	{
 		buf = tail[o15:o16]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		num, err := codecs.DecodeDynamicLength(buf, 4096)
 		if err != nil {
 			return err
 		}
@@ -467,7 +468,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	// Field (16) 'CurrentEpochAttestations'
 	{
 		buf = tail[o16:]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		num, err := codecs.DecodeDynamicLength(buf, 4096)
 		if err != nil {
 			return err
 		}
@@ -118,3 +118,17 @@ func (f *ForkData) String() string {
 	}
 	return string(data)
 }
+
+// ForkDigest returns the fork digest of the fork data, which is the first four bytes of
+// its hash tree root.
+func (f *ForkData) ForkDigest() (ForkDigest, error) {
+	root, err := f.HashTreeRoot()
+	if err != nil {
+		return ForkDigest{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	var forkDigest ForkDigest
+	copy(forkDigest[:], root[:])
+
+	return forkDigest, nil
+}
@@ -141,3 +141,50 @@ func TestForkDataYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestForkDataForkDigest(t *testing.T) {
+	// Mainnet genesis validators root.
+	genesisValidatorsRoot := phase0.Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	}
+
+	tests := []struct {
+		name     string
+		version  phase0.Version
+		expected phase0.ForkDigest
+	}{
+		{
+			name:     "Phase0",
+			version:  phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected: phase0.ForkDigest{0xb5, 0x30, 0x3f, 0x2a},
+		},
+		{
+			name:     "Altair",
+			version:  phase0.Version{0x01, 0x00, 0x00, 0x00},
+			expected: phase0.ForkDigest{0xaf, 0xca, 0xab, 0xa0},
+		},
+		{
+			name:     "Bellatrix",
+			version:  phase0.Version{0x02, 0x00, 0x00, 0x00},
+			expected: phase0.ForkDigest{0x4a, 0x26, 0xc5, 0x8b},
+		},
+		{
+			name:     "Capella",
+			version:  phase0.Version{0x03, 0x00, 0x00, 0x00},
+			expected: phase0.ForkDigest{0xbb, 0xa4, 0xda, 0x96},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			forkData := &phase0.ForkData{
+				CurrentVersion:        test.version,
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+			}
+			res, err := forkData.ForkDigest()
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/internal/ssztest"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+func FuzzAggregateAndProofUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.AggregateAndProof](f)
+}
+
+func FuzzAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.Attestation](f)
+}
+
+func FuzzAttestationDataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.AttestationData](f)
+}
+
+func FuzzAttesterSlashingUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.AttesterSlashing](f)
+}
+
+func FuzzBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.BeaconBlock](f)
+}
+
+func FuzzBeaconBlockBodyUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.BeaconBlockBody](f)
+}
+
+func FuzzBeaconBlockHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.BeaconBlockHeader](f)
+}
+
+func FuzzBeaconStateUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.BeaconState](f)
+}
+
+func FuzzCheckpointUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.Checkpoint](f)
+}
+
+func FuzzDepositUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.Deposit](f)
+}
+
+func FuzzDepositDataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.DepositData](f)
+}
+
+func FuzzDepositMessageUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.DepositMessage](f)
+}
+
+func FuzzETH1DataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.ETH1Data](f)
+}
+
+func FuzzForkUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.Fork](f)
+}
+
+func FuzzForkDataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.ForkData](f)
+}
+
+func FuzzIndexedAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.IndexedAttestation](f)
+}
+
+func FuzzPendingAttestationUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.PendingAttestation](f)
+}
+
+func FuzzProposerSlashingUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.ProposerSlashing](f)
+}
+
+func FuzzSignedAggregateAndProofUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.SignedAggregateAndProof](f)
+}
+
+func FuzzSignedBeaconBlockUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.SignedBeaconBlock](f)
+}
+
+func FuzzSignedBeaconBlockHeaderUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.SignedBeaconBlockHeader](f)
+}
+
+func FuzzSignedVoluntaryExitUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.SignedVoluntaryExit](f)
+}
+
+func FuzzSigningDataUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.SigningData](f)
+}
+
+func FuzzValidatorUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.Validator](f)
+}
+
+func FuzzVoluntaryExitUnmarshalSSZ(f *testing.F) {
+	ssztest.FuzzUnmarshalSSZ[phase0.VoluntaryExit](f)
+}
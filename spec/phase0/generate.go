@@ -16,4 +16,5 @@ package phase0
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
 //go:generate rm -f aggregateandproof_ssz.go attestationdata_ssz.go attestation_ssz.go attesterslashing_ssz.go beaconblockbody_ssz.go beaconblock_ssz.go beaconblockheader_ssz.go beaconstate_ssz.go checkpoint_ssz.go depositdata_ssz.go deposit_ssz.go depositmessage_ssz.go eth1data_ssz.go forkdata_ssz.go fork_ssz.go indexedattestation_ssz.go pendingattestation_ssz.go proposerslashing_ssz.go signedaggregateandproof_ssz.go signedbeaconblock_ssz.go signedbeaconblockheader_ssz.go signedvoluntaryexit_ssz.go signingdata_ssz.go validator_ssz.go voluntaryexit_ssz.go
 //go:generate sszgen -suffix ssz -path . --objs AggregateAndProof,AttestationData,Attestation,AttesterSlashing,BeaconBlockBody,BeaconBlock,BeaconBlockHeader,BeaconState,Checkpoint,Deposit,DepositData,DepositMessage,ETH1Data,Fork,ForkData,IndexedAttestation,PendingAttestation,ProposerSlashing,SignedAggregateAndProof,SignedBeaconBlock,SignedBeaconBlockHeader,SignedVoluntaryExit,SigningData,Validator,VoluntaryExit
+//go:generate go run ../../internal/sszpatch aggregateandproof_ssz.go attestationdata_ssz.go attestation_ssz.go attesterslashing_ssz.go beaconblockbody_ssz.go beaconblock_ssz.go beaconblockheader_ssz.go beaconstate_ssz.go checkpoint_ssz.go depositdata_ssz.go deposit_ssz.go depositmessage_ssz.go eth1data_ssz.go forkdata_ssz.go fork_ssz.go indexedattestation_ssz.go pendingattestation_ssz.go proposerslashing_ssz.go signedaggregateandproof_ssz.go signedbeaconblock_ssz.go signedbeaconblockheader_ssz.go signedvoluntaryexit_ssz.go signingdata_ssz.go validator_ssz.go voluntaryexit_ssz.go
 //go:generate goimports -w aggregateandproof_ssz.go attestationdata_ssz.go attestation_ssz.go attesterslashing_ssz.go beaconblockbody_ssz.go beaconblock_ssz.go beaconblockheader_ssz.go beaconstate_ssz.go checkpoint_ssz.go depositdata_ssz.go deposit_ssz.go depositmessage_ssz.go eth1data_ssz.go forkdata_ssz.go fork_ssz.go indexedattestation_ssz.go pendingattestation_ssz.go proposerslashing_ssz.go signedaggregateandproof_ssz.go signedbeaconblock_ssz.go signedbeaconblockheader_ssz.go signedvoluntaryexit_ssz.go signingdata_ssz.go validator_ssz.go voluntaryexit_ssz.go
@@ -4,6 +4,7 @@
 package phase0
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -68,7 +69,9 @@ func (v *Validator) UnmarshalSSZ(buf []byte) error {
 	v.EffectiveBalance = Gwei(ssz.UnmarshallUint64(buf[80:88]))
 
 	// Field (3) 'Slashed'
-	v.Slashed = ssz.UnmarshalBool(buf[88:89])
+	if v.Slashed, err = codecs.UnmarshalBool(buf[88:89]); err != nil {
+		return err
+	}
 
 	// Field (4) 'ActivationEligibilityEpoch'
 	v.ActivationEligibilityEpoch = Epoch(ssz.UnmarshallUint64(buf[89:97]))
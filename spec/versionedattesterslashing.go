@@ -0,0 +1,188 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedAttesterSlashing contains a versioned attester slashing.
+// Attester slashings prior to Electra share the phase0 format.
+type VersionedAttesterSlashing struct {
+	Version   DataVersion
+	Phase0    *phase0.AttesterSlashing
+	Altair    *phase0.AttesterSlashing
+	Bellatrix *phase0.AttesterSlashing
+	Capella   *phase0.AttesterSlashing
+	Deneb     *phase0.AttesterSlashing
+	Electra   *electra.AttesterSlashing
+}
+
+// IsEmpty returns true if there is no attester slashing.
+func (v *VersionedAttesterSlashing) IsEmpty() bool {
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
+// Attestation1 returns the first indexed attestation of the attester slashing.
+func (v *VersionedAttesterSlashing) Attestation1() (*VersionedIndexedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Phase0:  v.Phase0.Attestation1,
+		}, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Altair:  v.Altair.Attestation1,
+		}, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.Attestation1,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Capella: v.Capella.Attestation1,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Deneb:   v.Deneb.Attestation1,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Electra: v.Electra.Attestation1,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// Attestation2 returns the second indexed attestation of the attester slashing.
+func (v *VersionedAttesterSlashing) Attestation2() (*VersionedIndexedAttestation, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Phase0:  v.Phase0.Attestation2,
+		}, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Altair:  v.Altair.Attestation2,
+		}, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.Attestation2,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Capella: v.Capella.Attestation2,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Deneb:   v.Deneb.Attestation2,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra attester slashing")
+		}
+		return &VersionedIndexedAttestation{
+			Version: v.Version,
+			Electra: v.Electra.Attestation2,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedAttesterSlashing) String() string {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return ""
+		}
+		return v.Phase0.String()
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
+	default:
+		return "unknown version"
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,11 +32,12 @@ type VersionedBeaconBlock struct {
 	Bellatrix *bellatrix.BeaconBlock
 	Capella   *capella.BeaconBlock
 	Deneb     *deneb.BeaconBlock
+	Electra   *electra.BeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconBlock) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the beacon block.
@@ -66,6 +68,11 @@ func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -114,6 +121,14 @@ func (v *VersionedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error) {
 			return phase0.BLSSignature{}, errors.New("no deneb block body")
 		}
 		return v.Deneb.Body.RANDAOReveal, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block body")
+		}
+		return v.Electra.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -162,6 +177,14 @@ func (v *VersionedBeaconBlock) Graffiti() ([32]byte, error) {
 			return [32]byte{}, errors.New("no deneb block body")
 		}
 		return v.Deneb.Body.Graffiti, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return [32]byte{}, errors.New("no electra block")
+		}
+		if v.Electra.Body == nil {
+			return [32]byte{}, errors.New("no electra block body")
+		}
+		return v.Electra.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
@@ -195,6 +218,11 @@ func (v *VersionedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -228,6 +256,11 @@ func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -261,6 +294,11 @@ func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -294,6 +332,11 @@ func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -327,72 +370,171 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
-func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+func (v *VersionedBeaconBlock) Attestations() ([]*VersionedAttestation, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Body == nil {
 			return nil, errors.New("no phase0 block")
 		}
-		return v.Phase0.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Phase0.Body.Attestations))
+		for i, attestation := range v.Phase0.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionPhase0,
+				Phase0:  attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Body == nil {
 			return nil, errors.New("no altair block")
 		}
-		return v.Altair.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Altair.Body.Attestations))
+		for i, attestation := range v.Altair.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionAltair,
+				Altair:  attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Bellatrix.Body.Attestations))
+		for i, attestation := range v.Bellatrix.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version:   DataVersionBellatrix,
+				Bellatrix: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Capella.Body.Attestations))
+		for i, attestation := range v.Capella.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionCapella,
+				Capella: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Body == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Deneb.Body.Attestations))
+		for i, attestation := range v.Deneb.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionDeneb,
+				Deneb:   attestation,
+			}
+		}
+		return versionedAttestations, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttestations := make([]*VersionedAttestation, len(v.Electra.Body.Attestations))
+		for i, attestation := range v.Electra.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionElectra,
+				Electra: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
-func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+func (v *VersionedBeaconBlock) AttesterSlashings() ([]*VersionedAttesterSlashing, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Body == nil {
 			return nil, errors.New("no phase0 block")
 		}
-		return v.Phase0.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Phase0.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Phase0.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionPhase0,
+				Phase0:  attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Body == nil {
 			return nil, errors.New("no altair block")
 		}
-		return v.Altair.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Altair.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Altair.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionAltair,
+				Altair:  attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Body == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Bellatrix.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Bellatrix.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version:   DataVersionBellatrix,
+				Bellatrix: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Body == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Capella.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Capella.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionCapella,
+				Capella: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Body == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Deneb.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Deneb.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionDeneb,
+				Deneb:   attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Electra.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Electra.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionElectra,
+				Electra: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -426,6 +568,11 @@ func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing,
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -459,6 +606,11 @@ func (v *VersionedBeaconBlock) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -0,0 +1,120 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBeaconBlockElectra(t *testing.T) {
+	block := &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconBlock{
+			Slot:          12,
+			ProposerIndex: 3,
+			Body: &electra.BeaconBlockBody{
+				Attestations: []*electra.Attestation{
+					{
+						Data: &phase0.AttestationData{Slot: 11},
+					},
+				},
+				AttesterSlashings: []*electra.AttesterSlashing{
+					{
+						Attestation1: &electra.IndexedAttestation{
+							AttestingIndices: []uint64{1, 2},
+						},
+						Attestation2: &electra.IndexedAttestation{
+							AttestingIndices: []uint64{2, 3},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.False(t, block.IsEmpty())
+
+	slot, err := block.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), slot)
+
+	proposerIndex, err := block.ProposerIndex()
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(3), proposerIndex)
+
+	attestations, err := block.Attestations()
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+	require.Equal(t, spec.DataVersionElectra, attestations[0].Version)
+	data, err := attestations[0].Data()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(11), data.Slot)
+
+	attesterSlashings, err := block.AttesterSlashings()
+	require.NoError(t, err)
+	require.Len(t, attesterSlashings, 1)
+	attestation2, err := attesterSlashings[0].Attestation2()
+	require.NoError(t, err)
+	attestingIndices, err := attestation2.AttestingIndices()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, attestingIndices)
+}
+
+func TestVersionedBeaconBlockAttestationsDeneb(t *testing.T) {
+	block := &spec.VersionedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+		Deneb: &deneb.BeaconBlock{
+			Body: &deneb.BeaconBlockBody{
+				Attestations: []*phase0.Attestation{
+					{
+						Data: &phase0.AttestationData{Slot: 11},
+					},
+				},
+			},
+		},
+	}
+
+	attestations, err := block.Attestations()
+	require.NoError(t, err)
+	require.Len(t, attestations, 1)
+	require.Equal(t, spec.DataVersionDeneb, attestations[0].Version)
+	require.Equal(t, block.Deneb.Body.Attestations[0], attestations[0].Deneb)
+}
+
+func TestVersionedBeaconStateElectra(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconState{
+			Slot:     12,
+			Balances: []phase0.Gwei{32000000000},
+		},
+	}
+	require.False(t, state.IsEmpty())
+
+	slot, err := state.Slot()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(12), slot)
+
+	balances, err := state.ValidatorBalances()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.Gwei{32000000000}, balances)
+
+	_, err = (&spec.VersionedBeaconState{Version: spec.DataVersionElectra}).Slot()
+	require.EqualError(t, err, "no Electra state")
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -29,6 +30,7 @@ type VersionedBeaconBlockBody struct {
 	Bellatrix *bellatrix.BeaconBlockBody
 	Capella   *capella.BeaconBlockBody
 	Deneb     *deneb.BeaconBlockBody
+	Electra   *electra.BeaconBlockBody
 }
 
 // String returns a string version of the structure.
@@ -59,6 +61,11 @@ func (v *VersionedBeaconBlockBody) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,11 +32,12 @@ type VersionedBeaconState struct {
 	Bellatrix *bellatrix.BeaconState
 	Capella   *capella.BeaconState
 	Deneb     *deneb.BeaconState
+	Electra   *electra.BeaconState
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconState) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the state.
@@ -66,6 +68,11 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no Deneb state")
 		}
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -86,6 +93,11 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 			return 0, errors.New("no Deneb state")
 		}
 		return v.Deneb.NextWithdrawalValidatorIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.NextWithdrawalValidatorIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -119,6 +131,11 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 			return nil, errors.New("no Deneb state")
 		}
 		return v.Deneb.Validators, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Validators, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -152,6 +169,11 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 			return nil, errors.New("no Deneb state")
 		}
 		return v.Deneb.Balances, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Balances, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -185,6 +207,11 @@ func (v *VersionedBeaconState) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientOptimisticUpdate contains a versioned light client optimistic update.
+type VersionedLightClientOptimisticUpdate struct {
+	Version   DataVersion
+	Altair    *altair.LightClientOptimisticUpdate
+	Bellatrix *altair.LightClientOptimisticUpdate
+	Capella   *capella.LightClientOptimisticUpdate
+	Deneb     *deneb.LightClientOptimisticUpdate
+}
+
+// IsEmpty returns true if there is no update.
+func (v *VersionedLightClientOptimisticUpdate) IsEmpty() bool {
+	return v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+}
+
+// SignatureSlot returns the signature slot of the update.
+func (v *VersionedLightClientOptimisticUpdate) SignatureSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair optimistic update")
+		}
+		return v.Altair.SignatureSlot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix optimistic update")
+		}
+		return v.Bellatrix.SignatureSlot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella optimistic update")
+		}
+		return v.Capella.SignatureSlot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb optimistic update")
+		}
+		return v.Deneb.SignatureSlot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// AttestedHeader returns the beacon block header of the attested header of the update.
+func (v *VersionedLightClientOptimisticUpdate) AttestedHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.AttestedHeader == nil {
+			return nil, errors.New("no altair optimistic update")
+		}
+		return v.Altair.AttestedHeader.Beacon, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.AttestedHeader == nil {
+			return nil, errors.New("no bellatrix optimistic update")
+		}
+		return v.Bellatrix.AttestedHeader.Beacon, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.AttestedHeader == nil {
+			return nil, errors.New("no capella optimistic update")
+		}
+		return v.Capella.AttestedHeader.Beacon, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.AttestedHeader == nil {
+			return nil, errors.New("no deneb optimistic update")
+		}
+		return v.Deneb.AttestedHeader.Beacon, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// SyncAggregate returns the sync aggregate of the update.
+func (v *VersionedLightClientOptimisticUpdate) SyncAggregate() (*altair.SyncAggregate, error) {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair optimistic update")
+		}
+		return v.Altair.SyncAggregate, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix optimistic update")
+		}
+		return v.Bellatrix.SyncAggregate, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella optimistic update")
+		}
+		return v.Capella.SyncAggregate, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb optimistic update")
+		}
+		return v.Deneb.SyncAggregate, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// String returns a string version of the structure.
+func (v *VersionedLightClientOptimisticUpdate) String() string {
+	switch v.Version {
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return ""
+		}
+		return v.Altair.String()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return ""
+		}
+		return v.Bellatrix.String()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return ""
+		}
+		return v.Capella.String()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return ""
+		}
+		return v.Deneb.String()
+	default:
+		return "unknown version"
+	}
+}
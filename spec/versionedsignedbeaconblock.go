@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,6 +32,7 @@ type VersionedSignedBeaconBlock struct {
 	Bellatrix *bellatrix.SignedBeaconBlock
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *deneb.SignedBeaconBlock
+	Electra   *electra.SignedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -61,6 +63,11 @@ func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no denb block")
 		}
 		return v.Deneb.Message.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no denb block")
+		}
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -94,6 +101,11 @@ func (v *VersionedSignedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, err
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -117,6 +129,11 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error)
 			return phase0.Hash32{}, errors.New("no denb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayload.BlockHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no denb block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -140,6 +157,11 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockNumber() (uint64, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayload.BlockNumber, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.BlockNumber, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -166,6 +188,11 @@ func (v *VersionedSignedBeaconBlock) ExecutionTransactions() ([]bellatrix.Transa
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.Transactions, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -198,39 +225,91 @@ func (v *VersionedSignedBeaconBlock) Graffiti() ([32]byte, error) {
 			return [32]byte{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.Graffiti, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return [32]byte{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.Graffiti, nil
 	default:
 		return [32]byte{}, errors.New("unknown version")
 	}
 }
 
 // Attestations returns the attestations of the beacon block.
-func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
+func (v *VersionedSignedBeaconBlock) Attestations() ([]*VersionedAttestation, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil || v.Phase0.Message == nil || v.Phase0.Message.Body == nil {
 			return nil, errors.New("no phase0 block")
 		}
-		return v.Phase0.Message.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Phase0.Message.Body.Attestations))
+		for i, attestation := range v.Phase0.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionPhase0,
+				Phase0:  attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionAltair:
 		if v.Altair == nil || v.Altair.Message == nil || v.Altair.Message.Body == nil {
 			return nil, errors.New("no altair block")
 		}
-		return v.Altair.Message.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Altair.Message.Body.Attestations))
+		for i, attestation := range v.Altair.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionAltair,
+				Altair:  attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Message.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Bellatrix.Message.Body.Attestations))
+		for i, attestation := range v.Bellatrix.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version:   DataVersionBellatrix,
+				Bellatrix: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionCapella:
 		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Message.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Capella.Message.Body.Attestations))
+		for i, attestation := range v.Capella.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionCapella,
+				Capella: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Message.Body.Attestations, nil
+		versionedAttestations := make([]*VersionedAttestation, len(v.Deneb.Message.Body.Attestations))
+		for i, attestation := range v.Deneb.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionDeneb,
+				Deneb:   attestation,
+			}
+		}
+		return versionedAttestations, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttestations := make([]*VersionedAttestation, len(v.Electra.Message.Body.Attestations))
+		for i, attestation := range v.Electra.Message.Body.Attestations {
+			versionedAttestations[i] = &VersionedAttestation{
+				Version: DataVersionElectra,
+				Electra: attestation,
+			}
+		}
+		return versionedAttestations, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -264,6 +343,11 @@ func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -297,6 +381,11 @@ func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -330,6 +419,11 @@ func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -363,6 +457,11 @@ func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -396,6 +495,11 @@ func (v *VersionedSignedBeaconBlock) RandaoReveal() (phase0.BLSSignature, error)
 			return phase0.BLSSignature{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.RANDAOReveal, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.RANDAOReveal, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
@@ -429,6 +533,11 @@ func (v *VersionedSignedBeaconBlock) ETH1Data() (*phase0.ETH1Data, error) {
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ETH1Data, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ETH1Data, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -462,6 +571,11 @@ func (v *VersionedSignedBeaconBlock) Deposits() ([]*phase0.Deposit, error) {
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.Deposits, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.Deposits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -495,39 +609,91 @@ func (v *VersionedSignedBeaconBlock) VoluntaryExits() ([]*phase0.SignedVoluntary
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.VoluntaryExits, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.VoluntaryExits, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
 // AttesterSlashings returns the attester slashings of the beacon block.
-func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing, error) {
+func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*VersionedAttesterSlashing, error) {
 	switch v.Version {
 	case DataVersionPhase0:
 		if v.Phase0 == nil {
 			return nil, errors.New("no phase0 block")
 		}
-		return v.Phase0.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Phase0.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Phase0.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionPhase0,
+				Phase0:  attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionAltair:
 		if v.Altair == nil {
 			return nil, errors.New("no altair block")
 		}
-		return v.Altair.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Altair.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Altair.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionAltair,
+				Altair:  attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionBellatrix:
 		if v.Bellatrix == nil {
 			return nil, errors.New("no bellatrix block")
 		}
-		return v.Bellatrix.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Bellatrix.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Bellatrix.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version:   DataVersionBellatrix,
+				Bellatrix: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionCapella:
 		if v.Capella == nil {
 			return nil, errors.New("no capella block")
 		}
-		return v.Capella.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Capella.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Capella.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionCapella,
+				Capella: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil {
 			return nil, errors.New("no deneb block")
 		}
-		return v.Deneb.Message.Body.AttesterSlashings, nil
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Deneb.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Deneb.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionDeneb,
+				Deneb:   attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		versionedAttesterSlashings := make([]*VersionedAttesterSlashing, len(v.Electra.Message.Body.AttesterSlashings))
+		for i, attesterSlashing := range v.Electra.Message.Body.AttesterSlashings {
+			versionedAttesterSlashings[i] = &VersionedAttesterSlashing{
+				Version: DataVersionElectra,
+				Electra: attesterSlashing,
+			}
+		}
+		return versionedAttesterSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -561,6 +727,11 @@ func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlas
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -591,6 +762,11 @@ func (v *VersionedSignedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, err
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.SyncAggregate, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.SyncAggregate, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -615,6 +791,11 @@ func (v *VersionedSignedBeaconBlock) BLSToExecutionChanges() ([]*capella.SignedB
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.BLSToExecutionChanges, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.BLSToExecutionChanges, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -639,6 +820,11 @@ func (v *VersionedSignedBeaconBlock) Withdrawals() ([]*capella.Withdrawal, error
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayload.Withdrawals, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.Withdrawals, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -658,6 +844,11 @@ func (v *VersionedSignedBeaconBlock) BlobKzgCommitments() ([]deneb.KzgCommitment
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.BlobKzgCommitments, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.BlobKzgCommitments, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -691,6 +882,11 @@ func (v *VersionedSignedBeaconBlock) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -25,7 +25,10 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -114,6 +117,18 @@ func (s *Erroring) NodeVersion(ctx context.Context) (string, error) {
 	return next.NodeVersion(ctx)
 }
 
+// ParsedNodeVersion returns the node version parsed in to its component parts.
+func (s *Erroring) ParsedNodeVersion(ctx context.Context) (*apiv1.NodeVersion, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ParsedNodeVersionProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ParsedNodeVersion(ctx)
+}
+
 // SlotDuration provides the duration of a slot of the chain.
 func (s *Erroring) SlotDuration(ctx context.Context) (time.Duration, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -222,6 +237,18 @@ func (s *Erroring) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*ph
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Erroring) AttestationPoolForCommittee(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]*phase0.Attestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationPoolForCommittee(ctx, slot, committeeIndex)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -234,6 +261,67 @@ func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase
 	return next.SubmitAttestations(ctx, attestations)
 }
 
+// VersionedAggregateAttestation fetches the aggregate attestation for the given slot, attestation data root
+// and committee index.
+func (s *Erroring) VersionedAggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root, committeeIndex phase0.CommitteeIndex) (*spec.VersionedAttestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.VersionedAggregateAttestation(ctx, slot, attestationDataRoot, committeeIndex)
+}
+
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Erroring) VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.VersionedAttestationPool(ctx, slot)
+}
+
+// VersionedAttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Erroring) VersionedAttestationPoolForCommittee(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]*spec.VersionedAttestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.VersionedAttestationPoolForCommittee(ctx, slot, committeeIndex)
+}
+
+// SubmitVersionedAttestations submits versioned attestations.
+func (s *Erroring) SubmitVersionedAttestations(ctx context.Context, attestations []*spec.VersionedAttestation) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.VersionedAttestationsSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitVersionedAttestations(ctx, attestations)
+}
+
+// BlockAttestations fetches the attestations in a beacon block given a block ID.
+func (s *Erroring) BlockAttestations(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlockAttestationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlockAttestations(ctx, blockID)
+}
+
 // SubmitProposalPreparations submits proposal preparations.
 func (s *Erroring) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -654,6 +742,42 @@ func (s *Erroring) GenesisDomain(ctx context.Context, domainType phase0.DomainTy
 	return next.GenesisDomain(ctx, domainType)
 }
 
+// ForkDigest provides the fork digest at a given epoch.
+func (s *Erroring) ForkDigest(ctx context.Context, epoch phase0.Epoch) (phase0.ForkDigest, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return phase0.ForkDigest{}, err
+	}
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.ForkDigest{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkDigest(ctx, epoch)
+}
+
+// ForkVersion provides the fork version at a given epoch.
+func (s *Erroring) ForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return phase0.Version{}, err
+	}
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.Version{}, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ForkVersion(ctx, epoch)
+}
+
+// NextForkVersion provides the version and epoch of the first fork scheduled after a given epoch.
+func (s *Erroring) NextForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return phase0.Version{}, 0, err
+	}
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.Version{}, 0, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NextForkVersion(ctx, epoch)
+}
+
 // GenesisTime provides the genesis time of the chain.
 func (s *Erroring) GenesisTime(ctx context.Context) (time.Time, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -725,3 +849,325 @@ func (s *Erroring) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
 	}
 	return next.ForkChoice(ctx)
 }
+
+// BlockRewards fetches the rewards received by the proposer of a block given a block ID.
+func (s *Erroring) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// AttestationRewards provides the attestation rewards for a given epoch.
+func (s *Erroring) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationRewards(ctx, epoch, validatorIndices)
+}
+
+// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+func (s *Erroring) AttestationRewardsByPubKey(ctx context.Context, epoch phase0.Epoch, validatorPubKeys []phase0.BLSPubKey) (*apiv1.AttestationRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationRewardsByPubKey(ctx, epoch, validatorPubKeys)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update known to the beacon node.
+func (s *Erroring) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update known to the beacon node.
+func (s *Erroring) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Erroring) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ValidatorLiveness(ctx, epoch, validatorIndices)
+}
+
+// ExpectedWithdrawals fetches the withdrawals expected to be included in the next block built on the given state.
+func (s *Erroring) ExpectedWithdrawals(ctx context.Context, stateID string) ([]*apiv1.ExpectedWithdrawal, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ExpectedWithdrawals(ctx, stateID)
+}
+
+// PendingConsolidations fetches the pending consolidations given a state ID.
+func (s *Erroring) PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingConsolidationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PendingConsolidations(ctx, stateID)
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+func (s *Erroring) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlobSidecars(ctx, blockID, indices)
+}
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+func (s *Erroring) DataColumnSidecars(ctx context.Context, blockID string, indices []fulu.ColumnIndex) ([]*fulu.DataColumnSidecar, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DataColumnSidecars(ctx, blockID, indices)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Erroring) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRandao(ctx, stateID)
+}
+
+// BeaconStateRandaoAtEpoch fetches the RANDAO mix for the given epoch given a state ID.
+func (s *Erroring) BeaconStateRandaoAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*phase0.Root, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoAtEpochProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRandaoAtEpoch(ctx, stateID, epoch)
+}
+
+// DepositSnapshot provides a snapshot of the deposit contract Merkle tree.
+func (s *Erroring) DepositSnapshot(ctx context.Context) (*apiv1.DepositTreeSnapshot, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.DepositSnapshot(ctx)
+}
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Erroring) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SignedBlindedBeaconBlockProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SignedBlindedBeaconBlock(ctx, blockID)
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Erroring) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttesterSlashingPool(ctx)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Erroring) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Erroring) ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.ProposerSlashingPool(ctx)
+}
+
+// SubmitProposerSlashing submits a proposer slashing.
+func (s *Erroring) SubmitProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.ProposerSlashingSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitProposerSlashing(ctx, slashing)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Erroring) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BLSToExecutionChangePool(ctx)
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Erroring) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte, builderBoostFactor uint64) (*api.VersionedProposal, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Proposal(ctx, slot, randaoReveal, graffiti, builderBoostFactor)
+}
+
+// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+func (s *Erroring) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconBlockHeaders(ctx, slot, parentRoot)
+}
+
+// BuilderBid fetches the bid of a builder for the execution payload of the given slot.
+func (s *Erroring) BuilderBid(ctx context.Context, slot phase0.Slot, parentHash phase0.Hash32, pubKey phase0.BLSPubKey) (*api.VersionedSignedBuilderBid, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BuilderBidProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BuilderBid(ctx, slot, parentHash, pubKey)
+}
+
+// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder.
+func (s *Erroring) SubmitBuilderBlindedBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) (*api.VersionedSubmitBlindedBlockResponse, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BuilderBlindedBlockSubmitter)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBuilderBlindedBlock(ctx, block)
+}
+
+// BuilderStatus returns nil if the builder is ready to provide bids, otherwise an error.
+func (s *Erroring) BuilderStatus(ctx context.Context) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BuilderStatusProvider)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BuilderStatus(ctx)
+}
+
+// Capabilities provides the provider and submitter interfaces that are usable
+// against the connected node.
+func (s *Erroring) Capabilities(ctx context.Context) (*api.Capabilities, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.CapabilitiesProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Capabilities(ctx)
+}
+
+// RawCall calls the given endpoint on the node, returning the response regardless
+// of its status code.
+func (s *Erroring) RawCall(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	opts *api.RawCallOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.RawCallProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.RawCall(ctx, method, endpoint, body, opts)
+}
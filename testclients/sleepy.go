@@ -24,7 +24,10 @@ import (
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/fulu"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -106,6 +109,16 @@ func (s *Sleepy) NodeVersion(ctx context.Context) (string, error) {
 	return next.NodeVersion(ctx)
 }
 
+// ParsedNodeVersion returns the node version parsed in to its component parts.
+func (s *Sleepy) ParsedNodeVersion(ctx context.Context) (*apiv1.NodeVersion, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ParsedNodeVersionProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ParsedNodeVersion(ctx)
+}
+
 // SlotDuration provides the duration of a slot of the chain.
 func (s *Sleepy) SlotDuration(ctx context.Context) (time.Duration, error) {
 	s.sleep(ctx)
@@ -196,6 +209,16 @@ func (s *Sleepy) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phas
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Sleepy) AttestationPoolForCommittee(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]*phase0.Attestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationPoolForCommittee(ctx, slot, committeeIndex)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	s.sleep(ctx)
@@ -206,6 +229,57 @@ func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.
 	return next.SubmitAttestations(ctx, attestations)
 }
 
+// VersionedAggregateAttestation fetches the aggregate attestation for the given slot, attestation data root
+// and committee index.
+func (s *Sleepy) VersionedAggregateAttestation(ctx context.Context, slot phase0.Slot, attestationDataRoot phase0.Root, committeeIndex phase0.CommitteeIndex) (*spec.VersionedAttestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAggregateAttestationProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.VersionedAggregateAttestation(ctx, slot, attestationDataRoot, committeeIndex)
+}
+
+// VersionedAttestationPool fetches the attestation pool for the given slot.
+func (s *Sleepy) VersionedAttestationPool(ctx context.Context, slot phase0.Slot) ([]*spec.VersionedAttestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.VersionedAttestationPool(ctx, slot)
+}
+
+// VersionedAttestationPoolForCommittee fetches the attestation pool for the given slot and committee.
+func (s *Sleepy) VersionedAttestationPoolForCommittee(ctx context.Context, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]*spec.VersionedAttestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAttestationPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.VersionedAttestationPoolForCommittee(ctx, slot, committeeIndex)
+}
+
+// SubmitVersionedAttestations submits versioned attestations.
+func (s *Sleepy) SubmitVersionedAttestations(ctx context.Context, attestations []*spec.VersionedAttestation) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.VersionedAttestationsSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.SubmitVersionedAttestations(ctx, attestations)
+}
+
+// BlockAttestations fetches the attestations in a beacon block given a block ID.
+func (s *Sleepy) BlockAttestations(ctx context.Context, blockID string) ([]*spec.VersionedAttestation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlockAttestationsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BlockAttestations(ctx, blockID)
+}
+
 // AttesterDuties obtains attester duties.
 // If validatorIndicess is nil it will return all duties for the given epoch.
 func (s *Sleepy) AttesterDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
@@ -457,6 +531,36 @@ func (s *Sleepy) GenesisDomain(ctx context.Context, domainType phase0.DomainType
 	return next.GenesisDomain(ctx, domainType)
 }
 
+// ForkDigest provides the fork digest at a given epoch.
+func (s *Sleepy) ForkDigest(ctx context.Context, epoch phase0.Epoch) (phase0.ForkDigest, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.ForkDigest{}, errors.New("next does not support this call")
+	}
+	return next.ForkDigest(ctx, epoch)
+}
+
+// ForkVersion provides the fork version at a given epoch.
+func (s *Sleepy) ForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.Version{}, errors.New("next does not support this call")
+	}
+	return next.ForkVersion(ctx, epoch)
+}
+
+// NextForkVersion provides the version and epoch of the first fork scheduled after a given epoch.
+func (s *Sleepy) NextForkVersion(ctx context.Context, epoch phase0.Epoch) (phase0.Version, phase0.Epoch, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ForkDigestProvider)
+	if !isNext {
+		return phase0.Version{}, 0, errors.New("next does not support this call")
+	}
+	return next.NextForkVersion(ctx, epoch)
+}
+
 // GenesisTime provides the genesis time of the chain.
 func (s *Sleepy) GenesisTime(ctx context.Context) (time.Time, error) {
 	s.sleep(ctx)
@@ -486,3 +590,273 @@ func (s *Sleepy) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb
 	}
 	return next.BeaconBlockBlobs(ctx, blockID)
 }
+
+// BlockRewards fetches the rewards received by the proposer of a block given a block ID.
+func (s *Sleepy) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// AttestationRewards provides the attestation rewards for a given epoch.
+func (s *Sleepy) AttestationRewards(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationRewards(ctx, epoch, validatorIndices)
+}
+
+// AttestationRewardsByPubKey provides the attestation rewards for a given epoch.
+func (s *Sleepy) AttestationRewardsByPubKey(ctx context.Context, epoch phase0.Epoch, validatorPubKeys []phase0.BLSPubKey) (*apiv1.AttestationRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationRewardsByPubKey(ctx, epoch, validatorPubKeys)
+}
+
+// LightClientFinalityUpdate fetches the latest light client finality update known to the beacon node.
+func (s *Sleepy) LightClientFinalityUpdate(ctx context.Context) (*spec.VersionedLightClientFinalityUpdate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientFinalityUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientFinalityUpdate(ctx)
+}
+
+// LightClientOptimisticUpdate fetches the latest light client optimistic update known to the beacon node.
+func (s *Sleepy) LightClientOptimisticUpdate(ctx context.Context) (*spec.VersionedLightClientOptimisticUpdate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.LightClientOptimisticUpdateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.LightClientOptimisticUpdate(ctx)
+}
+
+// ValidatorLiveness provides the liveness of the given validators in the given epoch.
+func (s *Sleepy) ValidatorLiveness(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorLiveness, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ValidatorLivenessProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ValidatorLiveness(ctx, epoch, validatorIndices)
+}
+
+// ExpectedWithdrawals fetches the withdrawals expected to be included in the next block built on the given state.
+func (s *Sleepy) ExpectedWithdrawals(ctx context.Context, stateID string) ([]*apiv1.ExpectedWithdrawal, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ExpectedWithdrawalsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ExpectedWithdrawals(ctx, stateID)
+}
+
+// PendingConsolidations fetches the pending consolidations given a state ID.
+func (s *Sleepy) PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.PendingConsolidationsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.PendingConsolidations(ctx, stateID)
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+func (s *Sleepy) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BlobSidecars(ctx, blockID, indices)
+}
+
+// DataColumnSidecars fetches the data column sidecars given a block ID.
+func (s *Sleepy) DataColumnSidecars(ctx context.Context, blockID string, indices []fulu.ColumnIndex) ([]*fulu.DataColumnSidecar, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DataColumnSidecarsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.DataColumnSidecars(ctx, blockID, indices)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Sleepy) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconStateRandao(ctx, stateID)
+}
+
+// BeaconStateRandaoAtEpoch fetches the RANDAO mix for the given epoch given a state ID.
+func (s *Sleepy) BeaconStateRandaoAtEpoch(ctx context.Context, stateID string, epoch phase0.Epoch) (*phase0.Root, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoAtEpochProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconStateRandaoAtEpoch(ctx, stateID, epoch)
+}
+
+// DepositSnapshot provides a snapshot of the deposit contract Merkle tree.
+func (s *Sleepy) DepositSnapshot(ctx context.Context) (*apiv1.DepositTreeSnapshot, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.DepositSnapshotProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.DepositSnapshot(ctx)
+}
+
+// SignedBlindedBeaconBlock fetches a signed blinded beacon block given a block ID.
+func (s *Sleepy) SignedBlindedBeaconBlock(ctx context.Context, blockID string) (*api.VersionedSignedBlindedBeaconBlock, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.SignedBlindedBeaconBlockProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SignedBlindedBeaconBlock(ctx, blockID)
+}
+
+// AttesterSlashingPool fetches the attester slashing pool.
+func (s *Sleepy) AttesterSlashingPool(ctx context.Context) ([]*phase0.AttesterSlashing, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttesterSlashingPool(ctx)
+}
+
+// SubmitAttesterSlashing submits an attester slashing.
+func (s *Sleepy) SubmitAttesterSlashing(ctx context.Context, slashing *phase0.AttesterSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttesterSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.SubmitAttesterSlashing(ctx, slashing)
+}
+
+// ProposerSlashingPool fetches the proposer slashing pool.
+func (s *Sleepy) ProposerSlashingPool(ctx context.Context) ([]*phase0.ProposerSlashing, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingPoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.ProposerSlashingPool(ctx)
+}
+
+// SubmitProposerSlashing submits a proposer slashing.
+func (s *Sleepy) SubmitProposerSlashing(ctx context.Context, slashing *phase0.ProposerSlashing) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposerSlashingSubmitter)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.SubmitProposerSlashing(ctx, slashing)
+}
+
+// BLSToExecutionChangePool fetches the BLS to execution change pool.
+func (s *Sleepy) BLSToExecutionChangePool(ctx context.Context) ([]*capella.SignedBLSToExecutionChange, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangePoolProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BLSToExecutionChangePool(ctx)
+}
+
+// Proposal fetches a proposal for signing.
+func (s *Sleepy) Proposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte, builderBoostFactor uint64) (*api.VersionedProposal, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.Proposal(ctx, slot, randaoReveal, graffiti, builderBoostFactor)
+}
+
+// BeaconBlockHeaders provides the block headers that match the given slot and parent root.
+func (s *Sleepy) BeaconBlockHeaders(ctx context.Context, slot *phase0.Slot, parentRoot *phase0.Root) ([]*apiv1.BeaconBlockHeader, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BeaconBlockHeadersProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BeaconBlockHeaders(ctx, slot, parentRoot)
+}
+
+// BuilderBid fetches the bid of a builder for the execution payload of the given slot.
+func (s *Sleepy) BuilderBid(ctx context.Context, slot phase0.Slot, parentHash phase0.Hash32, pubKey phase0.BLSPubKey) (*api.VersionedSignedBuilderBid, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BuilderBidProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BuilderBid(ctx, slot, parentHash, pubKey)
+}
+
+// SubmitBuilderBlindedBlock submits a signed blinded beacon block to a builder.
+func (s *Sleepy) SubmitBuilderBlindedBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) (*api.VersionedSubmitBlindedBlockResponse, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BuilderBlindedBlockSubmitter)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.SubmitBuilderBlindedBlock(ctx, block)
+}
+
+// BuilderStatus returns nil if the builder is ready to provide bids, otherwise an error.
+func (s *Sleepy) BuilderStatus(ctx context.Context) error {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BuilderStatusProvider)
+	if !isNext {
+		return errors.New("next does not support this call")
+	}
+	return next.BuilderStatus(ctx)
+}
+
+// Capabilities provides the provider and submitter interfaces that are usable
+// against the connected node.
+func (s *Sleepy) Capabilities(ctx context.Context) (*api.Capabilities, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.CapabilitiesProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.Capabilities(ctx)
+}
+
+// RawCall calls the given endpoint on the node, returning the response regardless
+// of its status code.
+func (s *Sleepy) RawCall(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	opts *api.RawCallOpts,
+) (
+	*api.RawResponse,
+	error,
+) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.RawCallProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.RawCall(ctx, method, endpoint, body, opts)
+}
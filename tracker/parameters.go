@@ -0,0 +1,127 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                zerolog.Level
+	client                  consensusclient.Service
+	chainTime               *chaintime.Service
+	pubKeys                 []phase0.BLSPubKey
+	refreshInterval         time.Duration
+	validatorChangedHandler ValidatorChangedHandlerFunc
+	dutiesHandler           DutiesHandlerFunc
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the consensus client from which to obtain information.
+// The client must be a validators provider.  If it is also an attester,
+// proposer or sync committee duties provider the relevant duties will be
+// tracked, and if it is an events provider it will be used to refresh the
+// tracked information as the chain progresses.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithChainTime sets the chain time service.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithPubKeys sets the initial public keys of the validators to track.
+func WithPubKeys(pubKeys []phase0.BLSPubKey) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pubKeys = pubKeys
+	})
+}
+
+// WithRefreshInterval sets the interval at which tracked information is refreshed
+// regardless of events.
+func WithRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshInterval = interval
+	})
+}
+
+// WithValidatorChangedHandler sets the handler called when a tracked validator changes.
+func WithValidatorChangedHandler(handler ValidatorChangedHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorChangedHandler = handler
+	})
+}
+
+// WithDutiesHandler sets the handler called when the duties for an epoch change.
+func WithDutiesHandler(handler DutiesHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutiesHandler = handler
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:        zerolog.GlobalLevel(),
+		refreshInterval: 5 * time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.ValidatorsProvider); !isProvider {
+		return nil, errors.New("client is not a validators provider")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("no chain time specified")
+	}
+	if parameters.refreshInterval == 0 {
+		return nil, errors.New("no refresh interval specified")
+	}
+
+	return &parameters, nil
+}
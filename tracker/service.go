@@ -0,0 +1,342 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Validator is the tracked information about a validator.
+type Validator struct {
+	PubKey           phase0.BLSPubKey
+	Index            phase0.ValidatorIndex
+	Status           apiv1.ValidatorState
+	Balance          phase0.Gwei
+	EffectiveBalance phase0.Gwei
+}
+
+// Duties are the duties of the tracked validators for an epoch.
+type Duties struct {
+	Epoch         phase0.Epoch
+	Attester      []*apiv1.AttesterDuty
+	Proposer      []*apiv1.ProposerDuty
+	SyncCommittee []*apiv1.SyncCommitteeDuty
+}
+
+// ValidatorChangedHandlerFunc is the handler called when a tracked validator changes.
+// previous is nil the first time that a validator is seen.
+type ValidatorChangedHandlerFunc func(ctx context.Context, previous *Validator, current *Validator)
+
+// DutiesHandlerFunc is the handler called when the duties of the tracked validators
+// for an epoch are first obtained, or subsequently change.
+type DutiesHandlerFunc func(ctx context.Context, duties *Duties)
+
+// Service tracks information about a set of validators.
+type Service struct {
+	log                     zerolog.Logger
+	client                  consensusclient.Service
+	chainTime               *chaintime.Service
+	validatorChangedHandler ValidatorChangedHandlerFunc
+	dutiesHandler           DutiesHandlerFunc
+	refreshCh               chan struct{}
+
+	// refreshMu ensures only one refresh happens at a time.
+	refreshMu sync.Mutex
+
+	mu         sync.RWMutex
+	pubKeys    map[phase0.BLSPubKey]struct{}
+	validators map[phase0.BLSPubKey]*Validator
+	duties     map[phase0.Epoch]*Duties
+
+	dependentRootsMu      sync.Mutex
+	currentDependentRoot  phase0.Root
+	previousDependentRoot phase0.Root
+}
+
+// New creates a new validator tracker.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "tracker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                     log,
+		client:                  parameters.client,
+		chainTime:               parameters.chainTime,
+		validatorChangedHandler: parameters.validatorChangedHandler,
+		dutiesHandler:           parameters.dutiesHandler,
+		refreshCh:               make(chan struct{}, 1),
+		pubKeys:                 make(map[phase0.BLSPubKey]struct{}, len(parameters.pubKeys)),
+		validators:              make(map[phase0.BLSPubKey]*Validator, len(parameters.pubKeys)),
+		duties:                  make(map[phase0.Epoch]*Duties),
+	}
+	for _, pubKey := range parameters.pubKeys {
+		s.pubKeys[pubKey] = struct{}{}
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to obtain initial state")
+	}
+
+	if provider, isProvider := s.client.(consensusclient.EventsProvider); isProvider {
+		if err := provider.Events(ctx, []string{"head"}, s.handleHeadEvent); err != nil {
+			return nil, errors.Wrap(err, "failed to subscribe to head events")
+		}
+	}
+
+	go s.run(ctx, parameters.refreshInterval)
+
+	return s, nil
+}
+
+// AddPubKeys adds validators to the set being tracked.
+func (s *Service) AddPubKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) error {
+	s.mu.Lock()
+	for _, pubKey := range pubKeys {
+		s.pubKeys[pubKey] = struct{}{}
+	}
+	s.mu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+// RemovePubKeys removes validators from the set being tracked.
+func (s *Service) RemovePubKeys(pubKeys []phase0.BLSPubKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, pubKey := range pubKeys {
+		delete(s.pubKeys, pubKey)
+		delete(s.validators, pubKey)
+	}
+}
+
+// Validators returns the tracked validators.
+// Validators that are not yet known to the chain are not returned.
+func (s *Service) Validators() []*Validator {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]*Validator, 0, len(s.validators))
+	for _, validator := range s.validators {
+		res = append(res, validator)
+	}
+
+	return res
+}
+
+// Validator returns the tracked information for the validator with the given public key.
+func (s *Service) Validator(pubKey phase0.BLSPubKey) (*Validator, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	validator, exists := s.validators[pubKey]
+
+	return validator, exists
+}
+
+// Duties returns the duties of the tracked validators for the given epoch.
+// Duties are tracked for the current and next epoch; other epochs return nil.
+func (s *Service) Duties(epoch phase0.Epoch) *Duties {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.duties[epoch]
+}
+
+// Refresh triggers an asynchronous refresh of the tracked information.
+func (s *Service) Refresh() {
+	select {
+	case s.refreshCh <- struct{}{}:
+	default:
+		// Refresh already pending.
+	}
+}
+
+func (s *Service) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Trace().Msg("Context done; tracker stopping")
+			return
+		case <-ticker.C:
+		case <-s.refreshCh:
+		}
+		if err := s.refresh(ctx); err != nil {
+			s.log.Warn().Err(err).Msg("Failed to refresh tracked validators")
+		}
+	}
+}
+
+// handleHeadEvent triggers a refresh when a head event indicates that duties may have changed.
+func (s *Service) handleHeadEvent(event *apiv1.Event) {
+	headEvent, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+	if !isHeadEvent {
+		return
+	}
+
+	s.dependentRootsMu.Lock()
+	dependentRootsChanged := headEvent.CurrentDutyDependentRoot != s.currentDependentRoot ||
+		headEvent.PreviousDutyDependentRoot != s.previousDependentRoot
+	s.currentDependentRoot = headEvent.CurrentDutyDependentRoot
+	s.previousDependentRoot = headEvent.PreviousDutyDependentRoot
+	s.dependentRootsMu.Unlock()
+
+	if headEvent.EpochTransition || dependentRootsChanged {
+		s.log.Trace().Uint64("slot", uint64(headEvent.Slot)).Msg("Head event requires refresh")
+		s.Refresh()
+	}
+}
+
+// refresh refreshes the tracked information.
+func (s *Service) refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.mu.RLock()
+	pubKeys := make([]phase0.BLSPubKey, 0, len(s.pubKeys))
+	for pubKey := range s.pubKeys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	s.mu.RUnlock()
+	if len(pubKeys) == 0 {
+		return nil
+	}
+
+	validators, err := s.client.(consensusclient.ValidatorsProvider).ValidatorsByPubKey(ctx, "head", pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain validators")
+	}
+
+	type change struct {
+		previous *Validator
+		current  *Validator
+	}
+	changes := make([]change, 0)
+	indices := make([]phase0.ValidatorIndex, 0, len(validators))
+	s.mu.Lock()
+	for _, validator := range validators {
+		if validator.Validator == nil {
+			continue
+		}
+		if _, tracked := s.pubKeys[validator.Validator.PublicKey]; !tracked {
+			// Removed whilst we were fetching.
+			continue
+		}
+		current := &Validator{
+			PubKey:           validator.Validator.PublicKey,
+			Index:            validator.Index,
+			Status:           validator.Status,
+			Balance:          validator.Balance,
+			EffectiveBalance: validator.Validator.EffectiveBalance,
+		}
+		indices = append(indices, current.Index)
+		previous := s.validators[current.PubKey]
+		if previous == nil || *previous != *current {
+			changes = append(changes, change{previous: previous, current: current})
+		}
+		s.validators[current.PubKey] = current
+	}
+	s.mu.Unlock()
+
+	if s.validatorChangedHandler != nil {
+		for _, change := range changes {
+			s.validatorChangedHandler(ctx, change.previous, change.current)
+		}
+	}
+
+	if len(indices) == 0 {
+		return nil
+	}
+
+	currentEpoch := s.chainTime.CurrentEpoch()
+	for _, epoch := range []phase0.Epoch{currentEpoch, currentEpoch + 1} {
+		if err := s.refreshDuties(ctx, epoch, indices); err != nil {
+			return err
+		}
+	}
+
+	// Remove duties for epochs that have passed.
+	s.mu.Lock()
+	for epoch := range s.duties {
+		if epoch < currentEpoch {
+			delete(s.duties, epoch)
+		}
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// refreshDuties refreshes the duties for the given epoch.
+func (s *Service) refreshDuties(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) error {
+	duties := &Duties{
+		Epoch: epoch,
+	}
+
+	var err error
+	if provider, isProvider := s.client.(consensusclient.AttesterDutiesProvider); isProvider {
+		duties.Attester, err = provider.AttesterDuties(ctx, epoch, indices)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain attester duties")
+		}
+	}
+	// Proposer duties are only available for the current epoch.
+	if provider, isProvider := s.client.(consensusclient.ProposerDutiesProvider); isProvider && epoch == s.chainTime.CurrentEpoch() {
+		duties.Proposer, err = provider.ProposerDuties(ctx, epoch, indices)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain proposer duties")
+		}
+	}
+	if provider, isProvider := s.client.(consensusclient.SyncCommitteeDutiesProvider); isProvider {
+		duties.SyncCommittee, err = provider.SyncCommitteeDuties(ctx, epoch, indices)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain sync committee duties")
+		}
+	}
+
+	s.mu.Lock()
+	previous := s.duties[epoch]
+	changed := previous == nil || !reflect.DeepEqual(previous, duties)
+	if changed {
+		s.duties[epoch] = duties
+	}
+	s.mu.Unlock()
+
+	if changed && s.dutiesHandler != nil {
+		s.dutiesHandler(ctx, duties)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/tracker"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorsClient is a mock client that returns configurable validators.
+type validatorsClient struct {
+	*mock.Service
+	mu       sync.Mutex
+	balances map[phase0.BLSPubKey]phase0.Gwei
+}
+
+func (c *validatorsClient) ValidatorsByPubKey(_ context.Context,
+	_ string,
+	pubKeys []phase0.BLSPubKey,
+) (
+	map[phase0.ValidatorIndex]*apiv1.Validator,
+	error,
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator)
+	for _, pubKey := range pubKeys {
+		balance, exists := c.balances[pubKey]
+		if !exists {
+			continue
+		}
+		index := phase0.ValidatorIndex(pubKey[0])
+		res[index] = &apiv1.Validator{
+			Index:   index,
+			Balance: balance,
+			Status:  apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:        pubKey,
+				EffectiveBalance: 32000000000,
+			},
+		}
+	}
+
+	return res, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []tracker.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithChainTime(chainTime),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "ChainTimeMissing",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithClient(mockClient),
+			},
+			err: "problem with parameters: no chain time specified",
+		},
+		{
+			name: "RefreshIntervalZero",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithClient(mockClient),
+				tracker.WithChainTime(chainTime),
+				tracker.WithRefreshInterval(0),
+			},
+			err: "problem with parameters: no refresh interval specified",
+		},
+		{
+			name: "Good",
+			params: []tracker.Parameter{
+				tracker.WithLogLevel(zerolog.Disabled),
+				tracker.WithClient(mockClient),
+				tracker.WithChainTime(chainTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := tracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTracking(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	client := &validatorsClient{
+		Service: mockClient,
+		balances: map[phase0.BLSPubKey]phase0.Gwei{
+			{0x01}: 32000000000,
+			{0x02}: 32000000000,
+		},
+	}
+	chainTime, err := chaintime.New(ctx,
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisProvider(mockClient),
+		chaintime.WithSpecProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	changes := 0
+	dutyUpdates := 0
+	s, err := tracker.New(ctx,
+		tracker.WithLogLevel(zerolog.Disabled),
+		tracker.WithClient(client),
+		tracker.WithChainTime(chainTime),
+		tracker.WithRefreshInterval(time.Hour),
+		tracker.WithPubKeys([]phase0.BLSPubKey{{0x01}}),
+		tracker.WithValidatorChangedHandler(func(_ context.Context, _ *tracker.Validator, _ *tracker.Validator) {
+			mu.Lock()
+			changes++
+			mu.Unlock()
+		}),
+		tracker.WithDutiesHandler(func(_ context.Context, _ *tracker.Duties) {
+			mu.Lock()
+			dutyUpdates++
+			mu.Unlock()
+		}),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, s.Validators(), 1)
+	validator, exists := s.Validator(phase0.BLSPubKey{0x01})
+	require.True(t, exists)
+	require.Equal(t, phase0.ValidatorIndex(1), validator.Index)
+	require.Equal(t, 1, changes)
+	// Duties for current and next epoch.
+	require.Equal(t, 2, dutyUpdates)
+	require.NotNil(t, s.Duties(chainTime.CurrentEpoch()))
+	require.Len(t, s.Duties(chainTime.CurrentEpoch()).Attester, 1)
+
+	// Add a validator.
+	require.NoError(t, s.AddPubKeys(ctx, []phase0.BLSPubKey{{0x02}}))
+	require.Len(t, s.Validators(), 2)
+	require.Equal(t, 2, changes)
+	require.Equal(t, 4, dutyUpdates)
+
+	// Change a balance.
+	client.mu.Lock()
+	client.balances[phase0.BLSPubKey{0x01}] = 32000000001
+	client.mu.Unlock()
+	require.NoError(t, s.AddPubKeys(ctx, nil))
+	require.Equal(t, 3, changes)
+	validator, exists = s.Validator(phase0.BLSPubKey{0x01})
+	require.True(t, exists)
+	require.Equal(t, phase0.Gwei(32000000001), validator.Balance)
+
+	// Remove a validator.
+	s.RemovePubKeys([]phase0.BLSPubKey{{0x02}})
+	require.Len(t, s.Validators(), 1)
+}
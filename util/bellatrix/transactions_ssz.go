@@ -4,6 +4,7 @@
 package bellatrix
 
 import (
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -64,14 +65,14 @@ func (t *ExecutionPayloadTransactions) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
+	if o0 != 4 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (0) 'Transactions'
 	{
 		buf = tail[o0:]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
+		num, err := codecs.DecodeDynamicLength(buf, 1048576)
 		if err != nil {
 			return err
 		}